@@ -0,0 +1,89 @@
+package notionapi
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// ErrNoAvatar is returned by Client.DownloadAvatar for the users without
+// avatar.
+var ErrNoAvatar = errors.New("user has no avatar")
+
+// Avatar is the image of the avatar of a user, as returned by
+// Client.DownloadAvatar. The caller must close it.
+type Avatar struct {
+	io.ReadCloser
+	// ContentType is the media type of the image, such as "image/png", from
+	// the response or detected from its content.
+	ContentType string
+}
+
+// Extension returns the usual extension of the files of the type of the
+// image, such as ".png", or "" when it is not known.
+func (a *Avatar) Extension() string {
+	switch a.ContentType {
+	case "image/png":
+		return ".png"
+	case "image/jpeg":
+		return ".jpg"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	case "image/svg+xml":
+		return ".svg"
+	}
+	if extensions, err := mime.ExtensionsByType(a.ContentType); err == nil && len(extensions) > 0 {
+		return extensions[0]
+	}
+	return ""
+}
+
+// DownloadAvatar fetches the avatar of user, such as to mirror the directory
+// of a team. The partial users are resolved with Client.UserCache first.
+// ErrNoAvatar is returned for the users without avatar.
+func (c *Client) DownloadAvatar(ctx context.Context, user *User) (*Avatar, error) {
+	if user == nil {
+		return nil, ErrNoAvatar
+	}
+	user, err := c.UserCache().Resolve(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if user.AvatarURL == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNoAvatar, user.ID)
+	}
+
+	res, err := c.download(ctx, user.AvatarURL)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+		return nil, fmt.Errorf("download avatar: unexpected status code: %d", res.StatusCode)
+	}
+
+	avatar := &Avatar{ReadCloser: res.Body}
+	if mediaType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type")); err == nil && strings.HasPrefix(mediaType, "image/") {
+		avatar.ContentType = mediaType
+		return avatar, nil
+	}
+	// the type is detected from the first bytes, which are read again
+	buffered := bufio.NewReader(res.Body)
+	head, _ := buffered.Peek(512)
+	avatar.ContentType, _, _ = mime.ParseMediaType(http.DetectContentType(head))
+	avatar.ReadCloser = struct {
+		io.Reader
+		io.Closer
+	}{buffered, res.Body}
+	return avatar, nil
+}
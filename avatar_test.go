@@ -0,0 +1,98 @@
+package notionapi_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientDownloadAvatar(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	newImageResponse := func(contentType string, body []byte) *http.Response {
+		header := http.Header{}
+		if contentType != "" {
+			header.Set("Content-Type", contentType)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: header, Body: ioutil.NopCloser(bytes.NewReader(body))}
+	}
+
+	tests := []struct {
+		name          string
+		user          *notionapi.User
+		response      *http.Response
+		want          []byte
+		wantType      string
+		wantExtension string
+		wantErr       error
+	}{
+		{
+			name:          "content type of the response",
+			user:          &notionapi.User{ID: "user_1", Type: notionapi.UserTypePerson, AvatarURL: "https://example.com/avatar"},
+			response:      newImageResponse("image/jpeg; charset=binary", []byte("jpeg")),
+			want:          []byte("jpeg"),
+			wantType:      "image/jpeg",
+			wantExtension: ".jpg",
+		},
+		{
+			name:          "content type detected",
+			user:          &notionapi.User{ID: "user_1", Type: notionapi.UserTypePerson, AvatarURL: "https://example.com/avatar"},
+			response:      newImageResponse("application/octet-stream", png),
+			want:          png,
+			wantType:      "image/png",
+			wantExtension: ".png",
+		},
+		{
+			name:          "partial user",
+			user:          &notionapi.User{Object: notionapi.ObjectTypeUser, ID: "user_2"},
+			response:      newImageResponse("image/png", png),
+			want:          png,
+			wantType:      "image/png",
+			wantExtension: ".png",
+		},
+		{
+			name:    "without avatar",
+			user:    &notionapi.User{ID: "user_3", Type: notionapi.UserTypePerson, Name: "Ada"},
+			wantErr: notionapi.ErrNoAvatar,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(func(req *http.Request) *http.Response {
+				switch req.URL.String() {
+				case "https://example.com/avatar":
+					return tt.response
+				case "https://api.notion.com/v1/users":
+					return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+						{"object":"user","id":"user_2","type":"person","avatar_url":"https://example.com/avatar"}]}`)
+				}
+				t.Errorf("unexpected request to %s", req.URL)
+				return newJSONResponse(http.StatusNotFound, "")
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			got, err := client.DownloadAvatar(context.Background(), tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DownloadAvatar() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer got.Close()
+			data, err := ioutil.ReadAll(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(data, tt.want) {
+				t.Errorf("DownloadAvatar() = %q, want %q", data, tt.want)
+			}
+			if got.ContentType != tt.wantType || got.Extension() != tt.wantExtension {
+				t.Errorf("DownloadAvatar() type = %s %s, want %s %s", got.ContentType, got.Extension(), tt.wantType, tt.wantExtension)
+			}
+		})
+	}
+}
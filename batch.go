@@ -0,0 +1,64 @@
+package notionapi
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultConcurrency is the number of requests the operations on many objects,
+// such as BlockService.DeleteBlocks and Client.CreatePages, send at the same
+// time, matching the average rate of requests Notion allows.
+const DefaultConcurrency = 3
+
+// forEachConcurrently calls fn with each index from 0 to n-1, concurrency at a
+// time, and returns the error of each call at its index. Once ctx is done, the
+// calls not started yet are not made, and their errors are ctx.Err().
+func forEachConcurrently(ctx context.Context, n, concurrency int, fn func(i int) error) []error {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	var (
+		errs = make([]error, n)
+		wg   sync.WaitGroup
+		jobs = make(chan int)
+	)
+	for w := 0; w < concurrency && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+
+dispatch:
+	for i := 0; i < n; i++ {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			for j := i; j < n; j++ {
+				errs[j] = ctx.Err()
+			}
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	return errs
+}
+
+// newBatchError returns a *BatchError of the errors of forEachConcurrently,
+// keyed by key, or nil when there are none.
+func newBatchError(errs []error, key func(i int) string) error {
+	failed := make(map[string]error)
+	for i, err := range errs {
+		if err != nil {
+			failed[key(i)] = err
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &BatchError{Errors: failed, Total: len(errs)}
+}
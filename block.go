@@ -3,9 +3,11 @@ package notionapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -20,7 +22,12 @@ type BlockService interface {
 	Get(context.Context, BlockID) (Block, error)
 	GetChildren(context.Context, BlockID, *Pagination) (*GetChildrenResponse, error)
 	Update(ctx context.Context, id BlockID, request *BlockUpdateRequest) (Block, error)
+	Patch(ctx context.Context, id BlockID, patch *BlockPatch) (Block, error)
 	Delete(context.Context, BlockID) (Block, error)
+	DeleteBlocks(context.Context, []BlockID) error
+	GetTree(context.Context, BlockID, *BlockTreeOptions) (*BlockNode, error)
+	ChildrenIterator(context.Context, BlockID) *BlockChildrenIterator
+	ResolveSyncedBlock(context.Context, *SyncedBlock) (Blocks, error)
 }
 
 type BlockClient struct {
@@ -33,15 +40,60 @@ type BlockClient struct {
 // Returns a paginated list of newly created first level children block objects.
 
 // Existing blocks cannot be moved using this endpoint. Blocks are appended to
-// the bottom of the parent block. Once a block is appended as a child, it can't
-// be moved elsewhere via the API.
+// the bottom of the parent block, or inserted after the child block set in
+// AppendBlockChildrenRequest.After. Once a block is appended as a child, it
+// can't be moved elsewhere via the API.
 
 // For blocks that allow children, we allow up to two levels of nesting in a
 // single request.
 //
+// The API accepts at most MaxAppendBlockChildren children per request: more
+// children are appended with sequential requests, each one inserting its
+// children after the last block appended by the previous one, and the
+// results of all requests are returned together.
+//
+// Blocks that cannot be created, such as link_preview blocks, are reported
+// with a *BlockNotCreatableError.
+//
 // See https://developers.notion.com/reference/patch-block-children
 func (bc *BlockClient) AppendChildren(ctx context.Context, id BlockID, requestBody *AppendBlockChildrenRequest) (*AppendBlockChildrenResponse, error) {
-	res, err := bc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("blocks/%s/children", id.String()), nil, requestBody, ContentTypeJSON)
+	if id == "" {
+		return nil, errors.New("empty block id")
+	}
+	if requestBody != nil {
+		if err := checkCreatable(requestBody.Children); err != nil {
+			return nil, err
+		}
+	}
+	if requestBody == nil || len(requestBody.Children) <= MaxAppendBlockChildren {
+		return bc.appendChildren(ctx, id, requestBody)
+	}
+
+	response := &AppendBlockChildrenResponse{Results: make([]Block, 0, len(requestBody.Children))}
+	after := requestBody.After
+	for start := 0; start < len(requestBody.Children); start += MaxAppendBlockChildren {
+		end := start + MaxAppendBlockChildren
+		if end > len(requestBody.Children) {
+			end = len(requestBody.Children)
+		}
+		chunk, err := bc.appendChildren(ctx, id, &AppendBlockChildrenRequest{
+			After:    after,
+			Children: requestBody.Children[start:end],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("appended %d of %d children: %w", start, len(requestBody.Children), err)
+		}
+		response.Object = chunk.Object
+		response.Results = append(response.Results, chunk.Results...)
+		if n := len(chunk.Results); n > 0 {
+			after = chunk.Results[n-1].GetID()
+		}
+	}
+	return response, nil
+}
+
+func (bc *BlockClient) appendChildren(ctx context.Context, id BlockID, requestBody *AppendBlockChildrenRequest) (*AppendBlockChildrenResponse, error) {
+	res, err := bc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("blocks/%s/children", pathID(id.String())), nil, requestBody, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +112,39 @@ func (bc *BlockClient) AppendChildren(ctx context.Context, id BlockID, requestBo
 	return &response, nil
 }
 
+// checkCreatable returns a *BlockNotCreatableError for the first of blocks,
+// or of their children at any depth, that the API only returns.
+func checkCreatable(blocks Blocks) error {
+	for _, block := range blocks {
+		if block == nil {
+			continue
+		}
+		// The children of the blocks are only reachable through their JSON.
+		nodes, err := NewBlockNodes(Blocks{block})
+		if err != nil {
+			return err
+		}
+		err = Walk(nodes[0], func(block Block, depth int) error {
+			switch block.GetType() {
+			case BlockTypeLinkPreview, BlockTypeTemplate:
+				return &BlockNotCreatableError{Type: block.GetType()}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MaxAppendBlockChildren is the maximum number of children the API accepts in
+// a single append request.
+const MaxAppendBlockChildren = 100
+
 type AppendBlockChildrenRequest struct {
-	// Append new children after a specific block. If empty, new children with be appended to the bottom of the parent block.
+	// Append new children after a specific child block of the parent. If empty,
+	// new children will be appended to the bottom of the parent block.
 	After BlockID `json:"after,omitempty"`
 	// Child content to append to a container block as an array of block objects.
 	Children []Block `json:"children"`
@@ -71,7 +154,11 @@ type AppendBlockChildrenRequest struct {
 //
 // Get https://developers.notion.com/reference/retrieve-a-block
 func (bc *BlockClient) Get(ctx context.Context, id BlockID) (Block, error) {
-	res, err := bc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("blocks/%s", id.String()), nil, nil, ContentTypeJSON)
+	if id == "" {
+		return nil, errors.New("empty block id")
+	}
+
+	res, err := bc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("blocks/%s", pathID(id.String())), nil, nil, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +183,11 @@ func (bc *BlockClient) Get(ctx context.Context, id BlockID) (Block, error) {
 //
 // See https://developers.notion.com/reference/get-block-children
 func (bc *BlockClient) GetChildren(ctx context.Context, id BlockID, pagination *Pagination) (*GetChildrenResponse, error) {
-	res, err := bc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("blocks/%s/children", id.String()), pagination.ToQuery(), nil, ContentTypeJSON)
+	if id == "" {
+		return nil, errors.New("empty block id")
+	}
+
+	res, err := bc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("blocks/%s/children", pathID(id.String())), pagination.ToQuery(), nil, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +214,63 @@ type GetChildrenResponse struct {
 	HasMore    bool       `json:"has_more"`
 }
 
+// ChildrenIterator returns an iterator over all the children of the block,
+// which retrieves the pages of results as they are needed.
+//
+//	it := client.Block.ChildrenIterator(ctx, id)
+//	for it.Next() {
+//		block := it.Block()
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle the error
+//	}
+func (bc *BlockClient) ChildrenIterator(ctx context.Context, id BlockID) *BlockChildrenIterator {
+	return &BlockChildrenIterator{ctx: ctx, client: bc, id: id}
+}
+
+// BlockChildrenIterator iterates over the children of a block, following
+// next_cursor. It is not safe for concurrent use.
+type BlockChildrenIterator struct {
+	ctx    context.Context
+	client *BlockClient
+	id     BlockID
+
+	blocks  Blocks
+	pager   cursorPager
+	current Block
+}
+
+// Next advances the iterator to the next child, which is then available
+// through Block. It returns false when there are no more children or an error
+// occurred.
+func (it *BlockChildrenIterator) Next() bool {
+	for len(it.blocks) == 0 {
+		if !it.pager.fetch(func(cursor Cursor) (Cursor, bool, error) {
+			res, err := it.client.GetChildren(it.ctx, it.id, &Pagination{StartCursor: cursor})
+			if err != nil {
+				return "", false, err
+			}
+			it.blocks = res.Results
+			return Cursor(res.NextCursor), res.HasMore, nil
+		}) {
+			it.current = nil
+			return false
+		}
+	}
+	it.current, it.blocks = it.blocks[0], it.blocks[1:]
+	return true
+}
+
+// Block returns the child the iterator is at.
+func (it *BlockChildrenIterator) Block() Block {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *BlockChildrenIterator) Err() error {
+	return it.pager.err
+}
+
 // Updates the content for the specified block_id based on the block type.
 // Supported fields based on the block object type (see Block object for
 // available fields and the expected input for each field).
@@ -132,7 +280,15 @@ type GetChildrenResponse struct {
 //
 // See https://developers.notion.com/reference/update-a-block
 func (bc *BlockClient) Update(ctx context.Context, id BlockID, requestBody *BlockUpdateRequest) (Block, error) {
-	res, err := bc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("blocks/%s", id.String()), nil, requestBody, ContentTypeJSON)
+	return bc.update(ctx, id, requestBody)
+}
+
+func (bc *BlockClient) update(ctx context.Context, id BlockID, requestBody interface{}) (Block, error) {
+	if id == "" {
+		return nil, errors.New("empty block id")
+	}
+
+	res, err := bc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("blocks/%s", pathID(id.String())), nil, requestBody, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -172,17 +328,26 @@ type BlockUpdateRequest struct {
 	Equation         *Equation  `json:"equation,omitempty"`
 	Quote            *Quote     `json:"quote,omitempty"`
 	TableRow         *TableRow  `json:"table_row,omitempty"`
+	Audio            *Audio     `json:"audio,omitempty"`
+	Table            *Table     `json:"table,omitempty"`
+	// Whether the block is archived. Set to false to restore a deleted block.
+	Archived *bool `json:"archived,omitempty"`
 }
 
 // Sets a Block object, including page blocks, to archived: true using the ID
 // specified. Note: in the Notion UI application, this moves the block to the
 // "Trash" where it can still be accessed and restored.
 //
-// To restore the block with the API, use the Update a block or Update page respectively.
+// To restore the block with the API, use Update with Archived set to false, or
+// the Update page endpoint for page blocks.
 //
 // See https://developers.notion.com/reference/delete-a-block
 func (bc *BlockClient) Delete(ctx context.Context, id BlockID) (Block, error) {
-	res, err := bc.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("blocks/%s", id.String()), nil, nil, ContentTypeJSON)
+	if id == "" {
+		return nil, errors.New("empty block id")
+	}
+
+	res, err := bc.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("blocks/%s", pathID(id.String())), nil, nil, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -213,8 +378,8 @@ type Block interface {
 	GetObject() ObjectType
 	GetCreatedTime() *time.Time
 	GetLastEditedTime() *time.Time
-	GetCreatedBy() *User
-	GetLastEditedBy() *User
+	GetCreatedBy() *PartialUser
+	GetLastEditedBy() *PartialUser
 	GetHasChildren() bool
 	GetArchived() bool
 	GetParent() *Parent
@@ -224,6 +389,10 @@ type Block interface {
 type Blocks []Block
 
 func (b *Blocks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
 	var err error
 	mapArr := make([]map[string]interface{}, 0)
 	if err = json.Unmarshal(data, &mapArr); err != nil {
@@ -245,16 +414,16 @@ func (b *Blocks) UnmarshalJSON(data []byte) error {
 // See https://developers.notion.com/reference/block for the list.
 // BasicBlock implements the Block interface.
 type BasicBlock struct {
-	Object         ObjectType `json:"object"`
-	ID             BlockID    `json:"id,omitempty"`
-	Type           BlockType  `json:"type"`
-	CreatedTime    *time.Time `json:"created_time,omitempty"`
-	LastEditedTime *time.Time `json:"last_edited_time,omitempty"`
-	CreatedBy      *User      `json:"created_by,omitempty"`
-	LastEditedBy   *User      `json:"last_edited_by,omitempty"`
-	HasChildren    bool       `json:"has_children,omitempty"`
-	Archived       bool       `json:"archived,omitempty"`
-	Parent         *Parent    `json:"parent,omitempty"`
+	Object         ObjectType   `json:"object"`
+	ID             BlockID      `json:"id,omitempty"`
+	Type           BlockType    `json:"type"`
+	CreatedTime    *time.Time   `json:"created_time,omitempty"`
+	LastEditedTime *time.Time   `json:"last_edited_time,omitempty"`
+	CreatedBy      *PartialUser `json:"created_by,omitempty"`
+	LastEditedBy   *PartialUser `json:"last_edited_by,omitempty"`
+	HasChildren    bool         `json:"has_children,omitempty"`
+	Archived       bool         `json:"archived,omitempty"`
+	Parent         *Parent      `json:"parent,omitempty"`
 }
 
 func (b BasicBlock) GetType() BlockType {
@@ -277,11 +446,11 @@ func (b BasicBlock) GetLastEditedTime() *time.Time {
 	return b.LastEditedTime
 }
 
-func (b BasicBlock) GetCreatedBy() *User {
+func (b BasicBlock) GetCreatedBy() *PartialUser {
 	return b.CreatedBy
 }
 
-func (b BasicBlock) GetLastEditedBy() *User {
+func (b BasicBlock) GetLastEditedBy() *PartialUser {
 	return b.LastEditedBy
 }
 
@@ -296,9 +465,16 @@ func (b BasicBlock) GetArchived() bool {
 func (b BasicBlock) GetParent() *Parent {
 	return b.Parent
 }
+
+// concatenateRichText returns the plain text of richtext. The content of text
+// objects is used when plain_text is not set, as in rich text built locally.
 func concatenateRichText(richtext []RichText) string {
 	var result string
 	for _, rt := range richtext {
+		if rt.PlainText == "" && rt.Text != nil {
+			result += rt.Text.Content
+			continue
+		}
 		result += rt.PlainText
 	}
 	return result
@@ -380,6 +556,10 @@ func (b LinkPreviewBlock) GetRichTextString() string {
 	return b.LinkPreview.URL
 }
 
+func (b CodeBlock) GetRichTextString() string {
+	return concatenateRichText(b.Code.RichText)
+}
+
 func (b EquationBlock) GetRichTextString() string {
 	return b.Equation.Expression
 }
@@ -508,9 +688,12 @@ type Toggle struct {
 
 type ChildPageBlock struct {
 	BasicBlock
-	ChildPage struct {
-		Title string `json:"title"`
-	} `json:"child_page"`
+	ChildPage ChildPage `json:"child_page"`
+}
+
+// NOTE: will only be returned by the API. Child pages are created with PageService.Create.
+type ChildPage struct {
+	Title string `json:"title"`
 }
 
 type EmbedBlock struct {
@@ -553,10 +736,11 @@ type AudioBlock struct {
 }
 
 type Audio struct {
-	Caption  []RichText  `json:"caption,omitempty"`
-	Type     FileType    `json:"type"`
-	File     *FileObject `json:"file,omitempty"`
-	External *FileObject `json:"external,omitempty"`
+	Caption    []RichText  `json:"caption,omitempty"`
+	Type       FileType    `json:"type"`
+	File       *FileObject `json:"file,omitempty"`
+	External   *FileObject `json:"external,omitempty"`
+	FileUpload *FileUpload `json:"file_upload,omitempty"`
 }
 
 // GetURL returns the external or internal URL depending on the image type.
@@ -578,7 +762,105 @@ type CodeBlock struct {
 type Code struct {
 	RichText []RichText `json:"rich_text"`
 	Caption  []RichText `json:"caption,omitempty"`
-	Language string     `json:"language"`
+	// Language is one of the CodeLanguage constants.
+	Language string `json:"language"`
+}
+
+// CodeLanguage is the language of a code block. The API rejects code blocks
+// with a language other than the CodeLanguage constants.
+type CodeLanguage string
+
+func (l CodeLanguage) String() string {
+	return string(l)
+}
+
+// IsValid reports whether l is one of the languages the API accepts.
+func (l CodeLanguage) IsValid() bool {
+	_, ok := codeLanguages[l]
+	return ok
+}
+
+var codeLanguages = func() map[CodeLanguage]struct{} {
+	languages := make(map[CodeLanguage]struct{}, len(CodeLanguages))
+	for _, l := range CodeLanguages {
+		languages[l] = struct{}{}
+	}
+	return languages
+}()
+
+var codeLanguageAliases = map[string]CodeLanguage{
+	"":           CodeLanguagePlainText,
+	"text":       CodeLanguagePlainText,
+	"txt":        CodeLanguagePlainText,
+	"plaintext":  CodeLanguagePlainText,
+	"js":         CodeLanguageJavaScript,
+	"jsx":        CodeLanguageJavaScript,
+	"ts":         CodeLanguageTypeScript,
+	"tsx":        CodeLanguageTypeScript,
+	"py":         CodeLanguagePython,
+	"rb":         CodeLanguageRuby,
+	"rs":         CodeLanguageRust,
+	"golang":     CodeLanguageGo,
+	"sh":         CodeLanguageShell,
+	"zsh":        CodeLanguageShell,
+	"console":    CodeLanguageShell,
+	"yml":        CodeLanguageYAML,
+	"md":         CodeLanguageMarkdown,
+	"cpp":        CodeLanguageCPP,
+	"cc":         CodeLanguageCPP,
+	"cs":         CodeLanguageCSharp,
+	"csharp":     CodeLanguageCSharp,
+	"fs":         CodeLanguageFSharp,
+	"fsharp":     CodeLanguageFSharp,
+	"kt":         CodeLanguageKotlin,
+	"objc":       CodeLanguageObjectiveC,
+	"ps1":        CodeLanguagePowerShell,
+	"tex":        CodeLanguageLaTeX,
+	"dockerfile": CodeLanguageDocker,
+	"proto":      CodeLanguageProtobuf,
+	"vb":         CodeLanguageVisualBasic,
+	"wasm":       CodeLanguageWebAssembly,
+	"make":       CodeLanguageMakefile,
+	"ascii":      CodeLanguageASCIIArt,
+	"asm":        CodeLanguageAssembly,
+	"ml":         CodeLanguageOCaml,
+	"hs":         CodeLanguageHaskell,
+	"ex":         CodeLanguageElixir,
+	"exs":        CodeLanguageElixir,
+	"erl":        CodeLanguageErlang,
+	"clj":        CodeLanguageClojure,
+	"coffee":     CodeLanguageCoffeeScript,
+	"gql":        CodeLanguageGraphQL,
+	"jl":         CodeLanguageJulia,
+	"pl":         CodeLanguagePerl,
+	"terraform":  CodeLanguageHCL,
+	"tf":         CodeLanguageHCL,
+	"vbnet":      CodeLanguageVBNet,
+	"htm":        CodeLanguageHTML,
+	"svg":        CodeLanguageXML,
+	"patch":      CodeLanguageDiff,
+}
+
+// NormalizeCodeLanguage returns the CodeLanguage of a language name as used
+// in Markdown code fences, such as "golang" or "py". It reports false, along
+// with CodeLanguagePlainText, if the language is not supported.
+func NormalizeCodeLanguage(name string) (CodeLanguage, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if l, ok := codeLanguageAliases[name]; ok {
+		return l, true
+	}
+	if l := CodeLanguage(name); l.IsValid() {
+		return l, true
+	}
+	if l := CodeLanguage(strings.Replace(name, "-", " ", -1)); l.IsValid() {
+		return l, true
+	}
+	return CodeLanguagePlainText, false
+}
+
+// GetCaptionString returns the plain text of the caption of the code block.
+func (c CodeBlock) GetCaptionString() string {
+	return concatenateRichText(c.Code.Caption)
 }
 
 type VideoBlock struct {
@@ -587,10 +869,22 @@ type VideoBlock struct {
 }
 
 type Video struct {
-	Caption  []RichText  `json:"caption,omitempty"`
-	Type     FileType    `json:"type"`
-	File     *FileObject `json:"file,omitempty"`
-	External *FileObject `json:"external,omitempty"`
+	Caption    []RichText  `json:"caption,omitempty"`
+	Type       FileType    `json:"type"`
+	File       *FileObject `json:"file,omitempty"`
+	External   *FileObject `json:"external,omitempty"`
+	FileUpload *FileUpload `json:"file_upload,omitempty"`
+}
+
+// GetURL returns the external or internal URL depending on the video type.
+func (v Video) GetURL() string {
+	if v.File != nil {
+		return v.File.URL
+	}
+	if v.External != nil {
+		return v.External.URL
+	}
+	return ""
 }
 
 type FileBlock struct {
@@ -599,10 +893,13 @@ type FileBlock struct {
 }
 
 type BlockFile struct {
-	Caption  []RichText  `json:"caption,omitempty"`
-	Type     FileType    `json:"type"`
-	File     *FileObject `json:"file,omitempty"`
-	External *FileObject `json:"external,omitempty"`
+	Caption    []RichText  `json:"caption,omitempty"`
+	Type       FileType    `json:"type"`
+	File       *FileObject `json:"file,omitempty"`
+	External   *FileObject `json:"external,omitempty"`
+	FileUpload *FileUpload `json:"file_upload,omitempty"`
+	// Name of the file as displayed in Notion.
+	Name string `json:"name,omitempty"`
 }
 
 type PdfBlock struct {
@@ -611,10 +908,11 @@ type PdfBlock struct {
 }
 
 type Pdf struct {
-	Caption  []RichText  `json:"caption,omitempty"`
-	Type     FileType    `json:"type,omitempty"`
-	File     *FileObject `json:"file,omitempty"`
-	External *FileObject `json:"external,omitempty"`
+	Caption    []RichText  `json:"caption,omitempty"`
+	Type       FileType    `json:"type,omitempty"`
+	File       *FileObject `json:"file,omitempty"`
+	External   *FileObject `json:"external,omitempty"`
+	FileUpload *FileUpload `json:"file_upload,omitempty"`
 }
 
 type BookmarkBlock struct {
@@ -629,9 +927,12 @@ type Bookmark struct {
 
 type ChildDatabaseBlock struct {
 	BasicBlock
-	ChildDatabase struct {
-		Title string `json:"title"`
-	} `json:"child_database"`
+	ChildDatabase ChildDatabase `json:"child_database"`
+}
+
+// NOTE: will only be returned by the API. Child databases are created with DatabaseService.Create.
+type ChildDatabase struct {
+	Title string `json:"title"`
 }
 
 type TableOfContentsBlock struct {
@@ -692,7 +993,8 @@ type ColumnList struct {
 	Children Blocks `json:"children"`
 }
 
-// NOTE: will only be returned by the API. Cannot be created by the API.
+// NOTE: will only be returned by the API. Cannot be created by the API:
+// BlockService.AppendChildren returns a *BlockNotCreatableError for them.
 // https://developers.notion.com/reference/block#link-preview-blocks
 type LinkPreviewBlock struct {
 	BasicBlock
@@ -714,6 +1016,13 @@ type LinkToPage struct {
 	DatabaseID DatabaseID `json:"database_id,omitempty"`
 }
 
+// TemplateBlock is a template button: the blocks of Children are duplicated
+// when the button is clicked. Template blocks are returned by the API, with
+// their children retrieved like those of any block, but can no longer be
+// created: BlockService.AppendChildren returns a *BlockNotCreatableError for
+// them.
+//
+// See https://developers.notion.com/reference/block#template
 type TemplateBlock struct {
 	BasicBlock
 	Template Template `json:"template"`
@@ -739,8 +1048,23 @@ type SyncedFrom struct {
 	BlockID BlockID `json:"block_id"`
 }
 
+// UnsupportedBlock is a block of a type this package does not know, or of
+// the unsupported type returned for blocks the API does not expose. The
+// content of the block is kept in Raw, so it is not lost when the block is
+// marshaled again.
 type UnsupportedBlock struct {
 	BasicBlock
+	// Raw is the JSON of the block, as returned by the API.
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON returns Raw if it is set, the basic fields of the block
+// otherwise.
+func (b UnsupportedBlock) MarshalJSON() ([]byte, error) {
+	if len(b.Raw) > 0 {
+		return b.Raw, nil
+	}
+	return json.Marshal(b.BasicBlock)
 }
 
 type AppendBlockChildrenResponse struct {
@@ -776,7 +1100,8 @@ func (r *AppendBlockChildrenResponse) UnmarshalJSON(data []byte) error {
 
 func decodeBlock(raw map[string]interface{}) (Block, error) {
 	var b Block
-	switch BlockType(raw["type"].(string)) {
+	blockType, _ := raw["type"].(string)
+	switch BlockType(blockType) {
 	case BlockTypeParagraph:
 		b = &ParagraphBlock{}
 	case BlockTypeHeading1:
@@ -807,6 +1132,8 @@ func decodeBlock(raw map[string]interface{}) (Block, error) {
 		b = &ImageBlock{}
 	case BlockTypeVideo:
 		b = &VideoBlock{}
+	case BlockTypeAudio:
+		b = &AudioBlock{}
 	case BlockTypeFile:
 		b = &FileBlock{}
 	case BlockTypePdf:
@@ -839,11 +1166,8 @@ func decodeBlock(raw map[string]interface{}) (Block, error) {
 		b = &TableBlock{}
 	case BlockTypeTableRowBlock:
 		b = &TableRowBlock{}
-
-	case BlockTypeUnsupported:
-		b = &UnsupportedBlock{}
 	default:
-		return &UnsupportedBlock{}, nil
+		return decodeUnsupportedBlock(raw)
 	}
 	j, err := json.Marshal(raw)
 	if err != nil {
@@ -853,3 +1177,15 @@ func decodeBlock(raw map[string]interface{}) (Block, error) {
 	err = json.Unmarshal(j, b)
 	return b, err
 }
+
+func decodeUnsupportedBlock(raw map[string]interface{}) (Block, error) {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	b := &UnsupportedBlock{Raw: j}
+	if err = json.Unmarshal(j, &b.BasicBlock); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
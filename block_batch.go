@@ -0,0 +1,17 @@
+package notionapi
+
+import "context"
+
+// DeleteBlocks deletes (archives) the blocks with the IDs specified,
+// DefaultConcurrency at a time. Requests that are rate limited are
+// retried like any other, and WithRateLimiter can be used to pace them.
+//
+// The blocks are all attempted even if some fail, unless ctx is done: the
+// failures are then reported with a *BatchError.
+func (bc *BlockClient) DeleteBlocks(ctx context.Context, ids []BlockID) error {
+	errs := forEachConcurrently(ctx, len(ids), DefaultConcurrency, func(i int) error {
+		_, err := bc.Delete(ctx, ids[i])
+		return err
+	})
+	return newBatchError(errs, func(i int) string { return ids[i].String() })
+}
@@ -0,0 +1,383 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// CopyBlockTreeOptions configures Client.CopyBlockTree.
+type CopyBlockTreeOptions struct {
+	// IDs maps the IDs of pages, databases or blocks to the IDs that replace
+	// them in the copies, in mentions, links, link_to_page and synced blocks.
+	// The blocks copied are added to it as they are created, so links to
+	// blocks copied before the link are remapped as well.
+	IDs map[string]string
+	// ReuploadFiles downloads the files hosted by Notion and uploads them
+	// again for the copies. Otherwise the copies reference the temporary URLs
+	// of the files, which expire after an hour.
+	ReuploadFiles bool
+	// Variables are substituted for the {{name}} placeholders of the text and
+	// URLs of the copies. Placeholders without a variable are kept, as are
+	// placeholders whose characters are not all formatted the same, which
+	// Notion splits in several rich texts.
+	Variables map[string]string
+}
+
+// CopyBlockTreeResult is the result of Client.CopyBlockTree.
+type CopyBlockTreeResult struct {
+	// IDs maps the IDs of the blocks copied to the IDs of their copies.
+	IDs map[BlockID]BlockID
+	// Skipped lists the blocks that cannot be created through the API, such
+	// as child pages, and were not copied along with their descendants.
+	Skipped []BlockID
+}
+
+// CopyBlockTree copies the block with the ID specified and all of its
+// descendants to the end of the children of destParent, which is a page or a
+// block. If source is a page, its content is copied. Notion has no way to copy
+// blocks server side: the blocks are retrieved and created again, level by
+// level.
+func (c *Client) CopyBlockTree(ctx context.Context, source BlockID, destParent BlockID, opts *CopyBlockTreeOptions) (*CopyBlockTreeResult, error) {
+	if opts == nil {
+		opts = &CopyBlockTreeOptions{}
+	}
+	tree, err := c.Block.GetTree(ctx, source, &BlockTreeOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	copier := newBlockCopier(c, opts)
+	nodes := []*BlockNode{tree}
+	if tree.Block.GetType() == BlockTypeChildPage {
+		nodes = tree.Children
+	}
+	if _, err = copier.copyNodes(ctx, destParent, "", nodes); err != nil {
+		return nil, err
+	}
+	return copier.result, nil
+}
+
+type blockCopier struct {
+	client *Client
+	opts   *CopyBlockTreeOptions
+	// ids holds the IDs to remap, without dashes.
+	ids    map[string]string
+	result *CopyBlockTreeResult
+}
+
+func newBlockCopier(c *Client, opts *CopyBlockTreeOptions) *blockCopier {
+	bc := &blockCopier{
+		client: c,
+		opts:   opts,
+		ids:    make(map[string]string, len(opts.IDs)),
+		result: &CopyBlockTreeResult{IDs: make(map[BlockID]BlockID)},
+	}
+	for from, to := range opts.IDs {
+		bc.addID(from, to)
+	}
+	return bc
+}
+
+func (bc *blockCopier) addID(from, to string) {
+	// only actual IDs are remapped, not to replace arbitrary text
+	if from, to := strings.Replace(from, "-", "", -1), strings.Replace(to, "-", "", -1); len(from) == 32 && len(to) == 32 {
+		bc.ids[from] = to
+	}
+	if bc.opts.IDs != nil {
+		bc.opts.IDs[from] = to
+	}
+}
+
+// isCopyable reports whether blocks of the type can be created through the
+// API.
+func isCopyable(block Block) bool {
+	if _, ok := block.(*UnsupportedBlock); ok {
+		return false
+	}
+	switch block.GetType() {
+	case BlockTypeChildPage, BlockTypeChildDatabase, BlockTypeLinkPreview, BlockTypeTemplate, BlockTypeUnsupported:
+		return false
+	}
+	return true
+}
+
+// copyNodes copies nodes and their descendants to parent, after the child of
+// parent after or at its end if empty, and returns the copies of nodes.
+func (bc *blockCopier) copyNodes(ctx context.Context, parent, after BlockID, nodes []*BlockNode) ([]Block, error) {
+	var (
+		blocks  []Block
+		sources []*BlockNode
+	)
+	for _, node := range nodes {
+		if !isCopyable(node.Block) {
+			bc.result.Skipped = append(bc.result.Skipped, node.Block.GetID())
+			continue
+		}
+		block, err := bc.creatable(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+		sources = append(sources, node)
+	}
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	res, err := bc.client.Block.AppendChildren(ctx, parent, &AppendBlockChildrenRequest{After: after, Children: blocks})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Results) != len(sources) {
+		return nil, fmt.Errorf("appended %d blocks to %s, got %d results", len(sources), parent, len(res.Results))
+	}
+	for i, created := range res.Results {
+		if id := sources[i].Block.GetID(); id != "" {
+			bc.result.IDs[id] = created.GetID()
+			bc.addID(id.String(), created.GetID().String())
+		}
+	}
+
+	for i, created := range res.Results {
+		source := sources[i]
+		switch source.Block.GetType() {
+		case BlockTypeTableBlock:
+			// rows are created along with the table
+		case BlockTypeColumnList:
+			if err = bc.copyColumns(ctx, created.GetID(), source.Children); err != nil {
+				return nil, err
+			}
+		default:
+			if synced, ok := source.Block.(*SyncedBlock); ok && !synced.IsOriginal() {
+				// duplicates show the content of their original
+				continue
+			}
+			if len(source.Children) > 0 {
+				if _, err = bc.copyNodes(ctx, created.GetID(), "", source.Children); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return res.Results, nil
+}
+
+// copyColumns copies the content of columns to the columns of a column list
+// created with placeholders, which are then deleted. Columns cannot be
+// created empty, and creating them along with their content would exceed the
+// two levels of nesting allowed per request.
+func (bc *blockCopier) copyColumns(ctx context.Context, columnList BlockID, columns []*BlockNode) error {
+	created, err := bc.client.Block.GetTree(ctx, columnList, &BlockTreeOptions{MaxDepth: 2})
+	if err != nil {
+		return err
+	}
+	if len(created.Children) != len(columns) {
+		return fmt.Errorf("column list %s has %d columns, want %d", columnList, len(created.Children), len(columns))
+	}
+	for i, column := range created.Children {
+		if id := columns[i].Block.GetID(); id != "" {
+			bc.result.IDs[id] = column.Block.GetID()
+		}
+		if _, err = bc.copyNodes(ctx, column.Block.GetID(), "", columns[i].Children); err != nil {
+			return err
+		}
+		for _, placeholder := range column.Children {
+			if _, err = bc.client.Block.Delete(ctx, placeholder.Block.GetID()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// blockMetadata lists the fields of blocks set by the API.
+var blockMetadata = []string{
+	"id", "created_time", "last_edited_time", "created_by", "last_edited_by",
+	"has_children", "archived", "in_trash", "parent", "request_id",
+}
+
+// creatable returns a copy of the block of node that can be appended.
+func (bc *blockCopier) creatable(ctx context.Context, node *BlockNode) (Block, error) {
+	raw, err := bc.blockJSON(ctx, node.Block)
+	if err != nil {
+		return nil, err
+	}
+	content, _ := raw[node.Block.GetType().String()].(map[string]interface{})
+
+	switch node.Block.GetType() {
+	case BlockTypeTableBlock:
+		rows := make([]interface{}, 0, len(node.Children))
+		for _, row := range node.Children {
+			rowJSON, err := bc.blockJSON(ctx, row.Block)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, rowJSON)
+		}
+		content["children"] = rows
+	case BlockTypeColumnList:
+		columns := make([]interface{}, 0, len(node.Children))
+		for range node.Children {
+			columns = append(columns, map[string]interface{}{
+				"object": ObjectTypeBlock,
+				"type":   BlockTypeColumn,
+				"column": map[string]interface{}{
+					"children": []interface{}{map[string]interface{}{
+						"object":    ObjectTypeBlock,
+						"type":      BlockTypeParagraph,
+						"paragraph": map[string]interface{}{"rich_text": []interface{}{}},
+					}},
+				},
+			})
+		}
+		content["children"] = columns
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &UnsupportedBlock{
+		BasicBlock: BasicBlock{Object: ObjectTypeBlock, Type: node.Block.GetType()},
+		Raw:        data,
+	}, nil
+}
+
+// blockJSON returns the JSON of block without the fields set by the API, its
+// files reuploaded or referenced by URL, and its IDs remapped.
+func (bc *blockCopier) blockJSON(ctx context.Context, block Block) (map[string]interface{}, error) {
+	data, err := json.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]interface{}
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	for _, key := range blockMetadata {
+		delete(raw, key)
+	}
+
+	content, ok := raw[block.GetType().String()].(map[string]interface{})
+	if !ok {
+		return raw, nil
+	}
+	delete(content, "children")
+	if file, ok := block.(DownloadableFileBlock); ok && content["type"] == string(FileTypeFile) {
+		if err = bc.copyFile(ctx, file, content); err != nil {
+			return nil, err
+		}
+	}
+	raw = bc.remapIDs(raw).(map[string]interface{})
+	expandVariables(raw, bc.opts.Variables)
+	return raw, nil
+}
+
+// copyFile replaces the file hosted by Notion of content, the content of a
+// file block or a file object, with a file that can be used to create blocks.
+func (bc *blockCopier) copyFile(ctx context.Context, file DownloadableFile, content map[string]interface{}) error {
+	delete(content, string(FileTypeFile))
+	if !bc.opts.ReuploadFiles {
+		content["type"] = FileTypeExternal
+		content[string(FileTypeExternal)] = map[string]interface{}{"url": file.GetURL()}
+		return nil
+	}
+
+	dir, err := ioutil.TempDir("", "notionapi")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, fileNameFromURL(file.GetURL()))
+	if err = bc.client.mirrorFile(ctx, file, filePath); err != nil {
+		return err
+	}
+	upload, err := bc.client.FileUpload.UploadFile(ctx, filePath, nil)
+	if err != nil {
+		return err
+	}
+	content["type"] = FileTypeFileUpload
+	content[string(FileTypeFileUpload)] = map[string]interface{}{"id": upload.FileUpload.ID}
+	return nil
+}
+
+// remapIDs replaces the IDs of bc.ids in the strings of value.
+func (bc *blockCopier) remapIDs(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = bc.remapIDs(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = bc.remapIDs(child)
+		}
+	case string:
+		return bc.remapString(v)
+	}
+	return value
+}
+
+func (bc *blockCopier) remapString(s string) string {
+	for from, to := range bc.ids {
+		if strings.Contains(s, from) {
+			s = strings.Replace(s, from, to, -1)
+		}
+		if dashed := dashedID(from); strings.Contains(s, dashed) {
+			s = strings.Replace(s, dashed, dashedID(to), -1)
+		}
+	}
+	return s
+}
+
+// placeholderRe matches the placeholders of CopyBlockTreeOptions.Variables.
+var placeholderRe = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+// variableKeys lists the keys of the JSON of blocks and properties whose
+// values are expanded.
+var variableKeys = map[string]bool{
+	"content": true, "plain_text": true, "expression": true,
+	"url": true, "email": true, "phone_number": true,
+}
+
+// expandVariables substitutes vars for the placeholders of the text and URLs
+// of value, the JSON of blocks or properties.
+func expandVariables(value interface{}, vars map[string]string) {
+	if len(vars) == 0 {
+		return
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if s, ok := child.(string); ok && variableKeys[key] {
+				v[key] = placeholderRe.ReplaceAllStringFunc(s, func(placeholder string) string {
+					if value, ok := vars[placeholderRe.FindStringSubmatch(placeholder)[1]]; ok {
+						return value
+					}
+					return placeholder
+				})
+				continue
+			}
+			expandVariables(child, vars)
+		}
+	case []interface{}:
+		for _, child := range v {
+			expandVariables(child, vars)
+		}
+	}
+}
+
+// dashedID returns a 32 characters ID in the 8-4-4-4-12 form, or id itself.
+func dashedID(id string) string {
+	if len(id) != 32 {
+		return id
+	}
+	return id[:8] + "-" + id[8:12] + "-" + id[12:16] + "-" + id[16:20] + "-" + id[20:]
+}
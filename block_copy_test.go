@@ -0,0 +1,176 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientCopyBlockTree(t *testing.T) {
+	const (
+		page      = "11111111-1111-1111-1111-111111111111"
+		paragraph = "22222222-2222-2222-2222-222222222222"
+		toggle    = "33333333-3333-3333-3333-333333333333"
+		linked    = "44444444-4444-4444-4444-444444444444"
+		relinked  = "55555555-5555-5555-5555-555555555555"
+	)
+	responses := map[string]string{
+		"/v1/blocks/" + page: `{"object":"block","id":"` + page + `","type":"child_page","has_children":true,"child_page":{"title":"Source"}}`,
+		"/v1/blocks/" + page + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"` + paragraph + `","type":"paragraph","created_time":"2021-05-24T05:06:34.827Z","paragraph":{"rich_text":[
+				{"type":"mention","mention":{"type":"page","page":{"id":"` + linked + `"}},"plain_text":"Linked"}]}},
+			{"object":"block","id":"` + toggle + `","type":"toggle","has_children":true,"toggle":{"rich_text":[]}},
+			{"object":"block","id":"child","type":"child_page","has_children":false,"child_page":{"title":"Child"}},
+			{"object":"block","id":"image","type":"image","image":{"type":"file","file":{"url":"https://files.example.com/cat.png","expiry_time":"2021-05-24T06:06:34.827Z"}}}]}`,
+		"/v1/blocks/" + toggle + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"nested","type":"paragraph","paragraph":{"rich_text":[
+				{"type":"text","text":{"content":"see","link":{"url":"/` + strings.Replace(paragraph, "-", "", -1) + `"}},"plain_text":"see"}]}}]}`,
+	}
+
+	var (
+		appends = map[string][]map[string]interface{}{}
+		created int
+	)
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodPatch {
+			body, ok := responses[req.URL.Path]
+			if !ok {
+				t.Fatalf("unexpected request to %s", req.URL)
+			}
+			return newJSONResponse(http.StatusOK, body)
+		}
+
+		var body struct {
+			Children []map[string]interface{} `json:"children"`
+		}
+		data, _ := ioutil.ReadAll(req.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Fatal(err)
+		}
+		parent := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/v1/blocks/"), "/children")
+		appends[parent] = body.Children
+
+		results := make([]string, len(body.Children))
+		for i, child := range body.Children {
+			created++
+			results[i] = fmt.Sprintf(`{"object":"block","id":"%032d","type":"%s","%s":{}}`, created, child["type"], child["type"])
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","results":[`+strings.Join(results, ",")+`]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	got, err := client.CopyBlockTree(context.Background(), page, "dest", &notionapi.CopyBlockTreeOptions{
+		IDs: map[string]string{linked: relinked},
+	})
+	if err != nil {
+		t.Fatalf("CopyBlockTree() error = %v", err)
+	}
+
+	if want := []notionapi.BlockID{"child"}; !reflect.DeepEqual(got.Skipped, want) {
+		t.Errorf("CopyBlockTree() skipped = %v, want %v", got.Skipped, want)
+	}
+	if got.IDs[toggle] != notionapi.BlockID(fmt.Sprintf("%032d", 2)) {
+		t.Errorf("CopyBlockTree() ids = %v", got.IDs)
+	}
+
+	top := appends["dest"]
+	if len(top) != 3 {
+		t.Fatalf("CopyBlockTree() appended %d blocks to dest, want 3", len(top))
+	}
+	if _, ok := top[0]["id"]; ok {
+		t.Error("CopyBlockTree() sent the id of the source block")
+	}
+	if _, ok := top[0]["created_time"]; ok {
+		t.Error("CopyBlockTree() sent the created_time of the source block")
+	}
+	mention, _ := json.Marshal(top[0]["paragraph"])
+	if !strings.Contains(string(mention), relinked) {
+		t.Errorf("CopyBlockTree() did not remap the mention: %s", mention)
+	}
+	image, _ := json.Marshal(top[2]["image"])
+	if want := `{"external":{"url":"https://files.example.com/cat.png"},"type":"external"}`; string(image) != want {
+		t.Errorf("CopyBlockTree() image = %s, want %s", image, want)
+	}
+
+	// the IDs in the paths of requests are dashed
+	nested, _ := json.Marshal(appends["00000000-0000-0000-0000-000000000002"])
+	if !strings.Contains(string(nested), fmt.Sprintf("/%032d", 1)) {
+		t.Errorf("CopyBlockTree() did not remap the link to the copied paragraph: %s", nested)
+	}
+}
+
+func TestClientCopyBlockTreeSyncedBlocks(t *testing.T) {
+	const (
+		page      = "11111111-1111-1111-1111-111111111111"
+		original  = "22222222-2222-2222-2222-222222222222"
+		duplicate = "33333333-3333-3333-3333-333333333333"
+	)
+	content := `{"object":"list","has_more":false,"results":[
+		{"object":"block","id":"content","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"synced"},"plain_text":"synced"}]}}]}`
+	responses := map[string]string{
+		"/v1/blocks/" + page: `{"object":"block","id":"` + page + `","type":"child_page","has_children":true,"child_page":{"title":"Source"}}`,
+		"/v1/blocks/" + page + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"` + original + `","type":"synced_block","has_children":true,"synced_block":{"synced_from":null}},
+			{"object":"block","id":"` + duplicate + `","type":"synced_block","has_children":true,"synced_block":{"synced_from":{"type":"block_id","block_id":"` + original + `"}}}]}`,
+		// the children of a duplicate are those of its original
+		"/v1/blocks/" + original + "/children":  content,
+		"/v1/blocks/" + duplicate + "/children": content,
+	}
+
+	var (
+		appends = map[string][]map[string]interface{}{}
+		created int
+	)
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodPatch {
+			body, ok := responses[req.URL.Path]
+			if !ok {
+				t.Fatalf("unexpected request to %s", req.URL)
+			}
+			return newJSONResponse(http.StatusOK, body)
+		}
+
+		var body struct {
+			Children []map[string]interface{} `json:"children"`
+		}
+		data, _ := ioutil.ReadAll(req.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Fatal(err)
+		}
+		parent := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/v1/blocks/"), "/children")
+		appends[parent] = body.Children
+
+		results := make([]string, len(body.Children))
+		for i, child := range body.Children {
+			created++
+			results[i] = fmt.Sprintf(`{"object":"block","id":"%032d","type":"%s","%s":{}}`, created, child["type"], child["type"])
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","results":[`+strings.Join(results, ",")+`]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	if _, err := client.CopyBlockTree(context.Background(), page, "dest", nil); err != nil {
+		t.Fatalf("CopyBlockTree() error = %v", err)
+	}
+
+	var parents []string
+	for parent := range appends {
+		parents = append(parents, parent)
+	}
+	sort.Strings(parents)
+	if want := []string{"00000000-0000-0000-0000-000000000001", "dest"}; !reflect.DeepEqual(parents, want) {
+		t.Errorf("CopyBlockTree() appended children to %v, want %v", parents, want)
+	}
+	synced, _ := json.Marshal(appends["dest"][1]["synced_block"])
+	if !strings.Contains(string(synced), `"synced_from":{"block_id":"`+original+`"`) {
+		t.Errorf("CopyBlockTree() duplicate = %s, want a duplicate of %s", synced, original)
+	}
+}
@@ -0,0 +1,527 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type BlockOpType string
+
+func (t BlockOpType) String() string {
+	return string(t)
+}
+
+const (
+	BlockOpInsert BlockOpType = "insert"
+	BlockOpUpdate BlockOpType = "update"
+	BlockOpDelete BlockOpType = "delete"
+	BlockOpMove   BlockOpType = "move"
+)
+
+// BlockOp is an operation of a block tree diff, as returned by
+// DiffBlockTrees.
+type BlockOp struct {
+	Type BlockOpType
+	// Parent is the ID of the parent of the blocks inserted or moved. It is
+	// empty for the top level of the trees.
+	Parent BlockID
+	// After is the ID of the block the blocks inserted or moved follow. It is
+	// empty to add them at the end of Parent. The blocks of consecutive
+	// operations with the same Parent and After follow each other, in the
+	// order of the operations.
+	After BlockID
+	// ID is the ID of the block updated, deleted or moved.
+	ID BlockID
+	// Nodes are the blocks inserted, or the new content of the block updated
+	// or moved. The children of the block updated are diffed separately.
+	Nodes []*BlockNode
+}
+
+// DiffBlockTrees returns the operations turning the blocks of old, as
+// retrieved with BlockService.GetTree, into the blocks of new, such as blocks
+// parsed from Markdown and turned into nodes with NewBlockNodes.
+//
+// Blocks with the same content are kept, blocks whose content changed are
+// updated when the API allows it, and blocks moved among their siblings are
+// reported as such. Notion cannot move blocks: moving a block recreates it.
+// Blocks of old that cannot be created through the API, such as child pages,
+// are never deleted.
+//
+// The API can only insert blocks after an existing block: when new blocks
+// come before all the blocks kept of their parent, all the blocks of the
+// parent are replaced.
+func DiffBlockTrees(old, new []*BlockNode) ([]BlockOp, error) {
+	d := &blockDiffer{}
+	if err := d.diff("", old, new); err != nil {
+		return nil, err
+	}
+	return d.ops, nil
+}
+
+type blockDiffer struct {
+	ops []BlockOp
+}
+
+// blockEdit is the fate of a block of old or new among its siblings.
+type blockEdit struct {
+	op     BlockOpType
+	old    int
+	new    int
+	anchor BlockID
+}
+
+func (d *blockDiffer) diff(parent BlockID, old, new []*BlockNode) error {
+	oldSigs, err := blockSignatures(old)
+	if err != nil {
+		return err
+	}
+	newSigs, err := blockSignatures(new)
+	if err != nil {
+		return err
+	}
+
+	// the blocks that cannot be created are kept, out of the matching
+	var candidates []int
+	for i, node := range old {
+		if isCopyable(node.Block) {
+			candidates = append(candidates, i)
+		}
+	}
+	matches := longestCommonSubsequence(candidates, oldSigs, newSigs)
+
+	var (
+		edits    []blockEdit
+		kept     = len(old) > len(candidates)
+		anchored = true
+		oi, ni   int
+		anchor   BlockID
+	)
+	for _, m := range append(matches, [2]int{len(old), len(new)}) {
+		var gapOld []int
+		for ; oi < m[0]; oi++ {
+			if isCopyable(old[oi].Block) {
+				gapOld = append(gapOld, oi)
+			}
+		}
+		gapNew := make([]int, 0, m[1]-ni)
+		for ; ni < m[1]; ni++ {
+			gapNew = append(gapNew, ni)
+		}
+
+		// the blocks of the gap are paired by position to be updated
+		paired := make(map[int]bool, len(gapOld))
+		for k, j := range gapNew {
+			if k < len(gapOld) {
+				i := gapOld[k]
+				ok, err := isUpdatable(old[i].Block, new[j].Block)
+				if err != nil {
+					return err
+				}
+				if ok {
+					edits = append(edits, blockEdit{op: BlockOpUpdate, old: i, new: j})
+					paired[k] = true
+					anchor = old[i].Block.GetID()
+					kept = true
+					continue
+				}
+			}
+			edits = append(edits, blockEdit{op: BlockOpInsert, old: -1, new: j, anchor: anchor})
+			if anchor == "" {
+				anchored = false
+			}
+		}
+		for k, i := range gapOld {
+			if !paired[k] {
+				edits = append(edits, blockEdit{op: BlockOpDelete, old: i, new: -1})
+			}
+		}
+
+		if m[0] < len(old) {
+			edits = append(edits, blockEdit{op: "", old: m[0], new: m[1]})
+			anchor = old[m[0]].Block.GetID()
+			kept = true
+			oi, ni = m[0]+1, m[1]+1
+		}
+	}
+
+	if !anchored && kept {
+		// new blocks cannot be inserted before the blocks kept
+		edits = edits[:0]
+		for i, node := range old {
+			if isCopyable(node.Block) {
+				edits = append(edits, blockEdit{op: BlockOpDelete, old: i, new: -1})
+			}
+		}
+		for j := range new {
+			edits = append(edits, blockEdit{op: BlockOpInsert, old: -1, new: j})
+		}
+	}
+	detectMoves(edits, oldSigs, newSigs)
+
+	var deletes []BlockOp
+	for _, e := range edits {
+		switch e.op {
+		case "":
+			if err = d.diff(old[e.old].Block.GetID(), old[e.old].Children, new[e.new].Children); err != nil {
+				return err
+			}
+		case BlockOpUpdate:
+			if oldSigs[e.old] != newSigs[e.new] {
+				d.ops = append(d.ops, BlockOp{Type: BlockOpUpdate, ID: old[e.old].Block.GetID(), Nodes: []*BlockNode{new[e.new]}})
+			}
+			if err = d.diff(old[e.old].Block.GetID(), old[e.old].Children, new[e.new].Children); err != nil {
+				return err
+			}
+		case BlockOpInsert:
+			if n := len(d.ops); n > 0 && d.ops[n-1].Type == BlockOpInsert && d.ops[n-1].Parent == parent && d.ops[n-1].After == e.anchor {
+				d.ops[n-1].Nodes = append(d.ops[n-1].Nodes, new[e.new])
+				continue
+			}
+			d.ops = append(d.ops, BlockOp{Type: BlockOpInsert, Parent: parent, After: e.anchor, Nodes: []*BlockNode{new[e.new]}})
+		case BlockOpMove:
+			d.ops = append(d.ops, BlockOp{Type: BlockOpMove, Parent: parent, After: e.anchor, ID: old[e.old].Block.GetID(), Nodes: []*BlockNode{new[e.new]}})
+		case BlockOpDelete:
+			if e.old < 0 {
+				// moved
+				continue
+			}
+			deletes = append(deletes, BlockOp{Type: BlockOpDelete, ID: old[e.old].Block.GetID()})
+		}
+	}
+	d.ops = append(d.ops, deletes...)
+	return nil
+}
+
+// detectMoves turns the deletion of a block and the insertion of a block with
+// the same content into a move.
+func detectMoves(edits []blockEdit, oldSigs, newSigs []string) {
+	for i := range edits {
+		if edits[i].op != BlockOpInsert {
+			continue
+		}
+		for j := range edits {
+			if edits[j].op == BlockOpDelete && edits[j].old >= 0 && oldSigs[edits[j].old] == newSigs[edits[i].new] {
+				edits[i].op = BlockOpMove
+				edits[i].old = edits[j].old
+				edits[j].old = -1
+				break
+			}
+		}
+	}
+}
+
+// longestCommonSubsequence returns the pairs of indexes of the longest common
+// subsequence of the signatures of the candidates of old and of new.
+func longestCommonSubsequence(candidates []int, oldSigs, newSigs []string) [][2]int {
+	n, m := len(candidates), len(newSigs)
+	lengths := make([][]int, n+1)
+	for i := range lengths {
+		lengths[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case oldSigs[candidates[i]] == newSigs[j]:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var matches [][2]int
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case oldSigs[candidates[i]] == newSigs[j]:
+			matches = append(matches, [2]int{candidates[i], j})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return matches
+}
+
+// blockSignatures returns the type and the content of the blocks of nodes,
+// children excluded, in a form that is equal for blocks with the same content.
+func blockSignatures(nodes []*BlockNode) ([]string, error) {
+	sigs := make([]string, len(nodes))
+	for i, node := range nodes {
+		content, err := comparableContent(node.Block)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(content)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = node.Block.GetType().String() + ":" + string(data)
+	}
+	return sigs, nil
+}
+
+// isUpdatable reports whether the block old can be updated to the content of
+// new: the API must accept updates of all the fields that differ.
+func isUpdatable(old, new Block) (bool, error) {
+	fields, ok := blockPatchFields[old.GetType()]
+	if !ok || old.GetType() != new.GetType() {
+		return false, nil
+	}
+	oldContent, err := comparableContent(old)
+	if err != nil {
+		return false, err
+	}
+	newContent, err := comparableContent(new)
+	if err != nil {
+		return false, err
+	}
+	for _, content := range []map[string]interface{}{oldContent, newContent} {
+		for _, field := range fields {
+			if field == "file" {
+				for _, key := range []string{"type", string(FileTypeFile), string(FileTypeExternal), string(FileTypeFileUpload)} {
+					delete(content, key)
+				}
+				continue
+			}
+			delete(content, field)
+		}
+	}
+	oldData, err := json.Marshal(oldContent)
+	if err != nil {
+		return false, err
+	}
+	newData, err := json.Marshal(newContent)
+	if err != nil {
+		return false, err
+	}
+	return string(oldData) == string(newData), nil
+}
+
+// comparableContent returns the content of the block, without its children,
+// with the values set by the API and the default values removed.
+func comparableContent(block Block) (map[string]interface{}, error) {
+	data, err := json.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]interface{}
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	content, _ := raw[block.GetType().String()].(map[string]interface{})
+	if content == nil {
+		return map[string]interface{}{}, nil
+	}
+	delete(content, "children")
+	normalizeContent(content)
+	return content, nil
+}
+
+// normalizeContent removes the fields derived from others, such as the plain
+// text of rich text, and the fields with default values from value.
+func normalizeContent(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		for key, child := range v {
+			switch key {
+			case "plain_text", "href", "object":
+				delete(v, key)
+				continue
+			case "color":
+				if s, _ := child.(string); s == "" || s == ColorDefault.String() {
+					delete(v, key)
+					continue
+				}
+			}
+			if normalizeContent(child) {
+				delete(v, key)
+			}
+		}
+		return len(v) == 0
+	case []interface{}:
+		for _, child := range v {
+			normalizeContent(child)
+		}
+		return len(v) == 0
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	}
+	return false
+}
+
+// ApplyDiffOptions configures Client.ApplyDiff.
+type ApplyDiffOptions struct {
+	// DryRun checks the operations and reports the requests they take without
+	// making them.
+	DryRun bool
+}
+
+// ApplyDiffResult is the result of Client.ApplyDiff.
+type ApplyDiffResult struct {
+	// Requests describes the requests made, or to be made on a dry run, for
+	// each operation. The requests creating the descendants of the blocks
+	// inserted are not listed.
+	Requests []string
+	// Skipped lists the blocks that cannot be created through the API, such
+	// as child pages, and were not inserted along with their descendants.
+	Skipped []BlockID
+}
+
+// ApplyDiff applies ops, as returned by DiffBlockTrees, to the content of the
+// page with the ID specified: blocks are appended after their sibling,
+// updated with BlockService.Patch and deleted, in the order of ops. A move
+// inserts the new block and then deletes the block moved.
+//
+// The operations applied before an error are not undone.
+func (c *Client) ApplyDiff(ctx context.Context, pageID PageID, ops []BlockOp, opts *ApplyDiffOptions) (*ApplyDiffResult, error) {
+	if opts == nil {
+		opts = &ApplyDiffOptions{}
+	}
+	result := &ApplyDiffResult{}
+	copier := &blockCopier{
+		client: c,
+		opts:   &CopyBlockTreeOptions{},
+		ids:    map[string]string{},
+		result: &CopyBlockTreeResult{IDs: make(map[BlockID]BlockID)},
+	}
+
+	// the blocks of consecutive insertions after the same block follow each
+	// other
+	var (
+		lastKey      string
+		lastInserted BlockID
+	)
+	for i, op := range ops {
+		parent := op.Parent
+		if parent == "" {
+			parent = BlockID(pageID)
+		}
+
+		switch op.Type {
+		case BlockOpInsert, BlockOpMove:
+			if len(op.Nodes) == 0 || (op.Type == BlockOpMove && (op.ID == "" || len(op.Nodes) != 1)) {
+				return nil, fmt.Errorf("invalid %s operation %d", op.Type, i)
+			}
+			key := parent.String() + "/" + op.After.String()
+			if key != lastKey {
+				lastInserted = ""
+			}
+			lastKey = key
+			after := op.After
+			if lastInserted != "" {
+				after = lastInserted
+			}
+			request := fmt.Sprintf("append %d blocks to %s", len(op.Nodes), parent)
+			if op.After != "" {
+				request += " after " + op.After.String()
+			}
+			result.Requests = append(result.Requests, request)
+			if op.Type == BlockOpMove {
+				result.Requests = append(result.Requests, "delete "+op.ID.String())
+			}
+			if opts.DryRun {
+				continue
+			}
+
+			created, err := copier.copyNodes(ctx, parent, after, op.Nodes)
+			if err != nil {
+				return nil, err
+			}
+			if len(created) > 0 {
+				lastInserted = created[len(created)-1].GetID()
+			}
+			if op.Type == BlockOpMove {
+				if _, err = c.Block.Delete(ctx, op.ID); err != nil {
+					return nil, err
+				}
+			}
+		case BlockOpUpdate:
+			lastKey = ""
+			if op.ID == "" || len(op.Nodes) != 1 {
+				return nil, fmt.Errorf("invalid %s operation %d", op.Type, i)
+			}
+			patch, err := patchFromBlock(op.Nodes[0].Block)
+			if err != nil {
+				return nil, err
+			}
+			if err = patch.Err(); err != nil {
+				return nil, err
+			}
+			result.Requests = append(result.Requests, "update "+op.ID.String())
+			if opts.DryRun {
+				continue
+			}
+			if _, err = c.Block.Patch(ctx, op.ID, patch); err != nil {
+				return nil, err
+			}
+		case BlockOpDelete:
+			lastKey = ""
+			if op.ID == "" {
+				return nil, fmt.Errorf("invalid %s operation %d", op.Type, i)
+			}
+			result.Requests = append(result.Requests, "delete "+op.ID.String())
+			if opts.DryRun {
+				continue
+			}
+			if _, err := c.Block.Delete(ctx, op.ID); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown operation type %q", op.Type)
+		}
+	}
+	result.Skipped = copier.result.Skipped
+	return result, nil
+}
+
+// patchFromBlock returns the patch setting the fields of a block that can be
+// updated to the values of block. The fields not set in block are reset.
+func patchFromBlock(block Block) (*BlockPatch, error) {
+	patch := NewBlockPatch(block.GetType())
+	if patch.err != nil {
+		return patch, nil
+	}
+	data, err := json.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]interface{}
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	content, _ := raw[block.GetType().String()].(map[string]interface{})
+
+	for _, field := range blockPatchFields[block.GetType()] {
+		if field == "file" {
+			// files hosted by Notion cannot be set
+			if fileType, _ := content["type"].(string); fileType == string(FileTypeExternal) || fileType == string(FileTypeFileUpload) {
+				patch.fields[field] = map[string]interface{}{"type": fileType, fileType: content[fileType]}
+			}
+			continue
+		}
+		value, ok := content[field]
+		if ok && value != nil {
+			patch.fields[field] = value
+			continue
+		}
+		switch field {
+		case "rich_text", "caption", "cells":
+			patch.fields[field] = []interface{}{}
+		case "color":
+			patch.fields[field] = ColorDefault
+		case "is_toggleable", "checked", "has_column_header", "has_row_header":
+			patch.fields[field] = false
+		}
+	}
+	return patch, nil
+}
@@ -0,0 +1,193 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func diffParagraph(id, text string, children ...notionapi.Block) *notionapi.ParagraphBlock {
+	return &notionapi.ParagraphBlock{
+		BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, ID: notionapi.BlockID(id), Type: notionapi.BlockTypeParagraph},
+		Paragraph: notionapi.Paragraph{
+			RichText: []notionapi.RichText{{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: text}, PlainText: text}},
+			Children: children,
+		},
+	}
+}
+
+func diffNodes(t *testing.T, blocks ...notionapi.Block) []*notionapi.BlockNode {
+	nodes, err := notionapi.NewBlockNodes(blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nodes
+}
+
+// formatBlockOps describes ops as "type id parent/after: texts".
+func formatBlockOps(ops []notionapi.BlockOp) []string {
+	var lines []string
+	for _, op := range ops {
+		texts := make([]string, len(op.Nodes))
+		for i, node := range op.Nodes {
+			texts[i] = node.Block.GetRichTextString()
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s/%s: %s", op.Type, op.ID, op.Parent, op.After, strings.Join(texts, ",")))
+	}
+	return lines
+}
+
+func TestDiffBlockTrees(t *testing.T) {
+	childPage := &notionapi.ChildPageBlock{
+		BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, ID: "page", Type: notionapi.BlockTypeChildPage},
+		ChildPage:  notionapi.ChildPage{Title: "Page"},
+	}
+	heading := &notionapi.Heading1Block{
+		BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeHeading1},
+		Heading1:   notionapi.Heading{RichText: []notionapi.RichText{{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "b"}}}},
+	}
+
+	tests := []struct {
+		name string
+		old  []notionapi.Block
+		new  []notionapi.Block
+		want []string
+	}{
+		{
+			name: "keeps blocks with the same content",
+			old:  []notionapi.Block{diffParagraph("a", "a"), diffParagraph("b", "b")},
+			new:  []notionapi.Block{diffParagraph("", "a"), diffParagraph("", "b")},
+		},
+		{
+			name: "updates blocks whose content changed",
+			old:  []notionapi.Block{diffParagraph("a", "a"), diffParagraph("b", "b")},
+			new:  []notionapi.Block{diffParagraph("", "a"), diffParagraph("", "B")},
+			want: []string{"update b /: B"},
+		},
+		{
+			name: "inserts and deletes blocks",
+			old:  []notionapi.Block{diffParagraph("a", "a"), diffParagraph("b", "b"), diffParagraph("c", "c")},
+			new:  []notionapi.Block{diffParagraph("", "a"), diffParagraph("", "x"), diffParagraph("", "y"), diffParagraph("", "c"), diffParagraph("", "z")},
+			want: []string{"update b /: x", "insert  /b: y", "insert  /c: z"},
+		},
+		{
+			name: "deletes blocks",
+			old:  []notionapi.Block{diffParagraph("a", "a"), diffParagraph("b", "b"), diffParagraph("c", "c")},
+			new:  []notionapi.Block{diffParagraph("", "a"), diffParagraph("", "c")},
+			want: []string{"delete b /: "},
+		},
+		{
+			name: "moves blocks",
+			old:  []notionapi.Block{diffParagraph("a", "a"), diffParagraph("b", "b"), diffParagraph("c", "c")},
+			new:  []notionapi.Block{diffParagraph("", "b"), diffParagraph("", "c"), diffParagraph("", "a")},
+			want: []string{"move a /c: a"},
+		},
+		{
+			name: "replaces blocks of another type",
+			old:  []notionapi.Block{diffParagraph("a", "a"), diffParagraph("b", "b")},
+			new:  []notionapi.Block{diffParagraph("", "a"), heading},
+			want: []string{"insert  /a: b", "delete b /: "},
+		},
+		{
+			name: "replaces all the blocks to insert blocks first",
+			old:  []notionapi.Block{diffParagraph("a", "a"), diffParagraph("b", "b")},
+			new:  []notionapi.Block{heading, diffParagraph("", "a"), diffParagraph("", "b")},
+			want: []string{"insert  /: b", "move a /: a", "move b /: b"},
+		},
+		{
+			name: "never deletes child pages",
+			old:  []notionapi.Block{childPage, diffParagraph("a", "a")},
+			new:  []notionapi.Block{diffParagraph("", "a"), diffParagraph("", "b")},
+			want: []string{"insert  /a: b"},
+		},
+		{
+			name: "diffs children",
+			old:  []notionapi.Block{diffParagraph("a", "a", diffParagraph("a1", "a1")), diffParagraph("b", "b")},
+			new:  []notionapi.Block{diffParagraph("", "a", diffParagraph("", "a1"), diffParagraph("", "a2")), diffParagraph("", "B", diffParagraph("", "b1"))},
+			want: []string{"insert  a/a1: a2", "update b /: B", "insert  b/: b1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops, err := notionapi.DiffBlockTrees(diffNodes(t, tt.old...), diffNodes(t, tt.new...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := formatBlockOps(ops); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffBlockTrees() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientApplyDiff(t *testing.T) {
+	ops := []notionapi.BlockOp{
+		{Type: notionapi.BlockOpInsert, After: "a", Nodes: diffNodes(t, diffParagraph("", "x"), diffParagraph("", "y"))},
+		{Type: notionapi.BlockOpMove, After: "a", ID: "m", Nodes: diffNodes(t, diffParagraph("", "m"))},
+		{Type: notionapi.BlockOpUpdate, ID: "b", Nodes: diffNodes(t, diffParagraph("", "B"))},
+		{Type: notionapi.BlockOpDelete, ID: "c"},
+	}
+
+	for _, dryRun := range []bool{false, true} {
+		t.Run(fmt.Sprintf("dry run %v", dryRun), func(t *testing.T) {
+			var (
+				requests []string
+				n        int
+			)
+			c := newTestClient(func(req *http.Request) *http.Response {
+				var body map[string]json.RawMessage
+				if req.Body != nil {
+					_ = json.NewDecoder(req.Body).Decode(&body)
+				}
+				request := []string{req.Method, req.URL.Path}
+				for _, key := range []string{"after", "paragraph"} {
+					if value, ok := body[key]; ok {
+						request = append(request, string(value))
+					}
+				}
+				requests = append(requests, strings.Join(request, " "))
+				if req.Method == http.MethodPatch && strings.HasSuffix(req.URL.Path, "/children") {
+					var children []json.RawMessage
+					_ = json.Unmarshal(body["children"], &children)
+					results := make([]string, len(children))
+					for i := range children {
+						n++
+						results[i] = fmt.Sprintf(`{"object":"block","id":"new_%d","type":"paragraph","paragraph":{"rich_text":[]}}`, n)
+					}
+					return newJSONResponse(http.StatusOK, `{"object":"list","results":[`+strings.Join(results, ",")+`]}`)
+				}
+				return newJSONResponse(http.StatusOK, `{"object":"block","id":"b","type":"paragraph","paragraph":{"rich_text":[]}}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			result, err := client.ApplyDiff(context.Background(), "page", ops, &notionapi.ApplyDiffOptions{DryRun: dryRun})
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantResult := []string{"append 2 blocks to page after a", "append 1 blocks to page after a", "delete m", "update b", "delete c"}
+			if !reflect.DeepEqual(result.Requests, wantResult) {
+				t.Errorf("ApplyDiff() requests = %q, want %q", result.Requests, wantResult)
+			}
+
+			var want []string
+			if !dryRun {
+				want = []string{
+					`PATCH /v1/blocks/page/children "a"`,
+					`PATCH /v1/blocks/page/children "new_2"`,
+					`DELETE /v1/blocks/m`,
+					`PATCH /v1/blocks/b {"color":"default","rich_text":[{"plain_text":"B","text":{"content":"B"},"type":"text"}]}`,
+					`DELETE /v1/blocks/c`,
+				}
+			}
+			if !reflect.DeepEqual(requests, want) {
+				t.Errorf("ApplyDiff() made %q, want %q", requests, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Limits documented by Notion for the blocks sent in a request.
+//
+// See https://developers.notion.com/reference/request-limits
+const (
+	// MaxTextContentLength is the maximum length of the content of a text
+	// object, counted in UTF-16 code units: characters outside of the Basic
+	// Multilingual Plane, such as most emoji, count twice.
+	MaxTextContentLength = 2000
+	// MaxURLLength is the maximum length of a URL, in UTF-16 code units.
+	MaxURLLength = 2000
+	// MaxEquationLength is the maximum length of an equation expression, in
+	// UTF-16 code units.
+	MaxEquationLength = 1000
+	// MaxArrayLength is the maximum number of elements of an array, such as
+	// rich text or children.
+	MaxArrayLength = 100
+	// MaxBlockNesting is the maximum number of levels of children below the
+	// blocks sent in a request.
+	MaxBlockNesting = 2
+	// MaxBlocksPerRequest is the maximum number of blocks sent in a request,
+	// descendants included.
+	MaxBlocksPerRequest = 1000
+)
+
+// LimitViolation is a value of a block exceeding one of Notion's limits.
+type LimitViolation struct {
+	// Path locates the value in the JSON of the blocks, as in
+	// [2].toggle.children[0].paragraph.rich_text[1].text.content.
+	Path    string
+	Message string
+}
+
+func (v LimitViolation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+	return v.Path + ": " + v.Message
+}
+
+// ValidateBlocks checks the blocks against the limits documented by Notion
+// before they are sent, and returns a *ValidationError listing the values
+// exceeding them. The blocks are checked as sent by
+// BlockService.AppendChildren, which sends them MaxAppendBlockChildren at a
+// time: more blocks than that at the top level is not a violation.
+func ValidateBlocks(blocks Blocks) error {
+	var violations []LimitViolation
+	for start := 0; start < len(blocks); start += MaxAppendBlockChildren {
+		end := start + MaxAppendBlockChildren
+		if end > len(blocks) {
+			end = len(blocks)
+		}
+		v := &blockValidator{}
+		for i := start; i < end; i++ {
+			if blocks[i] == nil {
+				v.add(fmt.Sprintf("[%d]", i), "nil block")
+				continue
+			}
+			data, err := json.Marshal(blocks[i])
+			if err != nil {
+				return err
+			}
+			var value interface{}
+			if err = json.Unmarshal(data, &value); err != nil {
+				return err
+			}
+			v.count++
+			v.validate(fmt.Sprintf("[%d]", i), "", value, 0)
+		}
+		if v.count > MaxBlocksPerRequest {
+			v.add("", fmt.Sprintf("%d blocks in a request, more than %d", v.count, MaxBlocksPerRequest))
+		}
+		violations = append(violations, v.violations...)
+	}
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+type blockValidator struct {
+	// count is the number of blocks of the request.
+	count      int
+	violations []LimitViolation
+}
+
+func (v *blockValidator) add(path, message string) {
+	v.violations = append(v.violations, LimitViolation{Path: path, Message: message})
+}
+
+// validate checks value, the value of the field key, where depth is the
+// number of levels of children above it.
+func (v *blockValidator) validate(path, key string, value interface{}, depth int) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		// sorted for the violations to be reported in a stable order
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := value[k]
+			childPath := path + "." + k
+			if key == "text" && k == "content" {
+				if s, ok := child.(string); ok && textLength(s) > MaxTextContentLength {
+					v.add(childPath, fmt.Sprintf("text of %d UTF-16 code units, more than %d", textLength(s), MaxTextContentLength))
+				}
+				continue
+			}
+			v.validate(childPath, k, child, depth)
+		}
+	case []interface{}:
+		if len(value) > MaxArrayLength {
+			v.add(path, fmt.Sprintf("%d elements, more than %d", len(value), MaxArrayLength))
+		}
+		if key == "children" {
+			if depth >= MaxBlockNesting && len(value) > 0 {
+				v.add(path, fmt.Sprintf("children nested more than %d levels deep", MaxBlockNesting))
+				return
+			}
+			depth++
+			v.count += len(value)
+		}
+		for i, child := range value {
+			v.validate(fmt.Sprintf("%s[%d]", path, i), "", child, depth)
+		}
+	case string:
+		n := textLength(value)
+		switch {
+		case key == "url" && n > MaxURLLength:
+			v.add(path, fmt.Sprintf("URL of %d UTF-16 code units, more than %d", n, MaxURLLength))
+		case key == "expression" && n > MaxEquationLength:
+			v.add(path, fmt.Sprintf("expression of %d UTF-16 code units, more than %d", n, MaxEquationLength))
+		}
+	}
+}
+
+// textLength returns the length of s as counted by Notion, in UTF-16 code
+// units.
+func textLength(s string) int {
+	n := 0
+	for _, r := range s {
+		n += runeLength(r)
+	}
+	return n
+}
+
+// runeLength returns the number of UTF-16 code units of r.
+func runeLength(r rune) int {
+	if r > 0xFFFF {
+		return 2
+	}
+	return 1
+}
+
+// SplitRichText returns richText with the text objects longer than
+// MaxTextContentLength split into several objects with the same annotations
+// and link. Characters are never split.
+func SplitRichText(richText []RichText) []RichText {
+	result := make([]RichText, 0, len(richText))
+	for _, rt := range richText {
+		if rt.Text == nil {
+			result = append(result, rt)
+			continue
+		}
+		content := rt.Text.Content
+		for textLength(content) > MaxTextContentLength {
+			n, length := 0, 0
+			for i, r := range content {
+				if length+runeLength(r) > MaxTextContentLength {
+					n = i
+					break
+				}
+				length += runeLength(r)
+			}
+			result = append(result, textPart(rt, content[:n]))
+			content = content[n:]
+		}
+		result = append(result, textPart(rt, content))
+	}
+	return result
+}
+
+// textPart returns the text object rt with content as its content.
+func textPart(rt RichText, content string) RichText {
+	rt.Text = &Text{Content: content, Link: rt.Text.Link}
+	if rt.PlainText != "" {
+		rt.PlainText = content
+	}
+	return rt
+}
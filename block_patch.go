@@ -0,0 +1,212 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// blockPatchFields lists the fields that can be updated for each block type.
+var blockPatchFields = map[BlockType][]string{
+	BlockTypeParagraph:        {"rich_text", "color"},
+	BlockTypeHeading1:         {"rich_text", "color", "is_toggleable"},
+	BlockTypeHeading2:         {"rich_text", "color", "is_toggleable"},
+	BlockTypeHeading3:         {"rich_text", "color", "is_toggleable"},
+	BlockTypeBulletedListItem: {"rich_text", "color"},
+	BlockTypeNumberedListItem: {"rich_text", "color"},
+	BlockTypeToDo:             {"rich_text", "color", "checked"},
+	BlockTypeToggle:           {"rich_text", "color"},
+	BlockTypeQuote:            {"rich_text", "color"},
+	BlockTypeCallout:          {"rich_text", "color", "icon"},
+	BlockTypeTemplate:         {"rich_text"},
+	BlockTypeCode:             {"rich_text", "caption", "language"},
+	BlockTypeEquation:         {"expression"},
+	BlockTypeEmbed:            {"url", "caption"},
+	BlockTypeBookmark:         {"url", "caption"},
+	BlockTypeImage:            {"file", "caption"},
+	BlockTypeVideo:            {"file", "caption"},
+	BlockTypeAudio:            {"file", "caption"},
+	BlockTypeFile:             {"file", "caption", "name"},
+	BlockTypePdf:              {"file", "caption"},
+	BlockTypeTableBlock:       {"has_column_header", "has_row_header"},
+	BlockTypeTableRowBlock:    {"cells"},
+}
+
+// BlockPatch holds the fields to update of a block, to be sent with
+// BlockService.Patch. Unlike BlockUpdateRequest, only the fields set are sent.
+// Setting a field that cannot be updated for the block type makes Patch fail.
+type BlockPatch struct {
+	blockType BlockType
+	fields    map[string]interface{}
+	err       error
+}
+
+// NewBlockPatch returns an empty patch for blocks of the type specified.
+func NewBlockPatch(blockType BlockType) *BlockPatch {
+	p := &BlockPatch{blockType: blockType, fields: map[string]interface{}{}}
+	if _, ok := blockPatchFields[blockType]; !ok {
+		p.err = fmt.Errorf("blocks of type %s cannot be updated", blockType)
+	}
+	return p
+}
+
+// ParagraphPatch and the functions below return an empty patch for blocks of
+// their type.
+func ParagraphPatch() *BlockPatch        { return NewBlockPatch(BlockTypeParagraph) }
+func Heading1Patch() *BlockPatch         { return NewBlockPatch(BlockTypeHeading1) }
+func Heading2Patch() *BlockPatch         { return NewBlockPatch(BlockTypeHeading2) }
+func Heading3Patch() *BlockPatch         { return NewBlockPatch(BlockTypeHeading3) }
+func BulletedListItemPatch() *BlockPatch { return NewBlockPatch(BlockTypeBulletedListItem) }
+func NumberedListItemPatch() *BlockPatch { return NewBlockPatch(BlockTypeNumberedListItem) }
+func ToDoPatch() *BlockPatch             { return NewBlockPatch(BlockTypeToDo) }
+func TogglePatch() *BlockPatch           { return NewBlockPatch(BlockTypeToggle) }
+func QuotePatch() *BlockPatch            { return NewBlockPatch(BlockTypeQuote) }
+func CalloutPatch() *BlockPatch          { return NewBlockPatch(BlockTypeCallout) }
+func CodePatch() *BlockPatch             { return NewBlockPatch(BlockTypeCode) }
+func EquationPatch() *BlockPatch         { return NewBlockPatch(BlockTypeEquation) }
+func EmbedPatch() *BlockPatch            { return NewBlockPatch(BlockTypeEmbed) }
+func BookmarkPatch() *BlockPatch         { return NewBlockPatch(BlockTypeBookmark) }
+func ImagePatch() *BlockPatch            { return NewBlockPatch(BlockTypeImage) }
+func VideoPatch() *BlockPatch            { return NewBlockPatch(BlockTypeVideo) }
+func AudioPatch() *BlockPatch            { return NewBlockPatch(BlockTypeAudio) }
+func FilePatch() *BlockPatch             { return NewBlockPatch(BlockTypeFile) }
+func PdfPatch() *BlockPatch              { return NewBlockPatch(BlockTypePdf) }
+func TablePatch() *BlockPatch            { return NewBlockPatch(BlockTypeTableBlock) }
+func TableRowPatch() *BlockPatch         { return NewBlockPatch(BlockTypeTableRowBlock) }
+
+func (p *BlockPatch) set(field string, value interface{}) *BlockPatch {
+	if p.err != nil {
+		return p
+	}
+	for _, f := range blockPatchFields[p.blockType] {
+		if f == field {
+			p.fields[field] = value
+			return p
+		}
+	}
+	p.err = fmt.Errorf("field %s cannot be updated for blocks of type %s", field, p.blockType)
+	return p
+}
+
+// SetRichText sets the text of the block. Use an empty slice to clear it.
+func (p *BlockPatch) SetRichText(richText []RichText) *BlockPatch {
+	if richText == nil {
+		richText = []RichText{}
+	}
+	return p.set("rich_text", richText)
+}
+
+func (p *BlockPatch) SetColor(color Color) *BlockPatch {
+	return p.set("color", color)
+}
+
+// SetToggleable sets whether a heading is a toggle heading.
+func (p *BlockPatch) SetToggleable(toggleable bool) *BlockPatch {
+	return p.set("is_toggleable", toggleable)
+}
+
+func (p *BlockPatch) SetChecked(checked bool) *BlockPatch {
+	return p.set("checked", checked)
+}
+
+func (p *BlockPatch) SetIcon(icon *Icon) *BlockPatch {
+	return p.set("icon", icon)
+}
+
+func (p *BlockPatch) SetLanguage(language CodeLanguage) *BlockPatch {
+	return p.set("language", language)
+}
+
+// SetCaption sets the caption of the block. Use an empty slice to clear it.
+func (p *BlockPatch) SetCaption(caption []RichText) *BlockPatch {
+	if caption == nil {
+		caption = []RichText{}
+	}
+	return p.set("caption", caption)
+}
+
+func (p *BlockPatch) SetExpression(expression string) *BlockPatch {
+	return p.set("expression", expression)
+}
+
+func (p *BlockPatch) SetURL(url string) *BlockPatch {
+	return p.set("url", url)
+}
+
+// SetExternalFile replaces the file of a file block with the file hosted at
+// url.
+func (p *BlockPatch) SetExternalFile(url string) *BlockPatch {
+	return p.set("file", map[string]interface{}{
+		"type":                   FileTypeExternal,
+		string(FileTypeExternal): map[string]interface{}{"url": url},
+	})
+}
+
+// SetFileUpload replaces the file of a file block with a file uploaded with
+// FileUploadService.
+func (p *BlockPatch) SetFileUpload(id FileUploadID) *BlockPatch {
+	return p.set("file", map[string]interface{}{
+		"type":                     FileTypeFileUpload,
+		string(FileTypeFileUpload): map[string]interface{}{"id": id},
+	})
+}
+
+// SetName sets the name of the file of a file block.
+func (p *BlockPatch) SetName(name string) *BlockPatch {
+	return p.set("name", name)
+}
+
+func (p *BlockPatch) SetColumnHeader(hasColumnHeader bool) *BlockPatch {
+	return p.set("has_column_header", hasColumnHeader)
+}
+
+func (p *BlockPatch) SetRowHeader(hasRowHeader bool) *BlockPatch {
+	return p.set("has_row_header", hasRowHeader)
+}
+
+func (p *BlockPatch) SetCells(cells [][]RichText) *BlockPatch {
+	return p.set("cells", cells)
+}
+
+// Err returns the error of the first field set that cannot be updated, if
+// any.
+func (p *BlockPatch) Err() error {
+	return p.err
+}
+
+func (p *BlockPatch) MarshalJSON() ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	content := make(map[string]interface{}, len(p.fields))
+	for field, value := range p.fields {
+		if field == "file" {
+			// the file fields are set along with the other fields of the
+			// content of file blocks
+			for k, v := range value.(map[string]interface{}) {
+				content[k] = v
+			}
+			continue
+		}
+		content[field] = value
+	}
+	return json.Marshal(map[string]interface{}{p.blockType.String(): content})
+}
+
+// Patch updates the fields set in patch of the block with the ID specified.
+// The type of the patch must be the type of the block.
+//
+// See https://developers.notion.com/reference/update-a-block
+func (bc *BlockClient) Patch(ctx context.Context, id BlockID, patch *BlockPatch) (Block, error) {
+	if patch == nil {
+		return nil, errors.New("empty block patch")
+	}
+	if patch.err != nil {
+		return nil, patch.err
+	}
+	if len(patch.fields) == 0 {
+		return nil, errors.New("empty block patch")
+	}
+	return bc.update(ctx, id, patch)
+}
@@ -3,9 +3,11 @@ package notionapi_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -158,6 +160,27 @@ func TestBlockClient(t *testing.T) {
 		}
 	})
 
+	t.Run("AppendChildren after a block", func(t *testing.T) {
+		var got map[string]interface{}
+		c := newTestClient(func(req *http.Request) *http.Response {
+			if err := json.NewDecoder(req.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[]}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+		_, err := client.Block.AppendChildren(context.Background(), "some_id", &notionapi.AppendBlockChildrenRequest{
+			After:    "sibling_id",
+			Children: []notionapi.Block{&notionapi.DividerBlock{BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeDivider}}},
+		})
+		if err != nil {
+			t.Fatalf("AppendChildren() error = %v", err)
+		}
+		if got["after"] != "sibling_id" {
+			t.Errorf("AppendChildren() after = %v, want sibling_id", got["after"])
+		}
+	})
+
 	t.Run("Get", func(t *testing.T) {
 		tests := []struct {
 			name       string
@@ -291,6 +314,56 @@ func TestBlockClient(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Delete", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			filePath   string
+			statusCode int
+			id         notionapi.BlockID
+			want       notionapi.Block
+			wantErr    bool
+		}{
+			{
+				name:       "archives block and returns it",
+				filePath:   "testdata/block_delete.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				want: &notionapi.DividerBlock{
+					BasicBlock: notionapi.BasicBlock{
+						Object:         notionapi.ObjectTypeBlock,
+						ID:             "some_id",
+						Type:           notionapi.BlockTypeDivider,
+						CreatedTime:    &timestamp,
+						LastEditedTime: &timestamp,
+						Archived:       true,
+					},
+				},
+			},
+			{
+				name:       "returns an error for an empty id",
+				filePath:   "testdata/block_delete.json",
+				statusCode: http.StatusOK,
+				wantErr:    true,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c := newMockedClient(t, tt.filePath, tt.statusCode)
+				client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+				got, err := client.Block.Delete(context.Background(), tt.id)
+
+				if (err != nil) != tt.wantErr {
+					t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Delete() got = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
 }
 
 func TestBlockArrayUnmarshal(t *testing.T) {
@@ -516,3 +589,400 @@ func TestBlockUpdateRequest_MarshallJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockTypesRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/block_all_types.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blocks notionapi.Blocks
+	if err = json.Unmarshal(data, &blocks); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, block := range blocks {
+		t.Run(block.GetType().String(), func(t *testing.T) {
+			if _, ok := block.(*notionapi.UnsupportedBlock); ok {
+				t.Fatalf("block type %s is not supported", block.GetType())
+			}
+			if block.GetID().String() != block.GetType().String() {
+				t.Errorf("block %s decoded as type %s", block.GetID(), block.GetType())
+			}
+
+			encoded, err := json.Marshal(block)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded notionapi.Blocks
+			if err = json.Unmarshal([]byte("["+string(encoded)+"]"), &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(decoded[0], block) {
+				t.Errorf("round trip got = %+v, want %+v", decoded[0], block)
+			}
+		})
+	}
+}
+
+func TestNormalizeCodeLanguage(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   notionapi.CodeLanguage
+		wantOk bool
+	}{
+		{name: "go", want: notionapi.CodeLanguageGo, wantOk: true},
+		{name: "Golang", want: notionapi.CodeLanguageGo, wantOk: true},
+		{name: "py", want: notionapi.CodeLanguagePython, wantOk: true},
+		{name: "visual-basic", want: notionapi.CodeLanguageVisualBasic, wantOk: true},
+		{name: "", want: notionapi.CodeLanguagePlainText, wantOk: true},
+		{name: "brainfuck", want: notionapi.CodeLanguagePlainText, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := notionapi.NormalizeCodeLanguage(tt.name)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("NormalizeCodeLanguage() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestUnsupportedBlock(t *testing.T) {
+	data := []byte(`[{"object":"block","id":"some_id","type":"transcription","has_children":false,"transcription":{"title":[],"status":"done"}}]`)
+	var blocks notionapi.Blocks
+	if err := json.Unmarshal(data, &blocks); err != nil {
+		t.Fatal(err)
+	}
+	block, ok := blocks[0].(*notionapi.UnsupportedBlock)
+	if !ok {
+		t.Fatalf("decoded as %T, want *notionapi.UnsupportedBlock", blocks[0])
+	}
+	if block.GetID() != "some_id" || block.GetType() != "transcription" {
+		t.Errorf("decoded id = %s, type = %s", block.GetID(), block.GetType())
+	}
+
+	encoded, err := json.Marshal(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got, want interface{}
+	if err = json.Unmarshal(encoded, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(data[1:len(data)-1], &want); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Marshal() got = %s, want %s", encoded, data)
+	}
+}
+
+func TestBlockClientAppendChildrenChunks(t *testing.T) {
+	var (
+		sizes  []int
+		afters []string
+		n      int
+	)
+	c := newTestClient(func(req *http.Request) *http.Response {
+		var body struct {
+			After    string            `json:"after"`
+			Children []json.RawMessage `json:"children"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		sizes = append(sizes, len(body.Children))
+		afters = append(afters, body.After)
+
+		results := make([]string, len(body.Children))
+		for i := range results {
+			n++
+			results[i] = fmt.Sprintf(`{"object":"block","id":"block_%d","type":"divider","divider":{}}`, n)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","results":[`+strings.Join(results, ",")+`]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	children := make([]notionapi.Block, 250)
+	for i := range children {
+		children[i] = &notionapi.DividerBlock{BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeDivider}}
+	}
+	got, err := client.Block.AppendChildren(context.Background(), "some_id", &notionapi.AppendBlockChildrenRequest{
+		After:    "sibling_id",
+		Children: children,
+	})
+	if err != nil {
+		t.Fatalf("AppendChildren() error = %v", err)
+	}
+
+	if want := []int{100, 100, 50}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("AppendChildren() sent chunks of %v, want %v", sizes, want)
+	}
+	if want := []string{"sibling_id", "block_100", "block_200"}; !reflect.DeepEqual(afters, want) {
+		t.Errorf("AppendChildren() sent after = %v, want %v", afters, want)
+	}
+	if len(got.Results) != 250 || got.Results[249].GetID() != "block_250" {
+		t.Errorf("AppendChildren() got %d results", len(got.Results))
+	}
+}
+
+func TestBlockClientDeleteBlocks(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodDelete {
+			t.Errorf("unexpected %s request", req.Method)
+		}
+		if req.URL.Path == "/v1/blocks/missing" {
+			return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"not found"}`)
+		}
+		id := strings.TrimPrefix(req.URL.Path, "/v1/blocks/")
+		return newJSONResponse(http.StatusOK, `{"object":"block","id":"`+id+`","type":"divider","archived":true,"divider":{}}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	err := client.Block.DeleteBlocks(context.Background(), []notionapi.BlockID{"a", "missing", "b", "c", "d"})
+	batchErr, ok := err.(*notionapi.BatchError)
+	if !ok {
+		t.Fatalf("DeleteBlocks() error = %v, want a *BatchError", err)
+	}
+	if batchErr.Total != 5 || len(batchErr.Errors) != 1 {
+		t.Errorf("DeleteBlocks() error = %v", batchErr)
+	}
+	if !notionapi.IsErrorCode(batchErr.Errors["missing"], notionapi.ErrorCodeObjectNotFound) {
+		t.Errorf("DeleteBlocks() error for missing = %v", batchErr.Errors["missing"])
+	}
+
+	if err = client.Block.DeleteBlocks(context.Background(), []notionapi.BlockID{"a", "b"}); err != nil {
+		t.Errorf("DeleteBlocks() error = %v", err)
+	}
+}
+
+func TestBlockPatch(t *testing.T) {
+	text := []notionapi.RichText{{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "Hello"}}}
+	tests := []struct {
+		name    string
+		patch   *notionapi.BlockPatch
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "sends only the fields set",
+			patch: notionapi.ToDoPatch().SetChecked(false),
+			want:  `{"to_do":{"checked":false}}`,
+		},
+		{
+			name:  "clears the rich text",
+			patch: notionapi.ParagraphPatch().SetRichText(nil).SetColor(notionapi.ColorRed),
+			want:  `{"paragraph":{"color":"red","rich_text":[]}}`,
+		},
+		{
+			name:  "sets the file of file blocks",
+			patch: notionapi.ImagePatch().SetExternalFile("https://example.com/a.png").SetCaption(text),
+			want:  `{"image":{"caption":[{"type":"text","text":{"content":"Hello"}}],"external":{"url":"https://example.com/a.png"},"type":"external"}}`,
+		},
+		{
+			name:    "fails on fields not updatable for the type",
+			patch:   notionapi.CodePatch().SetColor(notionapi.ColorRed),
+			wantErr: true,
+		},
+		{
+			name:    "fails on types not updatable",
+			patch:   notionapi.NewBlockPatch(notionapi.BlockTypeDivider).SetColor(notionapi.ColorRed),
+			wantErr: true,
+		},
+		{
+			name:    "fails on empty patches",
+			patch:   notionapi.Heading1Patch(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				body, _ := ioutil.ReadAll(req.Body)
+				sent = string(body)
+				return newJSONResponse(http.StatusOK, `{"object":"block","id":"some_id","type":"divider","divider":{}}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			_, err := client.Block.Patch(context.Background(), "some_id", tt.patch)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Patch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && sent != tt.want {
+				t.Errorf("Patch() sent %s, want %s", sent, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateBlocks(t *testing.T) {
+	paragraph := func(content string, children ...notionapi.Block) *notionapi.ParagraphBlock {
+		return &notionapi.ParagraphBlock{
+			BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeParagraph},
+			Paragraph: notionapi.Paragraph{
+				RichText: []notionapi.RichText{{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: content}}},
+				Children: children,
+			},
+		}
+	}
+	manyParagraphs := make(notionapi.Blocks, 101)
+	for i := range manyParagraphs {
+		manyParagraphs[i] = paragraph("a")
+	}
+
+	tests := []struct {
+		name   string
+		blocks notionapi.Blocks
+		want   []string
+	}{
+		{
+			name:   "accepts blocks within the limits",
+			blocks: notionapi.Blocks{paragraph("a", paragraph("b", paragraph("c")))},
+		},
+		{
+			name:   "accepts more than 100 blocks at the top level",
+			blocks: manyParagraphs,
+		},
+		{
+			name:   "reports long text",
+			blocks: notionapi.Blocks{paragraph("a"), paragraph(strings.Repeat("é", 2001))},
+			want:   []string{"[1].paragraph.rich_text[0].text.content: text of 2001 UTF-16 code units, more than 2000"},
+		},
+		{
+			name:   "reports long text of emoji",
+			blocks: notionapi.Blocks{paragraph(strings.Repeat("😀", 1001))},
+			want:   []string{"[0].paragraph.rich_text[0].text.content: text of 2002 UTF-16 code units, more than 2000"},
+		},
+		{
+			name: "reports long URLs and expressions",
+			blocks: notionapi.Blocks{
+				&notionapi.BookmarkBlock{
+					BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeBookmark},
+					Bookmark:   notionapi.Bookmark{URL: "https://example.com/" + strings.Repeat("a", 2000)},
+				},
+				&notionapi.EquationBlock{
+					BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeEquation},
+					Equation:   notionapi.Equation{Expression: strings.Repeat("x", 1001)},
+				},
+			},
+			want: []string{
+				"[0].bookmark.url: URL of 2020 UTF-16 code units, more than 2000",
+				"[1].equation.expression: expression of 1001 UTF-16 code units, more than 1000",
+			},
+		},
+		{
+			name:   "reports large arrays",
+			blocks: notionapi.Blocks{paragraph("a", manyParagraphs...)},
+			want:   []string{"[0].paragraph.children: 101 elements, more than 100"},
+		},
+		{
+			name:   "reports deep nesting",
+			blocks: notionapi.Blocks{paragraph("a", paragraph("b", paragraph("c", paragraph("d"))))},
+			want:   []string{"[0].paragraph.children[0].paragraph.children[0].paragraph.children: children nested more than 2 levels deep"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := notionapi.ValidateBlocks(tt.blocks)
+			var got []string
+			if validationErr, ok := err.(*notionapi.ValidationError); ok {
+				for _, v := range validationErr.Violations {
+					got = append(got, v.String())
+				}
+			} else if err != nil {
+				t.Fatalf("ValidateBlocks() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBlocks() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMediaBlocks(t *testing.T) {
+	caption := notionapi.RichText{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "Caption"}}
+	tests := []struct {
+		name  string
+		block notionapi.Block
+		want  string
+	}{
+		{
+			name:  "audio from an external URL",
+			block: notionapi.NewAudioBlock(notionapi.ExternalFile("https://example.com/a.mp3")),
+			want:  `{"object":"block","type":"audio","audio":{"type":"external","external":{"url":"https://example.com/a.mp3"}}}`,
+		},
+		{
+			name:  "video from a file upload",
+			block: notionapi.NewVideoBlock(notionapi.UploadedFile("upload_id"), caption),
+			want:  `{"object":"block","type":"video","video":{"caption":[{"type":"text","text":{"content":"Caption"}}],"type":"file_upload","file_upload":{"id":"upload_id"}}}`,
+		},
+		{
+			name:  "pdf from a file upload",
+			block: notionapi.NewPdfBlock(notionapi.UploadedFile("upload_id")),
+			want:  `{"object":"block","type":"pdf","pdf":{"type":"file_upload","file_upload":{"id":"upload_id"}}}`,
+		},
+		{
+			name:  "bookmark",
+			block: notionapi.NewBookmarkBlock("https://example.com", caption),
+			want:  `{"object":"block","type":"bookmark","bookmark":{"caption":[{"type":"text","text":{"content":"Caption"}}],"url":"https://example.com"}}`,
+		},
+		{
+			name:  "embed",
+			block: notionapi.NewEmbedBlock("https://example.com"),
+			want:  `{"object":"block","type":"embed","embed":{"url":"https://example.com"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.block)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockClientAppendChildrenNotCreatable(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		t.Errorf("unexpected request to %s", req.URL)
+		return newJSONResponse(http.StatusBadRequest, `{"object":"error","status":400,"code":"validation_error"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	linkPreview := &notionapi.LinkPreviewBlock{
+		BasicBlock:  notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeLinkPreview},
+		LinkPreview: notionapi.LinkPreview{URL: "https://example.com"},
+	}
+	tests := []struct {
+		name     string
+		children []notionapi.Block
+	}{
+		{
+			name:     "top level",
+			children: []notionapi.Block{notionapi.NewBookmarkBlock("https://example.com"), linkPreview},
+		},
+		{
+			name: "nested",
+			children: []notionapi.Block{&notionapi.ToggleBlock{
+				BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeToggle},
+				Toggle:     notionapi.Toggle{Children: notionapi.Blocks{notionapi.NewBookmarkBlock("https://example.com"), linkPreview}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Block.AppendChildren(context.Background(), "some_id", &notionapi.AppendBlockChildrenRequest{
+				Children: tt.children,
+			})
+			notCreatable, ok := err.(*notionapi.BlockNotCreatableError)
+			if !ok || notCreatable.Type != notionapi.BlockTypeLinkPreview {
+				t.Errorf("AppendChildren() error = %v, want a *BlockNotCreatableError", err)
+			}
+		})
+	}
+}
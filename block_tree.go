@@ -0,0 +1,230 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// BlockNode is a block along with its descendants, as returned by
+// BlockClient.GetTree.
+type BlockNode struct {
+	Block    Block
+	Children []*BlockNode
+}
+
+// BlockTreeOptions configures BlockClient.GetTree.
+type BlockTreeOptions struct {
+	// MaxDepth limits the levels of descendants retrieved: 1 retrieves only the
+	// children of the root block. 0 means no limit.
+	MaxDepth int
+	// Concurrency is the maximum number of blocks whose children are retrieved
+	// at the same time. Defaults to 1.
+	Concurrency int
+	// IncludeChildPages descends into child pages and child databases, which
+	// are otherwise returned without their content.
+	IncludeChildPages bool
+}
+
+// GetTree retrieves the block with the ID specified and all of its
+// descendants, following pagination and has_children.
+func (bc *BlockClient) GetTree(ctx context.Context, id BlockID, opts *BlockTreeOptions) (*BlockNode, error) {
+	block, err := bc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	root := &BlockNode{Block: block}
+	if !block.GetHasChildren() {
+		return root, nil
+	}
+	if err = bc.getSubtree(ctx, root, opts); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// getSubtree retrieves the descendants of root, which is not retrieved again.
+func (bc *BlockClient) getSubtree(ctx context.Context, root *BlockNode, opts *BlockTreeOptions) error {
+	if opts == nil {
+		opts = &BlockTreeOptions{}
+	}
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	t := &blockTreeFetcher{
+		client: bc,
+		opts:   opts,
+		sem:    make(chan struct{}, concurrency),
+		cancel: cancel,
+	}
+	t.wg.Add(1)
+	go t.fetch(ctx, root, 1)
+	t.wg.Wait()
+	return t.err
+}
+
+type blockTreeFetcher struct {
+	client *BlockClient
+	opts   *BlockTreeOptions
+	sem    chan struct{}
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+
+	once sync.Once
+	err  error
+}
+
+func (t *blockTreeFetcher) fail(err error) {
+	t.once.Do(func() {
+		t.err = err
+		t.cancel()
+	})
+}
+
+// fetch retrieves the children of node, at the given depth, and then their
+// own children concurrently.
+func (t *blockTreeFetcher) fetch(ctx context.Context, node *BlockNode, depth int) {
+	defer t.wg.Done()
+
+	select {
+	case t.sem <- struct{}{}:
+	case <-ctx.Done():
+		t.fail(ctx.Err())
+		return
+	}
+	children, err := t.client.getAllChildren(ctx, node.Block.GetID())
+	<-t.sem
+	if err != nil {
+		t.fail(err)
+		return
+	}
+
+	node.Children = make([]*BlockNode, len(children))
+	for i, child := range children {
+		node.Children[i] = &BlockNode{Block: child}
+		if !child.GetHasChildren() || (t.opts.MaxDepth > 0 && depth >= t.opts.MaxDepth) {
+			continue
+		}
+		if !t.opts.IncludeChildPages && isChildPageOrDatabase(child) {
+			continue
+		}
+		t.wg.Add(1)
+		go t.fetch(ctx, node.Children[i], depth+1)
+	}
+}
+
+// getAllChildren retrieves every page of children of the block.
+func (bc *BlockClient) getAllChildren(ctx context.Context, id BlockID) (Blocks, error) {
+	var blocks Blocks
+	it := bc.ChildrenIterator(ctx, id)
+	for it.Next() {
+		blocks = append(blocks, it.Block())
+	}
+	return blocks, it.Err()
+}
+
+func isChildPageOrDatabase(block Block) bool {
+	switch block.GetType() {
+	case BlockTypeChildPage, BlockTypeChildDatabase:
+		return true
+	}
+	return false
+}
+
+// SkipChildren is returned by a WalkFunc to skip the descendants of the
+// block it is called for.
+var SkipChildren = errors.New("skip children")
+
+// StopWalk is returned by a WalkFunc to stop Walk without an error.
+var StopWalk = errors.New("stop walk")
+
+// WalkFunc is called by Walk for each block, with its depth in the tree: 0
+// for the root.
+type WalkFunc func(block Block, depth int) error
+
+// Walk calls fn for each block of tree, depth first, parents before their
+// children. It stops at the first error returned by fn, which it returns,
+// unless it is SkipChildren or StopWalk.
+func Walk(tree *BlockNode, fn WalkFunc) error {
+	if tree == nil {
+		return nil
+	}
+	err := walk(tree, 0, fn)
+	if err == StopWalk {
+		return nil
+	}
+	return err
+}
+
+func walk(node *BlockNode, depth int, fn WalkFunc) error {
+	err := fn(node.Block, depth)
+	if err == SkipChildren {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, child := range node.Children {
+		if err = walk(child, depth+1, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewBlockNodes returns the blocks as BlockNodes, the children set in the
+// blocks, as for BlockService.AppendChildren, moved to the Children of the
+// nodes.
+func NewBlockNodes(blocks Blocks) ([]*BlockNode, error) {
+	nodes := make([]*BlockNode, 0, len(blocks))
+	for _, block := range blocks {
+		data, err := json.Marshal(block)
+		if err != nil {
+			return nil, err
+		}
+		var raw map[string]interface{}
+		if err = json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+		node, err := newBlockNode(raw)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func newBlockNode(raw map[string]interface{}) (*BlockNode, error) {
+	var children []interface{}
+	if blockType, ok := raw["type"].(string); ok {
+		if content, ok := raw[blockType].(map[string]interface{}); ok {
+			children, _ = content["children"].([]interface{})
+			delete(content, "children")
+		}
+	}
+	block, err := decodeBlock(raw)
+	if err != nil {
+		return nil, err
+	}
+	node := &BlockNode{Block: block}
+	for _, child := range children {
+		childRaw, ok := child.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid child of block of type %s", block.GetType())
+		}
+		childNode, err := newBlockNode(childRaw)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, childNode)
+	}
+	return node, nil
+}
@@ -0,0 +1,188 @@
+package notionapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// newMockedBlockTreeClient serves a page made of a toggle holding a paragraph,
+// whose children are split across two pages of results, and a child page.
+func newMockedBlockTreeClient(t *testing.T) *http.Client {
+	responses := map[string]string{
+		"/v1/blocks/root": `{"object":"block","id":"root","type":"child_page","has_children":true,"child_page":{"title":"Root"}}`,
+		"/v1/blocks/root/children": `{"object":"list","has_more":true,"next_cursor":"cursor","results":[
+			{"object":"block","id":"toggle","type":"toggle","has_children":true,"toggle":{"rich_text":[]}}]}`,
+		"/v1/blocks/root/children?start_cursor=cursor": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"child_page","type":"child_page","has_children":true,"child_page":{"title":"Child"}}]}`,
+		"/v1/blocks/toggle/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"paragraph","type":"paragraph","paragraph":{"rich_text":[]}}]}`,
+		"/v1/blocks/child_page/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"nested","type":"divider","divider":{}}]}`,
+	}
+	return newTestClient(func(req *http.Request) *http.Response {
+		body, ok := responses[req.URL.RequestURI()]
+		if !ok {
+			t.Errorf("unexpected request to %s", req.URL)
+			return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found"}`)
+		}
+		return newJSONResponse(http.StatusOK, body)
+	})
+}
+
+// flattenBlockTree lists the IDs of the blocks of the tree, depth first.
+func flattenBlockTree(node *notionapi.BlockNode) []string {
+	ids := []string{node.Block.GetID().String()}
+	for _, child := range node.Children {
+		ids = append(ids, flattenBlockTree(child)...)
+	}
+	return ids
+}
+
+func TestBlockClientGetTree(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *notionapi.BlockTreeOptions
+		want []string
+	}{
+		{
+			name: "retrieves all descendants except the content of child pages",
+			want: []string{"root", "toggle", "paragraph", "child_page"},
+		},
+		{
+			name: "stops at the max depth",
+			opts: &notionapi.BlockTreeOptions{MaxDepth: 1},
+			want: []string{"root", "toggle", "child_page"},
+		},
+		{
+			name: "descends into child pages concurrently",
+			opts: &notionapi.BlockTreeOptions{IncludeChildPages: true, Concurrency: 3},
+			want: []string{"root", "toggle", "paragraph", "child_page", "nested"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newMockedBlockTreeClient(t)))
+			got, err := client.Block.GetTree(context.Background(), "root", tt.opts)
+			if err != nil {
+				t.Fatalf("GetTree() error = %v", err)
+			}
+			if ids := flattenBlockTree(got); !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("GetTree() got = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockChildrenIterator(t *testing.T) {
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newMockedBlockTreeClient(t)))
+
+	var got []string
+	it := client.Block.ChildrenIterator(context.Background(), "root")
+	for it.Next() {
+		got = append(got, it.Block().GetID().String())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	if want := []string{"toggle", "child_page"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ChildrenIterator() got = %v, want %v", got, want)
+	}
+
+	t.Run("stops on errors", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"not found"}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+		it := client.Block.ChildrenIterator(context.Background(), "missing")
+		if it.Next() {
+			t.Error("Next() = true, want false")
+		}
+		if !notionapi.IsErrorCode(it.Err(), notionapi.ErrorCodeObjectNotFound) {
+			t.Errorf("Err() = %v", it.Err())
+		}
+	})
+}
+
+func TestWalk(t *testing.T) {
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newMockedBlockTreeClient(t)))
+	tree, err := client.Block.GetTree(context.Background(), "root", &notionapi.BlockTreeOptions{IncludeChildPages: true})
+	if err != nil {
+		t.Fatalf("GetTree() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		stop string
+		err  error
+		want []string
+	}{
+		{
+			name: "visits every block with its depth",
+			want: []string{"root:0", "toggle:1", "paragraph:2", "child_page:1", "nested:2"},
+		},
+		{
+			name: "skips children",
+			stop: "toggle",
+			err:  notionapi.SkipChildren,
+			want: []string{"root:0", "toggle:1", "child_page:1", "nested:2"},
+		},
+		{
+			name: "stops",
+			stop: "paragraph",
+			err:  notionapi.StopWalk,
+			want: []string{"root:0", "toggle:1", "paragraph:2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := notionapi.Walk(tree, func(block notionapi.Block, depth int) error {
+				got = append(got, fmt.Sprintf("%s:%d", block.GetID(), depth))
+				if block.GetID().String() == tt.stop {
+					return tt.err
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Walk() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Walk() visited %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockClientGetTreeTemplate(t *testing.T) {
+	responses := map[string]string{
+		"/v1/blocks/template": `{"object":"block","id":"template","type":"template","has_children":true,"template":{"rich_text":[{"type":"text","text":{"content":"Add a task"},"plain_text":"Add a task"}]}}`,
+		"/v1/blocks/template/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"to_do","type":"to_do","to_do":{"rich_text":[],"checked":false}}]}`,
+	}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		return newJSONResponse(http.StatusOK, responses[req.URL.RequestURI()])
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tree, err := client.Block.GetTree(context.Background(), "template", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, ok := tree.Block.(*notionapi.TemplateBlock)
+	if !ok {
+		t.Fatalf("GetTree() root is a %T, want a *TemplateBlock", tree.Block)
+	}
+	if got := template.GetRichTextString(); got != "Add a task" {
+		t.Errorf("GetRichTextString() = %q, want %q", got, "Add a task")
+	}
+	if len(tree.Children) != 1 || tree.Children[0].Block.GetType() != notionapi.BlockTypeToDo {
+		t.Errorf("GetTree() children = %v, want a to_do block", tree.Children)
+	}
+}
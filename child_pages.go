@@ -0,0 +1,57 @@
+package notionapi
+
+import "context"
+
+// ChildPageRef is a page found in the content of another page.
+type ChildPageRef struct {
+	ID    PageID
+	Title string
+}
+
+// ChildDatabaseRef is a database found in the content of a page.
+type ChildDatabaseRef struct {
+	ID    DatabaseID
+	Title string
+}
+
+// ListChildPages returns the pages whose parent is the page with the ID
+// specified, in the order they appear in its content, including the pages
+// nested in toggles or columns. The pages of the child pages are not listed.
+func (pc *PageClient) ListChildPages(ctx context.Context, id PageID) ([]ChildPageRef, error) {
+	var pages []ChildPageRef
+	err := pc.walkContent(ctx, id, func(block Block) {
+		if b, ok := block.(*ChildPageBlock); ok {
+			pages = append(pages, ChildPageRef{ID: PageID(b.ID), Title: b.ChildPage.Title})
+		}
+	})
+	return pages, err
+}
+
+// ListChildDatabases returns the databases whose parent is the page with the
+// ID specified, in the order they appear in its content, including the
+// databases nested in toggles or columns.
+func (pc *PageClient) ListChildDatabases(ctx context.Context, id PageID) ([]ChildDatabaseRef, error) {
+	var databases []ChildDatabaseRef
+	err := pc.walkContent(ctx, id, func(block Block) {
+		if b, ok := block.(*ChildDatabaseBlock); ok {
+			databases = append(databases, ChildDatabaseRef{ID: DatabaseID(b.ID), Title: b.ChildDatabase.Title})
+		}
+	})
+	return databases, err
+}
+
+// walkContent calls fn for each block of the content of a page, child pages
+// and child databases included but not their content.
+func (pc *PageClient) walkContent(ctx context.Context, id PageID, fn func(Block)) error {
+	tree, err := pc.apiClient.Block.GetTree(ctx, BlockID(id), nil)
+	if err != nil {
+		return err
+	}
+	for _, child := range tree.Children {
+		_ = Walk(child, func(block Block, depth int) error {
+			fn(block)
+			return nil
+		})
+	}
+	return nil
+}
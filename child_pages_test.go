@@ -0,0 +1,50 @@
+package notionapi_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestPageClientListChildren(t *testing.T) {
+	responses := map[string]string{
+		"/v1/blocks/page": `{"object":"block","id":"page","type":"child_page","has_children":true,"child_page":{"title":"Page"}}`,
+		"/v1/blocks/page/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"first","type":"child_page","has_children":true,"child_page":{"title":"First"}},
+			{"object":"block","id":"toggle","type":"toggle","has_children":true,"toggle":{"rich_text":[]}},
+			{"object":"block","id":"tasks","type":"child_database","child_database":{"title":"Tasks"}}]}`,
+		"/v1/blocks/toggle/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"second","type":"child_page","child_page":{"title":"Second"}},
+			{"object":"block","id":"notes","type":"child_database","child_database":{"title":"Notes"}}]}`,
+	}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		body, ok := responses[req.URL.RequestURI()]
+		if !ok {
+			t.Errorf("unexpected request to %s", req.URL)
+			return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found"}`)
+		}
+		return newJSONResponse(http.StatusOK, body)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	pages, err := client.Page.ListChildPages(context.Background(), "page")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPages := []notionapi.ChildPageRef{{ID: "first", Title: "First"}, {ID: "second", Title: "Second"}}
+	if !reflect.DeepEqual(pages, wantPages) {
+		t.Errorf("ListChildPages() = %v, want %v", pages, wantPages)
+	}
+
+	databases, err := client.Page.ListChildDatabases(context.Background(), "page")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDatabases := []notionapi.ChildDatabaseRef{{ID: "notes", Title: "Notes"}, {ID: "tasks", Title: "Tasks"}}
+	if !reflect.DeepEqual(databases, wantDatabases) {
+		t.Errorf("ListChildDatabases() = %v, want %v", databases, wantDatabases)
+	}
+}
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -48,13 +51,35 @@ type Client struct {
 
 	maxRetries int
 
+	// rateLimiter, if set, is waited on before every request.
+	rateLimiter RateLimiter
+
+	// maxFileUploadSize is the largest file the workspace accepts, 0 if unknown.
+	maxFileUploadSize int64
+
 	Token Token
 
 	// used in Authorization header only for requests that require Basic authentication.
 	oauthID     string
 	oauthSecret string
 
+	// dataSourceIDs caches the IDs of the data sources of databases, by
+	// database ID.
+	dataSourceIDs sync.Map
+
+	// queryCache, if set, caches the responses of database queries, see
+	// WithQueryCache.
+	queryCache *queryCache
+
+	// userCache caches the users of the workspace, see Client.UserCache.
+	userCache     *UserCache
+	userCacheOnce sync.Once
+	// hydrateUsers, if set, replaces the partial users of the pages
+	// retrieved, see WithUserHydration.
+	hydrateUsers bool
+
 	Database       DatabaseService
+	DataSource     DataSourceService
 	Block          BlockService
 	Page           PageService
 	User           UserService
@@ -79,6 +104,7 @@ func NewClient(token Token, opts ...ClientOption) *Client {
 	}
 
 	c.Database = &DatabaseClient{apiClient: c}
+	c.DataSource = &DataSourceClient{apiClient: c}
 	c.Block = &BlockClient{apiClient: c}
 	c.Page = &PageClient{apiClient: c}
 	c.User = &UserClient{apiClient: c}
@@ -115,6 +141,30 @@ func WithRetry(retries int) ClientOption {
 	}
 }
 
+// RateLimiter throttles the requests made by the client. It is satisfied by
+// *rate.Limiter from golang.org/x/time/rate.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// WithRateLimiter makes every request, including retries and file upload
+// parts, wait on limiter before it is sent. Notion allows an average of three
+// requests per second per integration.
+func WithRateLimiter(limiter RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithMaxFileUploadSize sets the largest file, in bytes, the workspace of the
+// integration accepts, so that bigger files are rejected before being sent.
+// It depends on the workspace plan, e.g. 5MiB for free workspaces.
+func WithMaxFileUploadSize(size int64) ClientOption {
+	return func(c *Client) {
+		c.maxFileUploadSize = size
+	}
+}
+
 // WithOAuthAppCredentials sets the OAuth app ID and secret to use when fetching a token from Notion.
 func WithOAuthAppCredentials(id, secret string) ClientOption {
 	return func(c *Client) {
@@ -128,13 +178,23 @@ func (c *Client) request(ctx context.Context, method string, urlStr string, quer
 }
 
 func (c *Client) requestImpl(ctx context.Context, method string, urlStr string, queryParams map[string]string, requestBody interface{}, basicAuth bool, contentType ContentType, errDecoder errJsonDecodeFunc) (*http.Response, error) {
-	u, err := c.baseUrl.Parse(fmt.Sprintf("%s/%s", c.apiVersion, urlStr))
+	var u *url.URL
+	var err error
+	if strings.HasPrefix(urlStr, "https://") || strings.HasPrefix(urlStr, "http://") {
+		// Absolute URLs returned by the API, e.g. a file upload's upload_url.
+		u, err = url.Parse(urlStr)
+	} else {
+		u, err = c.baseUrl.Parse(fmt.Sprintf("%s/%s", c.apiVersion, urlStr))
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	var buf io.ReadWriter
-	if requestBody != nil && !reflect.ValueOf(requestBody).IsNil() {
+	var buf io.Reader
+	if r, ok := requestBody.(io.Reader); ok {
+		// Already encoded bodies (e.g. multipart/form-data) are sent as is.
+		buf = r
+	} else if requestBody != nil && !reflect.ValueOf(requestBody).IsNil() {
 		body, err := json.Marshal(requestBody)
 		if err != nil {
 			return nil, err
@@ -154,10 +214,13 @@ func (c *Client) requestImpl(ctx context.Context, method string, urlStr string,
 		return nil, err
 	}
 
-	if basicAuth {
+	switch {
+	case u.Scheme != c.baseUrl.Scheme || u.Host != c.baseUrl.Host:
+		// The token of the integration is only sent to the API itself.
+	case basicAuth:
 		cred := base64.StdEncoding.EncodeToString([]byte(c.oauthID + ":" + c.oauthSecret))
 		req.Header.Add("Authorization", fmt.Sprintf("Basic %s", cred))
-	} else {
+	default:
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.Token.String()))
 	}
 	req.Header.Add("Notion-Version", c.notionVersion)
@@ -166,6 +229,12 @@ func (c *Client) requestImpl(ctx context.Context, method string, urlStr string,
 	failedAttempts := 0
 	var res *http.Response
 	for {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
 		var err error
 		res, err = c.httpClient.Do(req.WithContext(ctx))
 		if err != nil {
@@ -185,16 +254,26 @@ func (c *Client) requestImpl(ctx context.Context, method string, urlStr string,
 		if len(retryAfterHeader) == 0 {
 			return nil, &RateLimitedError{Message: "Retry-After header missing from Notion API response headers for 429 response"}
 		}
-		retryAfter := retryAfterHeader[0]
-
-		waitSeconds, err := strconv.Atoi(retryAfter)
-		if err != nil {
+		wait, ok := parseRetryAfter(retryAfterHeader[0], time.Now())
+		if !ok {
 			break // should not happen
 		}
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+
+		// The body of the previous attempt has been consumed, so it has to be
+		// recreated before the request can be sent again.
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
-		case <-time.After(time.Duration(waitSeconds) * time.Second):
+		case <-time.After(wait):
 		}
 	}
 
@@ -209,6 +288,22 @@ func (c *Client) requestImpl(ctx context.Context, method string, urlStr string,
 	return res, nil
 }
 
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
+
 func decodeClientError(data []byte) error {
 	var apiErr Error
 	err := json.Unmarshal(data, &apiErr)
@@ -238,3 +333,18 @@ func (p *Pagination) ToQuery() map[string]string {
 
 	return r
 }
+
+// filterPropertiesQuery returns the query string of the filter_properties
+// parameter, repeated for each property, or "" without properties. It is
+// appended to the paths of requests since the query parameters of request
+// cannot be repeated.
+func filterPropertiesQuery(properties []PropertyID) string {
+	if len(properties) == 0 {
+		return ""
+	}
+	q := url.Values{}
+	for _, id := range properties {
+		q.Add("filter_properties", id.String())
+	}
+	return "?" + q.Encode()
+}
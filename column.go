@@ -0,0 +1,37 @@
+package notionapi
+
+import "fmt"
+
+// NewColumnList returns a column_list block with a column for each element of
+// columns, holding its blocks. The API requires at least two columns, none of
+// them empty, which NewColumnList checks.
+func NewColumnList(columns ...Blocks) (*ColumnListBlock, error) {
+	if len(columns) < 2 {
+		return nil, fmt.Errorf("column list needs at least 2 columns, got %d", len(columns))
+	}
+	children := make(Blocks, len(columns))
+	for i, blocks := range columns {
+		if len(blocks) == 0 {
+			return nil, fmt.Errorf("column %d is empty", i)
+		}
+		for _, block := range blocks {
+			if block == nil {
+				return nil, fmt.Errorf("column %d has a nil block", i)
+			}
+		}
+		children[i] = &ColumnBlock{
+			BasicBlock: BasicBlock{
+				Object: ObjectTypeBlock,
+				Type:   BlockTypeColumn,
+			},
+			Column: Column{Children: blocks},
+		}
+	}
+	return &ColumnListBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypeColumnList,
+		},
+		ColumnList: ColumnList{Children: children},
+	}, nil
+}
@@ -0,0 +1,53 @@
+package notionapi_test
+
+import (
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestNewColumnList(t *testing.T) {
+	divider := &notionapi.DividerBlock{BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeDivider}}
+
+	tests := []struct {
+		name    string
+		columns []notionapi.Blocks
+		wantErr bool
+	}{
+		{
+			name:    "builds a column for each slice of blocks",
+			columns: []notionapi.Blocks{{divider}, {divider, divider}},
+		},
+		{
+			name:    "fails with a single column",
+			columns: []notionapi.Blocks{{divider}},
+			wantErr: true,
+		},
+		{
+			name:    "fails with an empty column",
+			columns: []notionapi.Blocks{{divider}, {}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := notionapi.NewColumnList(tt.columns...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewColumnList() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.ColumnList.Children) != len(tt.columns) {
+				t.Fatalf("NewColumnList() got %d columns, want %d", len(got.ColumnList.Children), len(tt.columns))
+			}
+			for i, child := range got.ColumnList.Children {
+				column := child.(*notionapi.ColumnBlock)
+				if len(column.Column.Children) != len(tt.columns[i]) {
+					t.Errorf("column %d has %d blocks, want %d", i, len(column.Column.Children), len(tt.columns[i]))
+				}
+			}
+		})
+	}
+}
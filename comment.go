@@ -3,6 +3,8 @@ package notionapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"time"
@@ -16,6 +18,8 @@ func (cID CommentID) String() string {
 
 type CommentService interface {
 	Create(ctx context.Context, request *CommentCreateRequest) (*Comment, error)
+	List(context.Context, BlockID, *Pagination) (*CommentQueryResponse, error)
+	ListIterator(context.Context, BlockID) *CommentIterator
 	Get(context.Context, BlockID, *Pagination) (*CommentQueryResponse, error)
 }
 
@@ -36,6 +40,10 @@ type CommentClient struct {
 //
 // See https://developers.notion.com/reference/create-a-comment
 func (cc *CommentClient) Create(ctx context.Context, requestBody *CommentCreateRequest) (*Comment, error) {
+	if err := requestBody.Validate(); err != nil {
+		return nil, fmt.Errorf("CommentClient.Create: %w", err)
+	}
+
 	res, err := cc.apiClient.request(ctx, http.MethodPost, "comments", nil, requestBody, ContentTypeJSON)
 	if err != nil {
 		return nil, err
@@ -61,18 +69,168 @@ type CommentCreateRequest struct {
 	Parent       Parent       `json:"parent,omitempty"`
 	DiscussionID DiscussionID `json:"discussion_id,omitempty"`
 	RichText     []RichText   `json:"rich_text"`
+	// Attachments are files uploaded with FileUploadService, at most
+	// MaxCommentAttachments.
+	Attachments []CommentAttachmentRequest `json:"attachments,omitempty"`
+	// DisplayName is the name shown as the author of the comment. Defaults
+	// to the name of the integration.
+	DisplayName *CommentDisplayName `json:"display_name,omitempty"`
+}
+
+type CommentDisplayNameType string
+
+const (
+	// CommentDisplayNameTypeIntegration shows the name of the integration,
+	// the default.
+	CommentDisplayNameTypeIntegration CommentDisplayNameType = "integration"
+	// CommentDisplayNameTypeUser shows the name of the user who authorized
+	// the public integration.
+	CommentDisplayNameTypeUser CommentDisplayNameType = "user"
+	// CommentDisplayNameTypeCustom shows the name set in Custom.
+	CommentDisplayNameTypeCustom CommentDisplayNameType = "custom"
+)
+
+// CommentDisplayName is the name shown as the author of a comment created by
+// an integration. ResolvedName is the name shown, returned by the API.
+type CommentDisplayName struct {
+	Type         CommentDisplayNameType `json:"type"`
+	Custom       *CustomDisplayName     `json:"custom,omitempty"`
+	ResolvedName string                 `json:"resolved_name,omitempty"`
+}
+
+type CustomDisplayName struct {
+	Name string `json:"name"`
+}
+
+// NewCustomDisplayName returns the display name showing name as the author
+// of a comment.
+func NewCustomDisplayName(name string) *CommentDisplayName {
+	return &CommentDisplayName{Type: CommentDisplayNameTypeCustom, Custom: &CustomDisplayName{Name: name}}
+}
+
+// MaxCommentAttachments is the maximum number of files attached to a comment.
+const MaxCommentAttachments = 3
+
+// CommentAttachmentRequest attaches a file uploaded with FileUploadService to
+// a comment being created.
+type CommentAttachmentRequest struct {
+	FileUploadID FileUploadID `json:"file_upload_id"`
+	Type         FileType     `json:"type,omitempty"`
+}
+
+// NewCommentAttachment returns the attachment of the file upload with the ID
+// specified, which must be uploaded.
+func NewCommentAttachment(id FileUploadID) CommentAttachmentRequest {
+	return CommentAttachmentRequest{FileUploadID: id, Type: FileTypeFileUpload}
+}
+
+// UploadCommentAttachments uploads the files at filePaths with
+// FileUploadService.UploadFile and returns their attachments, for
+// CommentCreateRequest.Attachments.
+func (c *Client) UploadCommentAttachments(ctx context.Context, filePaths ...string) ([]CommentAttachmentRequest, error) {
+	if len(filePaths) > MaxCommentAttachments {
+		return nil, fmt.Errorf("a comment can have at most %d attachments, not %d", MaxCommentAttachments, len(filePaths))
+	}
+	attachments := make([]CommentAttachmentRequest, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		result, err := c.FileUpload.UploadFile(ctx, filePath, nil)
+		if err != nil {
+			return nil, fmt.Errorf("upload %s: %w", filePath, err)
+		}
+		attachments = append(attachments, NewCommentAttachment(result.FileUpload.ID))
+	}
+	return attachments, nil
+}
+
+// CommentOnPage adds a comment with richText to the page with the ID
+// specified, starting a new discussion. The users mentioned in richText, such
+// as with richtext.Builder.Mentions, are notified.
+func (c *Client) CommentOnPage(ctx context.Context, id PageID, richText ...RichText) (*Comment, error) {
+	return c.Comment.Create(ctx, &CommentCreateRequest{
+		Parent:   Parent{Type: ParentTypePageID, PageID: id},
+		RichText: richText,
+	})
+}
+
+// ReplyToDiscussion adds a comment with richText to the discussion with the
+// ID specified, such as the DiscussionID of a comment.
+func (c *Client) ReplyToDiscussion(ctx context.Context, id DiscussionID, richText ...RichText) (*Comment, error) {
+	return c.Comment.Create(ctx, &CommentCreateRequest{DiscussionID: id, RichText: richText})
+}
+
+// Validate checks that the request has rich text and exactly one of a page
+// parent and a discussion, so that invalid requests fail without making an
+// API call.
+func (r *CommentCreateRequest) Validate() error {
+	if r == nil {
+		return errors.New("nil request")
+	}
+	hasParent := r.Parent != (Parent{})
+	switch {
+	case hasParent && r.DiscussionID != "":
+		return errors.New("a comment cannot have both a parent and a discussion id")
+	case !hasParent && r.DiscussionID == "":
+		return errors.New("a comment needs a parent or a discussion id")
+	case hasParent && (r.Parent.Type != ParentTypePageID || r.Parent.PageID == ""):
+		return errors.New("the parent of a comment must be a page, given by its page id")
+	case len(r.RichText) == 0:
+		return errors.New("a comment needs rich text")
+	case len(r.Attachments) > MaxCommentAttachments:
+		return fmt.Errorf("a comment can have at most %d attachments, not %d", MaxCommentAttachments, len(r.Attachments))
+	}
+	for _, attachment := range r.Attachments {
+		if attachment.FileUploadID == "" {
+			return errors.New("an attachment of the comment has no file upload id")
+		}
+	}
+	if r.DisplayName != nil {
+		return r.DisplayName.validate()
+	}
+	return nil
 }
 
-// Retrieves a list of un-resolved Comment objects from a page or block.
+// MarshalJSON omits the parent of the comments added to a discussion, which
+// the API rejects when it is empty.
+func (r CommentCreateRequest) MarshalJSON() ([]byte, error) {
+	type commentCreateRequest CommentCreateRequest
+	var parent *Parent
+	if r.Parent != (Parent{}) {
+		parent = &r.Parent
+	}
+	return json.Marshal(struct {
+		Parent *Parent `json:"parent,omitempty"`
+		commentCreateRequest
+	}{parent, commentCreateRequest(r)})
+}
+
+func (n *CommentDisplayName) validate() error {
+	switch n.Type {
+	case CommentDisplayNameTypeIntegration, CommentDisplayNameTypeUser:
+		if n.Custom != nil {
+			return fmt.Errorf("a %s display name cannot have a custom name", n.Type)
+		}
+	case CommentDisplayNameTypeCustom:
+		if n.Custom == nil || n.Custom.Name == "" {
+			return errors.New("a custom display name needs a name")
+		}
+	default:
+		return fmt.Errorf("unsupported display name type %q", n.Type)
+	}
+	return nil
+}
+
+// Retrieves a list of un-resolved Comment objects from a page or block. The
+// next page of comments starts at the NextCursor of the response, set as the
+// StartCursor of pagination, while HasMore is true.
 //
-// See https://developers.notion.com/reference/retrieve-a-comment
-func (cc *CommentClient) Get(ctx context.Context, id BlockID, pagination *Pagination) (*CommentQueryResponse, error) {
+// See https://developers.notion.com/reference/list-comments
+func (cc *CommentClient) List(ctx context.Context, id BlockID, pagination *Pagination) (*CommentQueryResponse, error) {
 	queryParams := map[string]string{}
 	if pagination != nil {
 		queryParams = pagination.ToQuery()
 	}
 
-	queryParams["block_id"] = id.String()
+	queryParams["block_id"] = pathID(id.String())
 
 	res, err := cc.apiClient.request(ctx, http.MethodGet, "comments", queryParams, nil, ContentTypeJSON)
 	if err != nil {
@@ -95,6 +253,70 @@ func (cc *CommentClient) Get(ctx context.Context, id BlockID, pagination *Pagina
 	return &response, nil
 }
 
+// ListIterator returns an iterator over all the un-resolved comments of a
+// page or block, which retrieves the pages of results as they are needed.
+//
+//	it := client.Comment.ListIterator(ctx, blockID)
+//	for it.Next() {
+//		comment := it.Comment()
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle the error
+//	}
+func (cc *CommentClient) ListIterator(ctx context.Context, id BlockID) *CommentIterator {
+	return &CommentIterator{ctx: ctx, client: cc, id: id}
+}
+
+// CommentIterator iterates over the comments of a page or block, following
+// next_cursor. It is not safe for concurrent use.
+type CommentIterator struct {
+	ctx    context.Context
+	client *CommentClient
+	id     BlockID
+
+	comments []Comment
+	pager    cursorPager
+	current  *Comment
+}
+
+// Next advances the iterator to the next comment, which is then available
+// through Comment. It returns false when there are no more comments or an
+// error occurred.
+func (it *CommentIterator) Next() bool {
+	for len(it.comments) == 0 {
+		if !it.pager.fetch(func(cursor Cursor) (Cursor, bool, error) {
+			res, err := it.client.List(it.ctx, it.id, &Pagination{StartCursor: cursor})
+			if err != nil {
+				return "", false, err
+			}
+			it.comments = res.Results
+			return res.NextCursor, res.HasMore, nil
+		}) {
+			it.current = nil
+			return false
+		}
+	}
+	it.current, it.comments = &it.comments[0], it.comments[1:]
+	return true
+}
+
+// Comment returns the comment the iterator is at.
+func (it *CommentIterator) Comment() *Comment {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *CommentIterator) Err() error {
+	return it.pager.err
+}
+
+// Get lists the un-resolved comments of a page or block, as List does.
+//
+// Deprecated: use List.
+func (cc *CommentClient) Get(ctx context.Context, id BlockID, pagination *Pagination) (*CommentQueryResponse, error) {
+	return cc.List(ctx, id, pagination)
+}
+
 type DiscussionID string
 
 func (dID DiscussionID) String() string {
@@ -110,6 +332,26 @@ type Comment struct {
 	CreatedBy      User         `json:"created_by,omitempty"`
 	RichText       []RichText   `json:"rich_text"`
 	Parent         Parent       `json:"parent"`
+	// Attachments are the files attached to the comment.
+	Attachments []CommentAttachment `json:"attachments,omitempty"`
+	// DisplayName is the name shown as the author of the comment.
+	DisplayName *CommentDisplayName `json:"display_name,omitempty"`
+}
+
+type CommentAttachmentCategory string
+
+const (
+	CommentAttachmentCategoryAudio        CommentAttachmentCategory = "audio"
+	CommentAttachmentCategoryImage        CommentAttachmentCategory = "image"
+	CommentAttachmentCategoryPDF          CommentAttachmentCategory = "pdf"
+	CommentAttachmentCategoryProductivity CommentAttachmentCategory = "productivity"
+	CommentAttachmentCategoryVideo        CommentAttachmentCategory = "video"
+)
+
+// CommentAttachment is a file attached to a comment, hosted by Notion.
+type CommentAttachment struct {
+	Category CommentAttachmentCategory `json:"category"`
+	File     *FileObject               `json:"file,omitempty"`
 }
 
 type CommentQueryResponse struct {
@@ -2,6 +2,8 @@ package notionapi_test
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
@@ -166,4 +168,331 @@ func TestCommentClient(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("List pagination", func(t *testing.T) {
+		var queries []string
+		c := newTestClient(func(req *http.Request) *http.Response {
+			queries = append(queries, req.URL.RawQuery)
+			if req.URL.Query().Get("start_cursor") == "" {
+				return newJSONResponse(http.StatusOK, `{"object":"list","results":[{"object":"comment","id":"comment_1"}],"has_more":true,"next_cursor":"cursor_1"}`)
+			}
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[{"object":"comment","id":"comment_2"}],"has_more":false,"next_cursor":null}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		var ids []notionapi.ObjectID
+		pagination := &notionapi.Pagination{PageSize: 1}
+		for {
+			res, err := client.Comment.List(context.Background(), "block_id", pagination)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, comment := range res.Results {
+				ids = append(ids, comment.ID)
+			}
+			if !res.HasMore {
+				break
+			}
+			pagination.StartCursor = res.NextCursor
+		}
+		if want := []notionapi.ObjectID{"comment_1", "comment_2"}; !reflect.DeepEqual(ids, want) {
+			t.Errorf("List() comments = %v, want %v", ids, want)
+		}
+		if want := []string{"block_id=block_id&page_size=1", "block_id=block_id&page_size=1&start_cursor=cursor_1"}; !reflect.DeepEqual(queries, want) {
+			t.Errorf("List() queries = %v, want %v", queries, want)
+		}
+	})
+
+	t.Run("Create request", func(t *testing.T) {
+		richText := []notionapi.RichText{{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "Hello world"}}}
+		tests := []struct {
+			name     string
+			request  *notionapi.CommentCreateRequest
+			wantBody map[string]interface{}
+			wantErr  bool
+		}{
+			{
+				name:    "page",
+				request: &notionapi.CommentCreateRequest{Parent: notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page_id"}, RichText: richText},
+				wantBody: map[string]interface{}{
+					"parent":    map[string]interface{}{"type": "page_id", "page_id": "page_id"},
+					"rich_text": []interface{}{map[string]interface{}{"type": "text", "text": map[string]interface{}{"content": "Hello world"}}},
+				},
+			},
+			{
+				name:    "discussion",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id", RichText: richText},
+				wantBody: map[string]interface{}{
+					"discussion_id": "discussion_id",
+					"rich_text":     []interface{}{map[string]interface{}{"type": "text", "text": map[string]interface{}{"content": "Hello world"}}},
+				},
+			},
+			{
+				name:    "parent and discussion",
+				request: &notionapi.CommentCreateRequest{Parent: notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page_id"}, DiscussionID: "discussion_id", RichText: richText},
+				wantErr: true,
+			},
+			{
+				name:    "neither parent nor discussion",
+				request: &notionapi.CommentCreateRequest{RichText: richText},
+				wantErr: true,
+			},
+			{
+				name:    "database parent",
+				request: &notionapi.CommentCreateRequest{Parent: notionapi.Parent{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "database_id"}, RichText: richText},
+				wantErr: true,
+			},
+			{
+				name: "attachments",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id", RichText: richText, Attachments: []notionapi.CommentAttachmentRequest{
+					notionapi.NewCommentAttachment("upload_1"),
+				}},
+				wantBody: map[string]interface{}{
+					"discussion_id": "discussion_id",
+					"rich_text":     []interface{}{map[string]interface{}{"type": "text", "text": map[string]interface{}{"content": "Hello world"}}},
+					"attachments":   []interface{}{map[string]interface{}{"file_upload_id": "upload_1", "type": "file_upload"}},
+				},
+			},
+			{
+				name: "too many attachments",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id", RichText: richText, Attachments: []notionapi.CommentAttachmentRequest{
+					notionapi.NewCommentAttachment("upload_1"),
+					notionapi.NewCommentAttachment("upload_2"),
+					notionapi.NewCommentAttachment("upload_3"),
+					notionapi.NewCommentAttachment("upload_4"),
+				}},
+				wantErr: true,
+			},
+			{
+				name:    "attachment without file upload",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id", RichText: richText, Attachments: []notionapi.CommentAttachmentRequest{{}}},
+				wantErr: true,
+			},
+			{
+				name:    "custom display name",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id", RichText: richText, DisplayName: notionapi.NewCustomDisplayName("Release bot")},
+				wantBody: map[string]interface{}{
+					"discussion_id": "discussion_id",
+					"rich_text":     []interface{}{map[string]interface{}{"type": "text", "text": map[string]interface{}{"content": "Hello world"}}},
+					"display_name":  map[string]interface{}{"type": "custom", "custom": map[string]interface{}{"name": "Release bot"}},
+				},
+			},
+			{
+				name:    "user display name",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id", RichText: richText, DisplayName: &notionapi.CommentDisplayName{Type: notionapi.CommentDisplayNameTypeUser}},
+				wantBody: map[string]interface{}{
+					"discussion_id": "discussion_id",
+					"rich_text":     []interface{}{map[string]interface{}{"type": "text", "text": map[string]interface{}{"content": "Hello world"}}},
+					"display_name":  map[string]interface{}{"type": "user"},
+				},
+			},
+			{
+				name:    "custom display name without name",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id", RichText: richText, DisplayName: notionapi.NewCustomDisplayName("")},
+				wantErr: true,
+			},
+			{
+				name: "integration display name with custom name",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id", RichText: richText, DisplayName: &notionapi.CommentDisplayName{
+					Type:   notionapi.CommentDisplayNameTypeIntegration,
+					Custom: &notionapi.CustomDisplayName{Name: "Release bot"},
+				}},
+				wantErr: true,
+			},
+			{
+				name:    "without rich text",
+				request: &notionapi.CommentCreateRequest{DiscussionID: "discussion_id"},
+				wantErr: true,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var body map[string]interface{}
+				c := newTestClient(func(req *http.Request) *http.Response {
+					data, err := ioutil.ReadAll(req.Body)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := json.Unmarshal(data, &body); err != nil {
+						t.Fatal(err)
+					}
+					return newJSONResponse(http.StatusOK, `{"object":"comment","id":"comment_id"}`)
+				})
+				client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+				_, err := client.Comment.Create(context.Background(), tt.request)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if !reflect.DeepEqual(body, tt.wantBody) {
+					t.Errorf("Create() body = %v, want %v", body, tt.wantBody)
+				}
+			})
+		}
+	})
+}
+
+func TestClientListDiscussions(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("start_cursor") == "" {
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":true,"next_cursor":"cursor_1","results":[
+				{"object":"comment","id":"comment_1","discussion_id":"discussion_b","created_time":"2021-05-24T05:08:00.000Z"},
+				{"object":"comment","id":"comment_2","discussion_id":"discussion_a","created_time":"2021-05-24T05:07:00.000Z"}]}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"next_cursor":null,"results":[
+			{"object":"comment","id":"comment_3","discussion_id":"discussion_b","created_time":"2021-05-24T05:06:00.000Z"},
+			{"object":"comment","id":"comment_4","discussion_id":"discussion_a","created_time":"2021-05-24T05:09:00.000Z"}]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	discussions, err := client.ListDiscussions(context.Background(), "block_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[notionapi.DiscussionID][]notionapi.ObjectID{}
+	var order []notionapi.DiscussionID
+	for _, discussion := range discussions {
+		order = append(order, discussion.ID)
+		for _, comment := range discussion.Comments {
+			got[discussion.ID] = append(got[discussion.ID], comment.ID)
+		}
+	}
+	if want := []notionapi.DiscussionID{"discussion_b", "discussion_a"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("ListDiscussions() order = %v, want %v", order, want)
+	}
+	want := map[notionapi.DiscussionID][]notionapi.ObjectID{
+		"discussion_a": {"comment_2", "comment_4"},
+		"discussion_b": {"comment_3", "comment_1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListDiscussions() = %v, want %v", got, want)
+	}
+}
+
+func TestCommentClientListIterator(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("block_id") != "block_id" {
+			t.Errorf("unexpected query %s", req.URL.RawQuery)
+		}
+		if req.URL.Query().Get("start_cursor") == "" {
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":true,"next_cursor":"cursor","results":[{"object":"comment","id":"comment_1"},{"object":"comment","id":"comment_2"}]}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[{"object":"comment","id":"comment_3"}]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	var ids []notionapi.ObjectID
+	it := client.Comment.ListIterator(context.Background(), "block_id")
+	for it.Next() {
+		ids = append(ids, it.Comment().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []notionapi.ObjectID{"comment_1", "comment_2", "comment_3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListIterator() = %v, want %v", ids, want)
+	}
+
+	t.Run("more results without cursor", func(t *testing.T) {
+		calls := 0
+		c := newTestClient(func(req *http.Request) *http.Response {
+			calls++
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":true,"next_cursor":null,"results":[{"object":"comment","id":"comment_1"}]}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		n := 0
+		it := client.Comment.ListIterator(context.Background(), "block_id")
+		for it.Next() && n < 3 {
+			n++
+		}
+		if n != 1 || calls != 1 || it.Err() != nil {
+			t.Errorf("ListIterator() got %d comments in %d requests, error %v, want 1 in 1", n, calls, it.Err())
+		}
+	})
+}
+
+func TestClientCommentOnPage(t *testing.T) {
+	mention := notionapi.NewUserMention("user_id")
+	tests := []struct {
+		name     string
+		comment  func(*notionapi.Client) (*notionapi.Comment, error)
+		wantBody string
+	}{
+		{
+			name: "page",
+			comment: func(client *notionapi.Client) (*notionapi.Comment, error) {
+				return client.CommentOnPage(context.Background(), "page_id", mention)
+			},
+			wantBody: `{"parent":{"type":"page_id","page_id":"page_id"},"rich_text":[{"type":"mention","mention":{"type":"user","user":{"object":"user","id":"user_id"}}}]}`,
+		},
+		{
+			name: "discussion",
+			comment: func(client *notionapi.Client) (*notionapi.Comment, error) {
+				return client.ReplyToDiscussion(context.Background(), "discussion_id", mention)
+			},
+			wantBody: `{"discussion_id":"discussion_id","rich_text":[{"type":"mention","mention":{"type":"user","user":{"object":"user","id":"user_id"}}}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				data, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(data)
+				return newJSONResponse(http.StatusOK, `{"object":"comment","id":"comment_id"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			got, err := tt.comment(client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.ID != "comment_id" {
+				t.Errorf("comment id = %s, want comment_id", got.ID)
+			}
+			if body != tt.wantBody {
+				t.Errorf("body = %s\nwant %s", body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestCommentAttachments(t *testing.T) {
+	var comment notionapi.Comment
+	err := json.Unmarshal([]byte(`{"object":"comment","id":"comment_id","attachments":[
+		{"category":"image","file":{"url":"https://example.com/image.png","expiry_time":"2021-05-24T06:06:34.827Z"}}]}`), &comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comment.Attachments) != 1 {
+		t.Fatalf("attachments = %v, want 1", comment.Attachments)
+	}
+	attachment := comment.Attachments[0]
+	if attachment.Category != notionapi.CommentAttachmentCategoryImage || attachment.File == nil || attachment.File.URL != "https://example.com/image.png" {
+		t.Errorf("attachment = %+v", attachment)
+	}
+
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newTestClient(func(req *http.Request) *http.Response {
+		t.Errorf("unexpected request to %s", req.URL)
+		return newJSONResponse(http.StatusInternalServerError, "")
+	})))
+	if _, err := client.UploadCommentAttachments(context.Background(), "a.png", "b.png", "c.png", "d.png"); err == nil {
+		t.Error("UploadCommentAttachments() with 4 files: expected an error")
+	}
+}
+
+func TestCommentDisplayName(t *testing.T) {
+	var comment notionapi.Comment
+	err := json.Unmarshal([]byte(`{"object":"comment","id":"comment_id","display_name":{"type":"custom","resolved_name":"Release bot"}}`), &comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &notionapi.CommentDisplayName{Type: notionapi.CommentDisplayNameTypeCustom, ResolvedName: "Release bot"}
+	if !reflect.DeepEqual(comment.DisplayName, want) {
+		t.Errorf("display name = %+v, want %+v", comment.DisplayName, want)
+	}
 }
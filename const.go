@@ -2,13 +2,17 @@ package notionapi
 
 const (
 	ObjectTypeDatabase ObjectType = "database"
-	ObjectTypeBlock    ObjectType = "block"
-	ObjectTypePage     ObjectType = "page"
-	ObjectTypeList     ObjectType = "list"
-	ObjectTypeText     ObjectType = "text"
-	ObjectTypeUser     ObjectType = "user"
-	ObjectTypeError    ObjectType = "error"
-	ObjectTypeComment  ObjectType = "comment"
+	// ObjectTypeDataSource is the type of data sources, see DataSource.
+	ObjectTypeDataSource ObjectType = "data_source"
+	ObjectTypeBlock      ObjectType = "block"
+	ObjectTypePage       ObjectType = "page"
+	ObjectTypeList       ObjectType = "list"
+	ObjectTypeText       ObjectType = "text"
+	ObjectTypeMention    ObjectType = "mention"
+	ObjectTypeEquation   ObjectType = "equation"
+	ObjectTypeUser       ObjectType = "user"
+	ObjectTypeError      ObjectType = "error"
+	ObjectTypeComment    ObjectType = "comment"
 )
 
 const (
@@ -82,7 +86,7 @@ const (
 	FormatRupiah           FormatType = "rupiah"
 	FormatFranc            FormatType = "franc"
 	FormatHongKongDollar   FormatType = "hong_kong_dollar"
-	FormatNewZealandDollar FormatType = "hong_kong_dollar"
+	FormatNewZealandDollar FormatType = "new_zealand_dollar"
 	FormatKrona            FormatType = "krona"
 	FormatNorwegianKrone   FormatType = "norwegian_krone"
 	FormatMexicanPeso      FormatType = "mexican_peso"
@@ -192,10 +196,18 @@ const (
 )
 
 const (
-	ParentTypeDatabaseID ParentType = "database_id"
-	ParentTypePageID     ParentType = "page_id"
-	ParentTypeWorkspace  ParentType = "workspace"
-	ParentTypeBlockID    ParentType = "block_id"
+	ParentTypeDatabaseID   ParentType = "database_id"
+	ParentTypePageID       ParentType = "page_id"
+	ParentTypeWorkspace    ParentType = "workspace"
+	ParentTypeBlockID      ParentType = "block_id"
+	ParentTypeDataSourceID ParentType = "data_source_id"
+)
+
+// URLKind is the kind of object a Notion URL points to, see ParseURL.
+const (
+	URLKindPage     URLKind = "page"
+	URLKindDatabase URLKind = "database"
+	URLKindBlock    URLKind = "block"
 )
 
 const (
@@ -221,6 +233,7 @@ const (
 	BlockTypeEmbed           BlockType = "embed"
 	BlockTypeImage           BlockType = "image"
 	BlockTypeVideo           BlockType = "video"
+	BlockTypeAudio           BlockType = "audio"
 	BlockTypeFile            BlockType = "file"
 	BlockTypePdf             BlockType = "pdf"
 	BlockTypeBookmark        BlockType = "bookmark"
@@ -243,8 +256,13 @@ const (
 )
 
 const (
-	FileTypeFile     FileType = "file"
-	FileTypeExternal FileType = "external"
+	FileTypeFile       FileType = "file"
+	FileTypeExternal   FileType = "external"
+	FileTypeFileUpload FileType = "file_upload"
+	// FileTypeEmoji and FileTypeCustomEmoji are the types of the icons that
+	// are not files.
+	FileTypeEmoji       FileType = "emoji"
+	FileTypeCustomEmoji FileType = "custom_emoji"
 )
 
 const (
@@ -258,6 +276,10 @@ const (
 	RollupTypeNumber RollupType = "number"
 	RollupTypeDate   RollupType = "date"
 	RollupTypeArray  RollupType = "array"
+	// RollupTypeIncomplete and RollupTypeUnsupported are the types of the
+	// rollups the API cannot compute, see PageService.GetPropertyValue.
+	RollupTypeIncomplete  RollupType = "incomplete"
+	RollupTypeUnsupported RollupType = "unsupported"
 )
 
 const (
@@ -265,6 +287,7 @@ const (
 	MentionTypePage            MentionType = "page"
 	MentionTypeUser            MentionType = "user"
 	MentionTypeDate            MentionType = "date"
+	MentionTypeLinkPreview     MentionType = "link_preview"
 	MentionTypeTemplateMention MentionType = "template_mention"
 )
 
@@ -273,6 +296,12 @@ const (
 	TemplateMentionTypeDate TemplateMentionType = "template_mention_date"
 )
 
+const (
+	TemplateMentionUserMe    = "me"
+	TemplateMentionDateToday = "today"
+	TemplateMentionDateNow   = "now"
+)
+
 const (
 	RelationSingleProperty RelationConfigType = "single_property"
 	RelationDualProperty   RelationConfigType = "dual_property"
@@ -282,3 +311,208 @@ const (
 	VerificationStateVerified   VerificationState = "verified"
 	VerificationStateUnverified VerificationState = "unverified"
 )
+
+// See https://developers.notion.com/reference/status-codes#error-codes
+const (
+	ErrorCodeInvalidJSON                   ErrorCode = "invalid_json"
+	ErrorCodeInvalidRequestURL             ErrorCode = "invalid_request_url"
+	ErrorCodeInvalidRequest                ErrorCode = "invalid_request"
+	ErrorCodeInvalidGrant                  ErrorCode = "invalid_grant"
+	ErrorCodeValidation                    ErrorCode = "validation_error"
+	ErrorCodeMissingVersion                ErrorCode = "missing_version"
+	ErrorCodeUnauthorized                  ErrorCode = "unauthorized"
+	ErrorCodeRestrictedResource            ErrorCode = "restricted_resource"
+	ErrorCodeObjectNotFound                ErrorCode = "object_not_found"
+	ErrorCodeConflict                      ErrorCode = "conflict_error"
+	ErrorCodeRateLimited                   ErrorCode = "rate_limited"
+	ErrorCodeInternalServer                ErrorCode = "internal_server_error"
+	ErrorCodeBadGateway                    ErrorCode = "bad_gateway"
+	ErrorCodeServiceUnavailable            ErrorCode = "service_unavailable"
+	ErrorCodeDatabaseConnectionUnavailable ErrorCode = "database_connection_unavailable"
+	ErrorCodeGatewayTimeout                ErrorCode = "gateway_timeout"
+)
+
+const (
+	CodeLanguageABAP           CodeLanguage = "abap"
+	CodeLanguageAgda           CodeLanguage = "agda"
+	CodeLanguageArduino        CodeLanguage = "arduino"
+	CodeLanguageASCIIArt       CodeLanguage = "ascii art"
+	CodeLanguageAssembly       CodeLanguage = "assembly"
+	CodeLanguageBash           CodeLanguage = "bash"
+	CodeLanguageBasic          CodeLanguage = "basic"
+	CodeLanguageBNF            CodeLanguage = "bnf"
+	CodeLanguageC              CodeLanguage = "c"
+	CodeLanguageCSharp         CodeLanguage = "c#"
+	CodeLanguageCPP            CodeLanguage = "c++"
+	CodeLanguageClojure        CodeLanguage = "clojure"
+	CodeLanguageCoffeeScript   CodeLanguage = "coffeescript"
+	CodeLanguageCoq            CodeLanguage = "coq"
+	CodeLanguageCSS            CodeLanguage = "css"
+	CodeLanguageDart           CodeLanguage = "dart"
+	CodeLanguageDhall          CodeLanguage = "dhall"
+	CodeLanguageDiff           CodeLanguage = "diff"
+	CodeLanguageDocker         CodeLanguage = "docker"
+	CodeLanguageEBNF           CodeLanguage = "ebnf"
+	CodeLanguageElixir         CodeLanguage = "elixir"
+	CodeLanguageElm            CodeLanguage = "elm"
+	CodeLanguageErlang         CodeLanguage = "erlang"
+	CodeLanguageFSharp         CodeLanguage = "f#"
+	CodeLanguageFlow           CodeLanguage = "flow"
+	CodeLanguageFortran        CodeLanguage = "fortran"
+	CodeLanguageGherkin        CodeLanguage = "gherkin"
+	CodeLanguageGLSL           CodeLanguage = "glsl"
+	CodeLanguageGo             CodeLanguage = "go"
+	CodeLanguageGraphQL        CodeLanguage = "graphql"
+	CodeLanguageGroovy         CodeLanguage = "groovy"
+	CodeLanguageHaskell        CodeLanguage = "haskell"
+	CodeLanguageHCL            CodeLanguage = "hcl"
+	CodeLanguageHTML           CodeLanguage = "html"
+	CodeLanguageIdris          CodeLanguage = "idris"
+	CodeLanguageJava           CodeLanguage = "java"
+	CodeLanguageJavaScript     CodeLanguage = "javascript"
+	CodeLanguageJSON           CodeLanguage = "json"
+	CodeLanguageJulia          CodeLanguage = "julia"
+	CodeLanguageKotlin         CodeLanguage = "kotlin"
+	CodeLanguageLaTeX          CodeLanguage = "latex"
+	CodeLanguageLess           CodeLanguage = "less"
+	CodeLanguageLisp           CodeLanguage = "lisp"
+	CodeLanguageLiveScript     CodeLanguage = "livescript"
+	CodeLanguageLLVMIR         CodeLanguage = "llvm ir"
+	CodeLanguageLua            CodeLanguage = "lua"
+	CodeLanguageMakefile       CodeLanguage = "makefile"
+	CodeLanguageMarkdown       CodeLanguage = "markdown"
+	CodeLanguageMarkup         CodeLanguage = "markup"
+	CodeLanguageMATLAB         CodeLanguage = "matlab"
+	CodeLanguageMathematica    CodeLanguage = "mathematica"
+	CodeLanguageMermaid        CodeLanguage = "mermaid"
+	CodeLanguageNix            CodeLanguage = "nix"
+	CodeLanguageNotionFormula  CodeLanguage = "notion formula"
+	CodeLanguageObjectiveC     CodeLanguage = "objective-c"
+	CodeLanguageOCaml          CodeLanguage = "ocaml"
+	CodeLanguagePascal         CodeLanguage = "pascal"
+	CodeLanguagePerl           CodeLanguage = "perl"
+	CodeLanguagePHP            CodeLanguage = "php"
+	CodeLanguagePlainText      CodeLanguage = "plain text"
+	CodeLanguagePowerShell     CodeLanguage = "powershell"
+	CodeLanguageProlog         CodeLanguage = "prolog"
+	CodeLanguageProtobuf       CodeLanguage = "protobuf"
+	CodeLanguagePureScript     CodeLanguage = "purescript"
+	CodeLanguagePython         CodeLanguage = "python"
+	CodeLanguageR              CodeLanguage = "r"
+	CodeLanguageRacket         CodeLanguage = "racket"
+	CodeLanguageReason         CodeLanguage = "reason"
+	CodeLanguageRuby           CodeLanguage = "ruby"
+	CodeLanguageRust           CodeLanguage = "rust"
+	CodeLanguageSass           CodeLanguage = "sass"
+	CodeLanguageScala          CodeLanguage = "scala"
+	CodeLanguageScheme         CodeLanguage = "scheme"
+	CodeLanguageSCSS           CodeLanguage = "scss"
+	CodeLanguageShell          CodeLanguage = "shell"
+	CodeLanguageSmalltalk      CodeLanguage = "smalltalk"
+	CodeLanguageSolidity       CodeLanguage = "solidity"
+	CodeLanguageSQL            CodeLanguage = "sql"
+	CodeLanguageSwift          CodeLanguage = "swift"
+	CodeLanguageTOML           CodeLanguage = "toml"
+	CodeLanguageTypeScript     CodeLanguage = "typescript"
+	CodeLanguageVBNet          CodeLanguage = "vb.net"
+	CodeLanguageVerilog        CodeLanguage = "verilog"
+	CodeLanguageVHDL           CodeLanguage = "vhdl"
+	CodeLanguageVisualBasic    CodeLanguage = "visual basic"
+	CodeLanguageWebAssembly    CodeLanguage = "webassembly"
+	CodeLanguageXML            CodeLanguage = "xml"
+	CodeLanguageYAML           CodeLanguage = "yaml"
+	CodeLanguageJavaCCPPCSharp CodeLanguage = "java/c/c++/c#"
+)
+
+// CodeLanguages lists the languages of code blocks the API accepts.
+var CodeLanguages = []CodeLanguage{
+	CodeLanguageABAP,
+	CodeLanguageAgda,
+	CodeLanguageArduino,
+	CodeLanguageASCIIArt,
+	CodeLanguageAssembly,
+	CodeLanguageBash,
+	CodeLanguageBasic,
+	CodeLanguageBNF,
+	CodeLanguageC,
+	CodeLanguageCSharp,
+	CodeLanguageCPP,
+	CodeLanguageClojure,
+	CodeLanguageCoffeeScript,
+	CodeLanguageCoq,
+	CodeLanguageCSS,
+	CodeLanguageDart,
+	CodeLanguageDhall,
+	CodeLanguageDiff,
+	CodeLanguageDocker,
+	CodeLanguageEBNF,
+	CodeLanguageElixir,
+	CodeLanguageElm,
+	CodeLanguageErlang,
+	CodeLanguageFSharp,
+	CodeLanguageFlow,
+	CodeLanguageFortran,
+	CodeLanguageGherkin,
+	CodeLanguageGLSL,
+	CodeLanguageGo,
+	CodeLanguageGraphQL,
+	CodeLanguageGroovy,
+	CodeLanguageHaskell,
+	CodeLanguageHCL,
+	CodeLanguageHTML,
+	CodeLanguageIdris,
+	CodeLanguageJava,
+	CodeLanguageJavaScript,
+	CodeLanguageJSON,
+	CodeLanguageJulia,
+	CodeLanguageKotlin,
+	CodeLanguageLaTeX,
+	CodeLanguageLess,
+	CodeLanguageLisp,
+	CodeLanguageLiveScript,
+	CodeLanguageLLVMIR,
+	CodeLanguageLua,
+	CodeLanguageMakefile,
+	CodeLanguageMarkdown,
+	CodeLanguageMarkup,
+	CodeLanguageMATLAB,
+	CodeLanguageMathematica,
+	CodeLanguageMermaid,
+	CodeLanguageNix,
+	CodeLanguageNotionFormula,
+	CodeLanguageObjectiveC,
+	CodeLanguageOCaml,
+	CodeLanguagePascal,
+	CodeLanguagePerl,
+	CodeLanguagePHP,
+	CodeLanguagePlainText,
+	CodeLanguagePowerShell,
+	CodeLanguageProlog,
+	CodeLanguageProtobuf,
+	CodeLanguagePureScript,
+	CodeLanguagePython,
+	CodeLanguageR,
+	CodeLanguageRacket,
+	CodeLanguageReason,
+	CodeLanguageRuby,
+	CodeLanguageRust,
+	CodeLanguageSass,
+	CodeLanguageScala,
+	CodeLanguageScheme,
+	CodeLanguageSCSS,
+	CodeLanguageShell,
+	CodeLanguageSmalltalk,
+	CodeLanguageSolidity,
+	CodeLanguageSQL,
+	CodeLanguageSwift,
+	CodeLanguageTOML,
+	CodeLanguageTypeScript,
+	CodeLanguageVBNet,
+	CodeLanguageVerilog,
+	CodeLanguageVHDL,
+	CodeLanguageVisualBasic,
+	CodeLanguageWebAssembly,
+	CodeLanguageXML,
+	CodeLanguageYAML,
+	CodeLanguageJavaCCPPCSharp,
+}
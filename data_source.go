@@ -0,0 +1,249 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// NotionVersionDataSources is the first version of the API splitting
+// databases in data sources, each with its own schema and pages. With this
+// version and the later ones, see WithVersion, the methods of DatabaseService
+// are routed to the data source of the database, and the pages created in a
+// database to its data source, for the databases with a single data source.
+const NotionVersionDataSources = "2025-09-03"
+
+// ErrMultipleDataSources is returned by the methods of DatabaseService that
+// need the data source of a database when it has several of them. They are
+// handled with DataSourceService.
+var ErrMultipleDataSources = errors.New("database has several data sources")
+
+// usesDataSources reports whether the version of the API used by the client
+// splits databases in data sources.
+func (c *Client) usesDataSources() bool {
+	return c.notionVersion >= NotionVersionDataSources
+}
+
+type DataSourceService interface {
+	Create(context.Context, *DataSourceCreateRequest) (*DataSource, error)
+	Get(context.Context, DataSourceID) (*DataSource, error)
+	Update(context.Context, DataSourceID, *DataSourceUpdateRequest) (*DataSource, error)
+	Query(context.Context, DataSourceID, *DatabaseQueryRequest) (*DatabaseQueryResponse, error)
+}
+
+// DataSourceClient implements DataSourceService, with the versions of the API
+// from NotionVersionDataSources: with the previous ones, its methods return
+// an *UnsupportedEndpointError.
+type DataSourceClient struct {
+	apiClient *Client
+}
+
+// DataSource is a table of a database, with the versions of the API from
+// NotionVersionDataSources.
+type DataSource struct {
+	Object         ObjectType  `json:"object"`
+	ID             ObjectID    `json:"id"`
+	CreatedTime    time.Time   `json:"created_time"`
+	LastEditedTime time.Time   `json:"last_edited_time"`
+	CreatedBy      PartialUser `json:"created_by,omitempty"`
+	LastEditedBy   PartialUser `json:"last_edited_by,omitempty"`
+	Title          []RichText  `json:"title"`
+	Description    []RichText  `json:"description"`
+	Icon           *Icon       `json:"icon,omitempty"`
+	// Parent is the database of the data source.
+	Parent Parent `json:"parent"`
+	// DatabaseParent is the parent of the database of the data source.
+	DatabaseParent Parent `json:"database_parent"`
+	// Properties is the schema of the pages of the data source.
+	Properties PropertyConfigs `json:"properties"`
+	Archived   bool            `json:"archived"`
+	InTrash    bool            `json:"in_trash"`
+	URL        string          `json:"url"`
+	PublicURL  string          `json:"public_url"`
+}
+
+func (ds *DataSource) GetObject() ObjectType {
+	return ds.Object
+}
+
+// DataSourceReference is a data source listed by a database.
+type DataSourceReference struct {
+	ID   DataSourceID `json:"id"`
+	Name string       `json:"name"`
+}
+
+// DataSourceCreateRequest represents the request body for
+// DataSourceClient.Create.
+type DataSourceCreateRequest struct {
+	// The database the data source is added to, a database_id parent.
+	Parent Parent `json:"parent"`
+	// The title of the data source.
+	Title []RichText `json:"title,omitempty"`
+	// The schema of the pages of the data source, which has a single title
+	// property.
+	Properties PropertyConfigs `json:"properties"`
+	Icon       *Icon           `json:"icon,omitempty"`
+}
+
+// DataSourceUpdateRequest represents the request body for
+// DataSourceClient.Update. Only the fields set are changed.
+type DataSourceUpdateRequest struct {
+	Title []RichText `json:"title,omitempty"`
+	// The properties to change, like in DatabaseUpdateRequest: the properties
+	// set to nil are removed.
+	Properties PropertyConfigs `json:"properties,omitempty"`
+	Icon       *Icon           `json:"icon,omitempty"`
+}
+
+// Creates a data source in the database of the parent of the request.
+//
+// See https://developers.notion.com/reference/create-a-data-source
+func (dsc *DataSourceClient) Create(ctx context.Context, requestBody *DataSourceCreateRequest) (*DataSource, error) {
+	if requestBody == nil || requestBody.Parent.Type != ParentTypeDatabaseID || requestBody.Parent.DatabaseID == "" {
+		return nil, errors.New("data sources can only be created in a database")
+	}
+	return dsc.do(ctx, http.MethodPost, "data_sources", requestBody)
+}
+
+// Retrieves the data source with the ID specified, with its schema.
+//
+// See https://developers.notion.com/reference/retrieve-a-data-source
+func (dsc *DataSourceClient) Get(ctx context.Context, id DataSourceID) (*DataSource, error) {
+	if id == "" {
+		return nil, errors.New("empty data source id")
+	}
+	return dsc.do(ctx, http.MethodGet, fmt.Sprintf("data_sources/%s", pathID(id.String())), nil)
+}
+
+// Updates the title, icon or schema of the data source with the ID specified.
+//
+// See https://developers.notion.com/reference/update-a-data-source
+func (dsc *DataSourceClient) Update(ctx context.Context, id DataSourceID, requestBody *DataSourceUpdateRequest) (*DataSource, error) {
+	if id == "" {
+		return nil, errors.New("empty data source id")
+	}
+	return dsc.do(ctx, http.MethodPatch, fmt.Sprintf("data_sources/%s", pathID(id.String())), requestBody)
+}
+
+// Gets a list of the pages of the data source with the ID specified, like
+// DatabaseClient.Query does for databases.
+//
+// See https://developers.notion.com/reference/query-a-data-source
+func (dsc *DataSourceClient) Query(ctx context.Context, id DataSourceID, requestBody *DatabaseQueryRequest) (*DatabaseQueryResponse, error) {
+	if id == "" {
+		return nil, errors.New("empty data source id")
+	}
+	path := fmt.Sprintf("data_sources/%s/query", pathID(id.String()))
+	res, err := dsc.apiClient.queryPages(ctx, path, requestBody)
+	if err != nil {
+		return nil, dsc.unsupported(path, err)
+	}
+	return res, nil
+}
+
+func (dsc *DataSourceClient) do(ctx context.Context, method, path string, requestBody interface{}) (*DataSource, error) {
+	res, err := dsc.apiClient.request(ctx, method, path, nil, requestBody, ContentTypeJSON)
+	if err != nil {
+		return nil, dsc.unsupported(path, err)
+	}
+
+	defer func() {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+	}()
+
+	var response DataSource
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// unsupported returns err as an *UnsupportedEndpointError when the version of
+// the API does not know the endpoint at path.
+func (dsc *DataSourceClient) unsupported(path string, err error) error {
+	if IsErrorCode(err, ErrorCodeInvalidRequestURL) {
+		return &UnsupportedEndpointError{Endpoint: path, Version: dsc.apiClient.notionVersion, Err: err}
+	}
+	return err
+}
+
+// dataSourceID returns the ID of the single data source of the database with
+// the ID specified, retrieved once per client.
+func (c *Client) dataSourceID(ctx context.Context, id DatabaseID) (DataSourceID, error) {
+	key := pathID(id.String())
+	if dataSourceID, ok := c.dataSourceIDs.Load(key); ok {
+		return dataSourceID.(DataSourceID), nil
+	}
+	database, err := c.getDatabase(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return c.singleDataSource(database)
+}
+
+// singleDataSource returns the ID of the single data source of database, and
+// remembers it.
+func (c *Client) singleDataSource(database *Database) (DataSourceID, error) {
+	switch len(database.DataSources) {
+	case 0:
+		return "", fmt.Errorf("database %s has no data source", database.ID)
+	case 1:
+	default:
+		return "", fmt.Errorf("database %s: %w: %d", database.ID, ErrMultipleDataSources, len(database.DataSources))
+	}
+	dataSourceID := database.DataSources[0].ID
+	c.dataSourceIDs.Store(pathID(database.ID.String()), dataSourceID)
+	return dataSourceID, nil
+}
+
+// fillDataSourceProperties sets the properties of database, returned by the
+// API without them with the versions from NotionVersionDataSources, to the
+// schema of its data source when it has a single one.
+func (c *Client) fillDataSourceProperties(ctx context.Context, database *Database) error {
+	if !c.usesDataSources() || database.Properties != nil || len(database.DataSources) != 1 {
+		return nil
+	}
+	dataSourceID, err := c.singleDataSource(database)
+	if err != nil {
+		return err
+	}
+	dataSource, err := c.DataSource.Get(ctx, dataSourceID)
+	if err != nil {
+		return err
+	}
+	database.Properties = dataSource.Properties
+	return nil
+}
+
+// dataSourceDatabaseCreateRequest is the body of the requests creating
+// databases with the versions of the API from NotionVersionDataSources.
+type dataSourceDatabaseCreateRequest struct {
+	Parent            Parent     `json:"parent"`
+	Title             []RichText `json:"title"`
+	Description       []RichText `json:"description,omitempty"`
+	IsInline          bool       `json:"is_inline"`
+	Icon              *Icon      `json:"icon,omitempty"`
+	Cover             *Image     `json:"cover,omitempty"`
+	InitialDataSource struct {
+		Properties PropertyConfigs `json:"properties"`
+	} `json:"initial_data_source"`
+}
+
+func newDataSourceDatabaseCreateRequest(request *DatabaseCreateRequest) *dataSourceDatabaseCreateRequest {
+	body := &dataSourceDatabaseCreateRequest{
+		Parent:      request.Parent,
+		Title:       request.Title,
+		Description: request.Description,
+		IsInline:    request.IsInline,
+		Icon:        request.Icon,
+		Cover:       request.Cover,
+	}
+	body.InitialDataSource.Properties = request.Properties
+	return body
+}
@@ -0,0 +1,190 @@
+package notionapi_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// newDataSourceTestClient returns a client of the 2025-09-03 version of the
+// API, whose database database_id has the data source data_source_id, and
+// the requests it makes as "METHOD path body".
+func newDataSourceTestClient(t *testing.T, database string) (*notionapi.Client, *[]string) {
+	var requests []string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if v := req.Header.Get("Notion-Version"); v != notionapi.NotionVersionDataSources {
+			t.Errorf("Notion-Version = %s", v)
+		}
+		request := req.Method + " " + req.URL.Path
+		if req.Body != nil {
+			if body, _ := ioutil.ReadAll(req.Body); len(body) > 0 {
+				request += " " + string(body)
+			}
+		}
+		requests = append(requests, request)
+		switch req.URL.Path {
+		case "/v1/databases", "/v1/databases/database_id":
+			return newJSONResponse(http.StatusOK, database)
+		case "/v1/data_sources/data_source_id":
+			return newJSONResponse(http.StatusOK, `{"object":"data_source","id":"data_source_id","properties":{
+				"Name": {"id": "title", "type": "title", "title": {}}
+			}}`)
+		case "/v1/data_sources/data_source_id/query":
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[{"object":"page","id":"page_1"}],"has_more":false}`)
+		case "/v1/pages":
+			return newJSONResponse(http.StatusOK, `{"object":"page","id":"page_1"}`)
+		}
+		t.Fatalf("unexpected request %s", request)
+		return nil
+	})
+	return notionapi.NewClient("some_token", notionapi.WithHTTPClient(c), notionapi.WithVersion(notionapi.NotionVersionDataSources)), &requests
+}
+
+const singleDataSourceDatabase = `{"object":"database","id":"database_id","data_sources":[{"id":"data_source_id","name":"Tasks"}]}`
+
+func TestDatabaseClientDataSources(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(*notionapi.Client) error
+		want []string
+	}{
+		{
+			name: "get fills the properties",
+			call: func(client *notionapi.Client) error {
+				database, err := client.Database.Get(context.Background(), "database_id")
+				if err == nil && database.Properties["Name"] == nil {
+					t.Errorf("Get() properties = %v", database.Properties)
+				}
+				return err
+			},
+			want: []string{"GET /v1/databases/database_id", "GET /v1/data_sources/data_source_id"},
+		},
+		{
+			name: "query the data source",
+			call: func(client *notionapi.Client) error {
+				for i := 0; i < 2; i++ {
+					if _, err := client.Database.Query(context.Background(), "database_id", &notionapi.DatabaseQueryRequest{PageSize: 10}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			want: []string{
+				"GET /v1/databases/database_id",
+				`POST /v1/data_sources/data_source_id/query {"page_size":10}`,
+				`POST /v1/data_sources/data_source_id/query {"page_size":10}`,
+			},
+		},
+		{
+			name: "update the schema on the data source",
+			call: func(client *notionapi.Client) error {
+				database, err := client.Database.Update(context.Background(), "database_id", &notionapi.DatabaseUpdateRequest{
+					Title:      []notionapi.RichText{{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "Tasks"}}},
+					Properties: notionapi.PropertyConfigs{"Done": notionapi.CheckboxPropertyConfig{Type: notionapi.PropertyConfigTypeCheckbox}},
+				})
+				if err == nil && database.Properties["Name"] == nil {
+					t.Errorf("Update() properties = %v", database.Properties)
+				}
+				return err
+			},
+			want: []string{
+				"GET /v1/databases/database_id",
+				`PATCH /v1/data_sources/data_source_id {"properties":{"Done":{"type":"checkbox","checkbox":{}}}}`,
+				`PATCH /v1/databases/database_id {"title":[{"type":"text","text":{"content":"Tasks"}}]}`,
+			},
+		},
+		{
+			name: "create with an initial data source",
+			call: func(client *notionapi.Client) error {
+				_, err := client.Database.Create(context.Background(), &notionapi.DatabaseCreateRequest{
+					Parent:     notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page_id"},
+					Properties: notionapi.PropertyConfigs{"Name": notionapi.TitlePropertyConfig{Type: notionapi.PropertyConfigTypeTitle}},
+				})
+				return err
+			},
+			want: []string{
+				`POST /v1/databases {"parent":{"type":"page_id","page_id":"page_id"},"title":null,"is_inline":false,"initial_data_source":{"properties":{"Name":{"type":"title","title":{}}}}}`,
+				"GET /v1/data_sources/data_source_id",
+			},
+		},
+		{
+			name: "create pages in the data source",
+			call: func(client *notionapi.Client) error {
+				_, err := client.Page.Create(context.Background(), &notionapi.PageCreateRequest{
+					Parent:     notionapi.Parent{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "database_id"},
+					Properties: notionapi.Properties{},
+				})
+				return err
+			},
+			want: []string{
+				"GET /v1/databases/database_id",
+				`POST /v1/pages {"parent":{"type":"data_source_id","data_source_id":"data_source_id"},"properties":{}}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, requests := newDataSourceTestClient(t, singleDataSourceDatabase)
+			if err := tt.call(client); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(*requests, tt.want) {
+				t.Errorf("requests got:\n%q\nwant:\n%q", *requests, tt.want)
+			}
+		})
+	}
+
+	t.Run("several data sources", func(t *testing.T) {
+		client, _ := newDataSourceTestClient(t, `{"object":"database","id":"database_id","data_sources":[{"id":"a"},{"id":"b"}]}`)
+		database, err := client.Database.Get(context.Background(), "database_id")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(database.DataSources) != 2 || database.Properties != nil {
+			t.Errorf("Get() got = %+v", database)
+		}
+		if _, err := client.Database.Query(context.Background(), "database_id", nil); !errors.Is(err, notionapi.ErrMultipleDataSources) {
+			t.Errorf("Query() error = %v, want %v", err, notionapi.ErrMultipleDataSources)
+		}
+	})
+}
+
+func TestDataSourceClient(t *testing.T) {
+	t.Run("query", func(t *testing.T) {
+		client, requests := newDataSourceTestClient(t, singleDataSourceDatabase)
+		res, err := client.DataSource.Query(context.Background(), "data_source_id", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Results) != 1 || len(*requests) != 1 {
+			t.Errorf("Query() got = %+v, requests %q", res, *requests)
+		}
+	})
+
+	t.Run("create outside of a database", func(t *testing.T) {
+		client, requests := newDataSourceTestClient(t, singleDataSourceDatabase)
+		_, err := client.DataSource.Create(context.Background(), &notionapi.DataSourceCreateRequest{
+			Parent: notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page_id"},
+		})
+		if err == nil || len(*requests) != 0 {
+			t.Errorf("Create() error = %v, requests %q", err, *requests)
+		}
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			return newJSONResponse(http.StatusBadRequest, `{"object":"error","status":400,"code":"invalid_request_url","message":"Invalid request URL."}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+		_, err := client.DataSource.Get(context.Background(), "data_source_id")
+		var unsupported *notionapi.UnsupportedEndpointError
+		if !errors.As(err, &unsupported) || unsupported.Endpoint != "data_sources/data_source_id" {
+			t.Errorf("Get() error = %v", err)
+		}
+	})
+}
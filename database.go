@@ -16,11 +16,23 @@ func (dID DatabaseID) String() string {
 	return string(dID)
 }
 
+// DataSourceID identifies a data source, one of the tables of a database in
+// the versions of the API from 2025-09-03.
+type DataSourceID string
+
+func (dsID DataSourceID) String() string {
+	return string(dsID)
+}
+
 type DatabaseService interface {
 	Create(ctx context.Context, request *DatabaseCreateRequest) (*Database, error)
 	Query(context.Context, DatabaseID, *DatabaseQueryRequest) (*DatabaseQueryResponse, error)
 	Get(context.Context, DatabaseID) (*Database, error)
 	Update(context.Context, DatabaseID, *DatabaseUpdateRequest) (*Database, error)
+	QueryIterator(context.Context, DatabaseID, *DatabaseQueryRequest) *DatabaseQueryIterator
+	QueryAll(ctx context.Context, id DatabaseID, request *DatabaseQueryRequest, maxResults int) ([]Page, error)
+	Archive(context.Context, DatabaseID) (*Database, error)
+	Restore(context.Context, DatabaseID) (*Database, error)
 }
 
 type DatabaseClient struct {
@@ -29,11 +41,20 @@ type DatabaseClient struct {
 
 // Creates a database as a subpage in the specified parent page, with the
 // specified properties schema. Currently, the parent of a new database must be
-// a Notion page.
+// a Notion page. With the versions of the API from NotionVersionDataSources,
+// the schema is the schema of the initial data source of the database.
 //
 // See https://developers.notion.com/reference/create-a-database
 func (dc *DatabaseClient) Create(ctx context.Context, requestBody *DatabaseCreateRequest) (*Database, error) {
-	res, err := dc.apiClient.request(ctx, http.MethodPost, "databases", nil, requestBody, ContentTypeJSON)
+	if err := requestBody.Validate(); err != nil {
+		return nil, fmt.Errorf("DatabaseClient.Create: %w", err)
+	}
+
+	var body interface{} = requestBody
+	if dc.apiClient.usesDataSources() {
+		body = newDataSourceDatabaseCreateRequest(requestBody)
+	}
+	res, err := dc.apiClient.request(ctx, http.MethodPost, "databases", nil, body, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +71,9 @@ func (dc *DatabaseClient) Create(ctx context.Context, requestBody *DatabaseCreat
 		return nil, err
 	}
 
+	if err := dc.apiClient.fillDataSourceProperties(ctx, &response); err != nil {
+		return nil, err
+	}
 	return &response, nil
 }
 
@@ -62,7 +86,46 @@ type DatabaseCreateRequest struct {
 	// Property schema of database. The keys are the names of properties as they
 	// appear in Notion and the values are property schema objects.
 	Properties PropertyConfigs `json:"properties"`
-	IsInline   bool            `json:"is_inline"`
+	// Whether the database is displayed inline in the content of its parent
+	// page, like a block, rather than as a full-page database, a child page
+	// of the parent listed in its content.
+	IsInline bool `json:"is_inline"`
+	// Description of the database as it appears in Notion.
+	Description []RichText `json:"description,omitempty"`
+	// The icon of the database, see EmojiIcon and ExternalIcon.
+	Icon *Icon `json:"icon,omitempty"`
+	// The cover image of the database, see ExternalCover.
+	Cover *Image `json:"cover,omitempty"`
+}
+
+// Validate checks the request against the requirements of the API, so that
+// invalid requests fail without making an API call: inline and full-page
+// databases alike are created in a page, given by the ID of a page parent,
+// and have a single title property.
+func (r *DatabaseCreateRequest) Validate() error {
+	if r == nil {
+		return errors.New("nil request")
+	}
+	switch r.Parent.Type {
+	case ParentTypePageID:
+		if r.Parent.PageID == "" {
+			return errors.New("the page parent of the database has no page id")
+		}
+	case "":
+		return errors.New("the parent of the database has no type")
+	default:
+		return fmt.Errorf("databases can only be created in pages, not with a %s parent", r.Parent.Type)
+	}
+	titles := 0
+	for _, config := range r.Properties {
+		if config != nil && config.GetType() == PropertyConfigTypeTitle {
+			titles++
+		}
+	}
+	if titles != 1 {
+		return fmt.Errorf("databases have a single title property, got %d", titles)
+	}
+	return nil
 }
 
 // Gets a list of Pages contained in the database, filtered and ordered
@@ -79,10 +142,57 @@ type DatabaseCreateRequest struct {
 //
 // Filters operate on database properties and can be combined. If no filter is
 // provided, all the pages in the database will be returned with pagination.
+// Compound filters nested more than MaxFilterNesting levels deep are rejected
+// with a *ValidationError before the request is sent.
 //
 // See https://developers.notion.com/reference/post-database-query
 func (dc *DatabaseClient) Query(ctx context.Context, id DatabaseID, requestBody *DatabaseQueryRequest) (*DatabaseQueryResponse, error) {
-	res, err := dc.apiClient.request(ctx, http.MethodPost, fmt.Sprintf("databases/%s/query", id.String()), nil, requestBody, ContentTypeJSON)
+	if id == "" {
+		return nil, errors.New("empty database id")
+	}
+	cache := dc.apiClient.queryCache
+	if cache == nil {
+		return dc.query(ctx, id, requestBody)
+	}
+	key, err := queryCacheKey(requestBody)
+	if err != nil {
+		return nil, err
+	}
+	if response := cache.get(id, key); response != nil {
+		return response, nil
+	}
+	response, err := dc.query(ctx, id, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	cache.put(id, key, response)
+	return response, nil
+}
+
+func (dc *DatabaseClient) query(ctx context.Context, id DatabaseID, requestBody *DatabaseQueryRequest) (*DatabaseQueryResponse, error) {
+	if dc.apiClient.usesDataSources() {
+		dataSourceID, err := dc.apiClient.dataSourceID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return dc.apiClient.queryPages(ctx, fmt.Sprintf("data_sources/%s/query", pathID(dataSourceID.String())), requestBody)
+	}
+	return dc.apiClient.queryPages(ctx, fmt.Sprintf("databases/%s/query", pathID(id.String())), requestBody)
+}
+
+// queryPages sends the query of requestBody to the query endpoint at path,
+// of a database or a data source.
+func (c *Client) queryPages(ctx context.Context, path string, requestBody *DatabaseQueryRequest) (*DatabaseQueryResponse, error) {
+	if requestBody != nil && requestBody.Filter != nil {
+		if err := ValidateFilter(requestBody.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	if requestBody != nil {
+		path += filterPropertiesQuery(requestBody.FilterProperties)
+	}
+	res, err := c.request(ctx, http.MethodPost, path, nil, requestBody, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +209,9 @@ func (dc *DatabaseClient) Query(ctx context.Context, id DatabaseID, requestBody
 		return nil, err
 	}
 
+	for i := range response.Results {
+		c.hydratePages(ctx, &response.Results[i])
+	}
 	return &response, nil
 }
 
@@ -114,15 +227,35 @@ type DatabaseQueryRequest struct {
 	StartCursor Cursor `json:"start_cursor,omitempty"`
 	// The number of items from the full list desired in the response. Maximum: 100
 	PageSize int `json:"page_size,omitempty"`
+	// When supplied, limits the properties of the pages returned to the ones
+	// with these IDs.
+	FilterProperties []PropertyID `json:"-"`
 }
 
+// Retrieves the database with the ID specified. With the versions of the API
+// from NotionVersionDataSources, the properties of databases with a single
+// data source are those of their data source, retrieved too.
+//
 // See https://developers.notion.com/reference/get-database
 func (dc *DatabaseClient) Get(ctx context.Context, id DatabaseID) (*Database, error) {
+	database, err := dc.apiClient.getDatabase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := dc.apiClient.fillDataSourceProperties(ctx, database); err != nil {
+		return nil, err
+	}
+	return database, nil
+}
+
+// getDatabase retrieves the database with the ID specified, as returned by
+// the API.
+func (c *Client) getDatabase(ctx context.Context, id DatabaseID) (*Database, error) {
 	if id == "" {
 		return nil, errors.New("empty database id")
 	}
 
-	res, err := dc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("databases/%s", id.String()), nil, nil, ContentTypeJSON)
+	res, err := c.request(ctx, http.MethodGet, fmt.Sprintf("databases/%s", pathID(id.String())), nil, nil, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -143,9 +276,49 @@ func (dc *DatabaseClient) Get(ctx context.Context, id DatabaseID) (*Database, er
 	return &response, nil
 }
 
-// Update https://developers.notion.com/reference/update-a-database
+// Updates the title, description, icon, cover or property schema of the
+// database with the ID specified. Only the fields set in the request are
+// changed. With the versions of the API from NotionVersionDataSources, the
+// property schema is updated on the data source of the database, which must
+// have a single one.
+//
+// See https://developers.notion.com/reference/update-a-database
 func (dc *DatabaseClient) Update(ctx context.Context, id DatabaseID, requestBody *DatabaseUpdateRequest) (*Database, error) {
-	res, err := dc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("databases/%s", id.String()), nil, requestBody, ContentTypeJSON)
+	if id == "" {
+		return nil, errors.New("empty database id")
+	}
+	dc.apiClient.InvalidateQueryCache(id)
+	if !dc.apiClient.usesDataSources() || requestBody == nil || requestBody.Properties == nil {
+		database, err := dc.update(ctx, id, requestBody)
+		if err != nil {
+			return nil, err
+		}
+		if err := dc.apiClient.fillDataSourceProperties(ctx, database); err != nil {
+			return nil, err
+		}
+		return database, nil
+	}
+
+	dataSourceID, err := dc.apiClient.dataSourceID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dataSource, err := dc.apiClient.DataSource.Update(ctx, dataSourceID, &DataSourceUpdateRequest{Properties: requestBody.Properties})
+	if err != nil {
+		return nil, err
+	}
+	databaseRequest := *requestBody
+	databaseRequest.Properties = nil
+	database, err := dc.update(ctx, id, &databaseRequest)
+	if err != nil {
+		return nil, err
+	}
+	database.Properties = dataSource.Properties
+	return database, nil
+}
+
+func (dc *DatabaseClient) update(ctx context.Context, id DatabaseID, requestBody *DatabaseUpdateRequest) (*Database, error) {
+	res, err := dc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("databases/%s", pathID(id.String())), nil, requestBody, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +336,27 @@ func (dc *DatabaseClient) Update(ctx context.Context, id DatabaseID, requestBody
 	return &response, nil
 }
 
+// Archive moves the database with the ID specified to the trash, along with
+// its pages. It can be restored with Restore.
+func (dc *DatabaseClient) Archive(ctx context.Context, id DatabaseID) (*Database, error) {
+	return dc.Update(ctx, id, dc.trashRequest(true))
+}
+
+// Restore restores the database with the ID specified from the trash.
+func (dc *DatabaseClient) Restore(ctx context.Context, id DatabaseID) (*Database, error) {
+	return dc.Update(ctx, id, dc.trashRequest(false))
+}
+
+// trashRequest returns the request moving a database to the trash or
+// restoring it, with in_trash from NotionVersionDataSources and archived
+// before.
+func (dc *DatabaseClient) trashRequest(trashed bool) *DatabaseUpdateRequest {
+	if dc.apiClient.usesDataSources() {
+		return &DatabaseUpdateRequest{InTrash: &trashed}
+	}
+	return &DatabaseUpdateRequest{Archived: &trashed}
+}
+
 // DatabaseUpdateRequest represents the request body for DatabaseClient.Update.
 type DatabaseUpdateRequest struct {
 	// An array of rich text objects that represents the title of the database
@@ -174,33 +368,143 @@ type DatabaseUpdateRequest struct {
 	// names or IDs of the properties as they appear in Notion, and the values are
 	// property schema objects. If adding a new property, then the key is the name
 	// of the new database property and the value is a property schema object.
+	// A property set to nil is removed from the database, and a property is
+	// renamed by setting the Name of its configuration.
 	Properties PropertyConfigs `json:"properties,omitempty"`
+	// An array of rich text objects that represents the description of the
+	// database. If omitted, then the description remains unchanged.
+	Description []RichText `json:"description,omitempty"`
+	// The icon of the database. If omitted, then the icon remains unchanged.
+	Icon *Icon `json:"icon,omitempty"`
+	// The cover of the database. If omitted, then the cover remains unchanged.
+	Cover *Image `json:"cover,omitempty"`
+	// Whether the database is displayed inline in its parent page. If omitted,
+	// then it remains unchanged.
+	IsInline *bool `json:"is_inline,omitempty"`
+	// Whether the database is archived. Set to true to archive the database,
+	// false to restore it. If omitted, then it remains unchanged.
+	Archived *bool `json:"archived,omitempty"`
+	// Whether the database is in the trash. Set to true to move the database
+	// to the trash, false to restore it. Supersedes Archived in newer
+	// versions of the API.
+	InTrash *bool `json:"in_trash,omitempty"`
 }
 
 type Database struct {
-	Object         ObjectType `json:"object"`
-	ID             ObjectID   `json:"id"`
-	CreatedTime    time.Time  `json:"created_time"`
-	LastEditedTime time.Time  `json:"last_edited_time"`
-	CreatedBy      User       `json:"created_by,omitempty"`
-	LastEditedBy   User       `json:"last_edited_by,omitempty"`
-	Title          []RichText `json:"title"`
-	Parent         Parent     `json:"parent"`
-	URL            string     `json:"url"`
-	PublicURL      string     `json:"public_url"`
+	Object         ObjectType  `json:"object"`
+	ID             ObjectID    `json:"id"`
+	CreatedTime    time.Time   `json:"created_time"`
+	LastEditedTime time.Time   `json:"last_edited_time"`
+	CreatedBy      PartialUser `json:"created_by,omitempty"`
+	LastEditedBy   PartialUser `json:"last_edited_by,omitempty"`
+	Title          []RichText  `json:"title"`
+	Parent         Parent      `json:"parent"`
+	URL            string      `json:"url"`
+	PublicURL      string      `json:"public_url"`
 	// Properties is a map of property configurations that defines what Page.Properties each page of the database can use
 	Properties  PropertyConfigs `json:"properties"`
 	Description []RichText      `json:"description"`
 	IsInline    bool            `json:"is_inline"`
 	Archived    bool            `json:"archived"`
+	InTrash     bool            `json:"in_trash"`
 	Icon        *Icon           `json:"icon,omitempty"`
 	Cover       *Image          `json:"cover,omitempty"`
+	// DataSources are the data sources of the database, with the versions of
+	// the API from NotionVersionDataSources.
+	DataSources []DataSourceReference `json:"data_sources,omitempty"`
 }
 
 func (db *Database) GetObject() ObjectType {
 	return db.Object
 }
 
+// QueryIterator returns an iterator over all the pages matching the query,
+// which retrieves the pages of results as they are needed. The StartCursor of
+// requestBody is where the iteration starts, and its PageSize the number of
+// pages retrieved per request.
+//
+//	it := client.Database.QueryIterator(ctx, id, &notionapi.DatabaseQueryRequest{
+//		Filter: filter,
+//	})
+//	for it.Next() {
+//		page := it.Page()
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle the error
+//	}
+func (dc *DatabaseClient) QueryIterator(ctx context.Context, id DatabaseID, requestBody *DatabaseQueryRequest) *DatabaseQueryIterator {
+	request := DatabaseQueryRequest{}
+	if requestBody != nil {
+		request = *requestBody
+	}
+	return &DatabaseQueryIterator{ctx: ctx, client: dc, id: id, request: request, pager: cursorPager{cursor: request.StartCursor}}
+}
+
+// QueryAll returns all the pages matching the query, or the first maxResults
+// of them if maxResults is positive.
+func (dc *DatabaseClient) QueryAll(ctx context.Context, id DatabaseID, requestBody *DatabaseQueryRequest, maxResults int) ([]Page, error) {
+	it := dc.QueryIterator(ctx, id, requestBody)
+	if maxResults > 0 && maxResults < 100 && (requestBody == nil || requestBody.PageSize == 0) {
+		// no need to retrieve more pages than needed, 100 being the maximum
+		// page size
+		it.request.PageSize = maxResults
+	}
+	pages := []Page{}
+	for (maxResults <= 0 || len(pages) < maxResults) && it.Next() {
+		pages = append(pages, *it.Page())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// DatabaseQueryIterator iterates over the pages matching a database query,
+// following next_cursor. It is not safe for concurrent use.
+type DatabaseQueryIterator struct {
+	ctx     context.Context
+	client  *DatabaseClient
+	id      DatabaseID
+	request DatabaseQueryRequest
+
+	pages   []Page
+	pager   cursorPager
+	current *Page
+}
+
+// Next advances the iterator to the next page, which is then available
+// through Page. It returns false when there are no more pages or an error
+// occurred.
+func (it *DatabaseQueryIterator) Next() bool {
+	for len(it.pages) == 0 {
+		if !it.pager.fetch(func(cursor Cursor) (Cursor, bool, error) {
+			request := it.request
+			request.StartCursor = cursor
+			res, err := it.client.Query(it.ctx, it.id, &request)
+			if err != nil {
+				return "", false, err
+			}
+			it.pages = res.Results
+			return res.NextCursor, res.HasMore, nil
+		}) {
+			it.current = nil
+			return false
+		}
+	}
+	it.current, it.pages = &it.pages[0], it.pages[1:]
+	return true
+}
+
+// Page returns the page the iterator is at.
+func (it *DatabaseQueryIterator) Page() *Page {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DatabaseQueryIterator) Err() error {
+	return it.pager.err
+}
+
 type DatabaseQueryResponse struct {
 	Object     ObjectType `json:"object"`
 	Results    []Page     `json:"results"`
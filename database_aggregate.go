@@ -0,0 +1,249 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+type AggregateFunction string
+
+const (
+	// AggregateCount counts the pages, or the pages with a value for the
+	// property of the aggregation when it is set.
+	AggregateCount AggregateFunction = "count"
+	AggregateSum   AggregateFunction = "sum"
+	AggregateMin   AggregateFunction = "min"
+	AggregateMax   AggregateFunction = "max"
+	AggregateAvg   AggregateFunction = "avg"
+)
+
+// Aggregation is a value computed over the pages of a query by
+// Client.Aggregate.
+type Aggregation struct {
+	// Name is the key of the value in AggregateGroup.Values, which defaults
+	// to the function and the property, such as "sum(Estimate)", or "count"
+	// when counting pages.
+	Name     string
+	Function AggregateFunction
+	// Property is the name of the property aggregated. The values of sum,
+	// min, max and avg are the values of number properties, and of the unique
+	// ID, formula and rollup properties whose values are numbers.
+	Property string
+}
+
+func (a Aggregation) name() string {
+	if a.Name != "" {
+		return a.Name
+	}
+	if a.Property == "" {
+		return string(a.Function)
+	}
+	return fmt.Sprintf("%s(%s)", a.Function, a.Property)
+}
+
+// AggregateRequest is the aggregations computed by Client.Aggregate.
+type AggregateRequest struct {
+	// GroupBy is the name of the property grouping the pages, whose values
+	// are aggregated per group. The pages are in the group of each of the
+	// options of multi-selects, each of the people of people properties and
+	// so on. The pages are not grouped when it is empty.
+	GroupBy      string
+	Aggregations []Aggregation
+}
+
+// AggregateGroup is the result of Client.Aggregate for a group of pages.
+type AggregateGroup struct {
+	// Key is the value of the GroupBy property of the pages of the group, as
+	// formatted by PropertyText, "" for the pages without value and when the
+	// pages are not grouped.
+	Key string
+	// Count is the number of pages of the group.
+	Count int
+	// Values are the values of the aggregations, by name. The min, max and
+	// avg of groups without values are left out.
+	Values map[string]float64
+}
+
+// Aggregate computes aggregations over the pages of the database with the ID
+// specified matching the query of requestBody, streaming them with
+// DatabaseService.QueryIterator: the API has no aggregation endpoint. It
+// returns a group per value of the GroupBy property of aggs, in the order of
+// the pages, or a single group when the pages are not grouped.
+func (c *Client) Aggregate(ctx context.Context, id DatabaseID, requestBody *DatabaseQueryRequest, aggs *AggregateRequest) ([]AggregateGroup, error) {
+	if aggs == nil {
+		aggs = &AggregateRequest{}
+	}
+	names := map[string]bool{}
+	for _, agg := range aggs.Aggregations {
+		switch agg.Function {
+		case AggregateCount:
+		case AggregateSum, AggregateMin, AggregateMax, AggregateAvg:
+			if agg.Property == "" {
+				return nil, fmt.Errorf("aggregation %s without property", agg.Function)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported aggregation function %q", agg.Function)
+		}
+		if names[agg.name()] {
+			return nil, fmt.Errorf("duplicate aggregation %q", agg.name())
+		}
+		names[agg.name()] = true
+	}
+
+	var (
+		groups []*aggregateState
+		byKey  = map[string]*aggregateState{}
+	)
+	group := func(key string) *aggregateState {
+		state, ok := byKey[key]
+		if !ok {
+			state = newAggregateState(key, len(aggs.Aggregations))
+			byKey[key] = state
+			groups = append(groups, state)
+		}
+		return state
+	}
+	if aggs.GroupBy == "" {
+		group("")
+	}
+
+	it := c.Database.QueryIterator(ctx, id, requestBody)
+	for it.Next() {
+		page := it.Page()
+		keys := []string{""}
+		if aggs.GroupBy != "" {
+			property, err := page.Properties.get(aggs.GroupBy)
+			if err != nil {
+				return nil, fmt.Errorf("page %s: %w", page.ID, err)
+			}
+			keys = groupKeys(property)
+		}
+		for _, key := range keys {
+			if err := group(key).add(page, aggs.Aggregations); err != nil {
+				return nil, fmt.Errorf("page %s: %w", page.ID, err)
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]AggregateGroup, len(groups))
+	for i, state := range groups {
+		results[i] = state.result(aggs.Aggregations)
+	}
+	return results, nil
+}
+
+// groupKeys returns the keys of the groups of the pages with the value of
+// property.
+func groupKeys(property Property) []string {
+	if items, ok := propertyStrings(property); ok {
+		if len(items) == 0 {
+			return []string{""}
+		}
+		return items
+	}
+	return []string{PropertyText(property)}
+}
+
+// aggregateState is the state of the aggregations of a group.
+type aggregateState struct {
+	key    string
+	count  int
+	counts []int
+	sums   []float64
+	mins   []float64
+	maxs   []float64
+}
+
+func newAggregateState(key string, n int) *aggregateState {
+	state := &aggregateState{
+		key:    key,
+		counts: make([]int, n),
+		sums:   make([]float64, n),
+		mins:   make([]float64, n),
+		maxs:   make([]float64, n),
+	}
+	for i := range state.mins {
+		state.mins[i] = math.Inf(1)
+		state.maxs[i] = math.Inf(-1)
+	}
+	return state
+}
+
+func (s *aggregateState) add(page *Page, aggs []Aggregation) error {
+	s.count++
+	for i, agg := range aggs {
+		if agg.Property == "" {
+			continue
+		}
+		property, err := page.Properties.get(agg.Property)
+		if err != nil {
+			return err
+		}
+		if agg.Function == AggregateCount {
+			if PropertyText(property) != "" {
+				s.counts[i]++
+			}
+			continue
+		}
+		if emptyNumber(property) {
+			continue
+		}
+		number, ok := propertyNumber(property)
+		if !ok {
+			return propertyTypeError(agg.Property, property, PropertyTypeNumber)
+		}
+		s.counts[i]++
+		s.sums[i] += number
+		s.mins[i] = math.Min(s.mins[i], number)
+		s.maxs[i] = math.Max(s.maxs[i], number)
+	}
+	return nil
+}
+
+// emptyNumber reports whether property is a number, formula or rollup
+// without value, which are left out of aggregations rather than rejected.
+func emptyNumber(property Property) bool {
+	switch p := property.(type) {
+	case *NumberProperty:
+		return p.IsEmpty()
+	case *FormulaProperty:
+		return p.Formula.Value() == nil
+	case *RollupProperty:
+		return p.Rollup.Value() == nil
+	}
+	return false
+}
+
+func (s *aggregateState) result(aggs []Aggregation) AggregateGroup {
+	group := AggregateGroup{Key: s.key, Count: s.count, Values: map[string]float64{}}
+	for i, agg := range aggs {
+		name := agg.name()
+		switch agg.Function {
+		case AggregateCount:
+			if agg.Property == "" {
+				group.Values[name] = float64(s.count)
+			} else {
+				group.Values[name] = float64(s.counts[i])
+			}
+		case AggregateSum:
+			group.Values[name] = s.sums[i]
+		case AggregateMin, AggregateMax, AggregateAvg:
+			if s.counts[i] == 0 {
+				continue
+			}
+			switch agg.Function {
+			case AggregateMin:
+				group.Values[name] = s.mins[i]
+			case AggregateMax:
+				group.Values[name] = s.maxs[i]
+			default:
+				group.Values[name] = s.sums[i] / float64(s.counts[i])
+			}
+		}
+	}
+	return group
+}
@@ -0,0 +1,138 @@
+package notionapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientAggregate(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+			{"object":"page","id":"page_1","properties":{
+				"Estimate": {"id": "a", "type": "number", "number": 3},
+				"Points": {"id": "e", "type": "number", "number": 10},
+				"Status": {"id": "b", "type": "select", "select": {"name": "Done"}},
+				"Tags": {"id": "c", "type": "multi_select", "multi_select": [{"name": "home"}, {"name": "urgent"}]},
+				"Notes": {"id": "d", "type": "rich_text", "rich_text": []}
+			}},
+			{"object":"page","id":"page_2","properties":{
+				"Estimate": {"id": "a", "type": "number", "number": 5},
+				"Points": {"id": "e", "type": "number", "number": null},
+				"Status": {"id": "b", "type": "select", "select": {"name": "To do"}},
+				"Tags": {"id": "c", "type": "multi_select", "multi_select": [{"name": "home"}]},
+				"Notes": {"id": "d", "type": "rich_text", "rich_text": [{"type": "text", "text": {"content": "soon"}, "plain_text": "soon"}]}
+			}},
+			{"object":"page","id":"page_3","properties":{
+				"Estimate": {"id": "a", "type": "number", "number": 1},
+				"Points": {"id": "e", "type": "number", "number": null},
+				"Status": {"id": "b", "type": "select", "select": {"name": "Done"}},
+				"Tags": {"id": "c", "type": "multi_select", "multi_select": []},
+				"Notes": {"id": "d", "type": "rich_text", "rich_text": []}
+			}}
+		]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tests := []struct {
+		name    string
+		aggs    *notionapi.AggregateRequest
+		want    []notionapi.AggregateGroup
+		wantErr bool
+	}{
+		{
+			name: "not grouped",
+			aggs: &notionapi.AggregateRequest{Aggregations: []notionapi.Aggregation{
+				{Function: notionapi.AggregateCount},
+				{Function: notionapi.AggregateCount, Property: "Notes"},
+				{Function: notionapi.AggregateSum, Property: "Estimate"},
+				{Function: notionapi.AggregateMin, Property: "Estimate"},
+				{Function: notionapi.AggregateMax, Property: "Estimate"},
+				{Name: "average", Function: notionapi.AggregateAvg, Property: "Estimate"},
+			}},
+			want: []notionapi.AggregateGroup{{Count: 3, Values: map[string]float64{
+				"count": 3, "count(Notes)": 1, "sum(Estimate)": 9, "min(Estimate)": 1, "max(Estimate)": 5, "average": 3,
+			}}},
+		},
+		{
+			name: "grouped by select",
+			aggs: &notionapi.AggregateRequest{GroupBy: "Status", Aggregations: []notionapi.Aggregation{
+				{Function: notionapi.AggregateSum, Property: "Estimate"},
+			}},
+			want: []notionapi.AggregateGroup{
+				{Key: "Done", Count: 2, Values: map[string]float64{"sum(Estimate)": 4}},
+				{Key: "To do", Count: 1, Values: map[string]float64{"sum(Estimate)": 5}},
+			},
+		},
+		{
+			name: "grouped by multi-select",
+			aggs: &notionapi.AggregateRequest{GroupBy: "Tags", Aggregations: []notionapi.Aggregation{
+				{Function: notionapi.AggregateAvg, Property: "Estimate"},
+			}},
+			want: []notionapi.AggregateGroup{
+				{Key: "home", Count: 2, Values: map[string]float64{"avg(Estimate)": 4}},
+				{Key: "urgent", Count: 1, Values: map[string]float64{"avg(Estimate)": 3}},
+				{Key: "", Count: 1, Values: map[string]float64{"avg(Estimate)": 1}},
+			},
+		},
+		{
+			name: "empty numbers",
+			aggs: &notionapi.AggregateRequest{Aggregations: []notionapi.Aggregation{
+				{Function: notionapi.AggregateCount, Property: "Points"},
+				{Function: notionapi.AggregateSum, Property: "Points"},
+				{Function: notionapi.AggregateMin, Property: "Points"},
+				{Function: notionapi.AggregateMax, Property: "Points"},
+				{Function: notionapi.AggregateAvg, Property: "Points"},
+			}},
+			want: []notionapi.AggregateGroup{{Count: 3, Values: map[string]float64{
+				"count(Points)": 1, "sum(Points)": 10, "min(Points)": 10, "max(Points)": 10, "avg(Points)": 10,
+			}}},
+		},
+		{
+			name: "grouped empty numbers",
+			aggs: &notionapi.AggregateRequest{GroupBy: "Status", Aggregations: []notionapi.Aggregation{
+				{Function: notionapi.AggregateAvg, Property: "Points"},
+			}},
+			want: []notionapi.AggregateGroup{
+				{Key: "Done", Count: 2, Values: map[string]float64{"avg(Points)": 10}},
+				{Key: "To do", Count: 1, Values: map[string]float64{}},
+			},
+		},
+		{
+			name: "sum of text",
+			aggs: &notionapi.AggregateRequest{Aggregations: []notionapi.Aggregation{
+				{Function: notionapi.AggregateSum, Property: "Notes"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unknown function",
+			aggs: &notionapi.AggregateRequest{Aggregations: []notionapi.Aggregation{
+				{Function: "median", Property: "Estimate"},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.Aggregate(context.Background(), "database_id", nil, tt.aggs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Aggregate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Aggregate() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unknown property", func(t *testing.T) {
+		_, err := client.Aggregate(context.Background(), "database_id", nil, &notionapi.AggregateRequest{GroupBy: "Owner"})
+		if !errors.Is(err, notionapi.ErrPropertyNotFound) {
+			t.Errorf("Aggregate() error = %v, want %v", err, notionapi.ErrPropertyNotFound)
+		}
+	})
+}
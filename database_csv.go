@@ -0,0 +1,207 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportCSVOptions configures Client.ExportCSV.
+type ExportCSVOptions struct {
+	// Filter limits the pages exported, all of them when nil.
+	Filter Filter
+	// Sorts orders the pages exported.
+	Sorts []SortObject
+	// Properties are the names of the properties exported, in the order of
+	// the columns. Defaults to all the properties of the database, the title
+	// first and the others sorted by name.
+	Properties []string
+}
+
+// ExportCSV writes the pages of the database with the ID specified to w as
+// CSV: a header row with the names of the properties, then a row per page
+// with its values as formatted by PropertyText. The pages are retrieved and
+// written as the query goes, so a failure can leave w with part of them.
+func (c *Client) ExportCSV(ctx context.Context, id DatabaseID, w io.Writer, opts *ExportCSVOptions) error {
+	if opts == nil {
+		opts = &ExportCSVOptions{}
+	}
+	columns := opts.Properties
+	if len(columns) == 0 {
+		database, err := c.Database.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		columns = schemaColumns(database.Properties)
+	}
+
+	out := csv.NewWriter(w)
+	if err := out.Write(columns); err != nil {
+		return err
+	}
+	it := c.Database.QueryIterator(ctx, id, &DatabaseQueryRequest{Filter: opts.Filter, Sorts: opts.Sorts})
+	row := make([]string, len(columns))
+	for it.Next() {
+		page := it.Page()
+		for i, name := range columns {
+			row[i] = PropertyText(page.Properties[name])
+		}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// schemaColumns returns the names of the properties of schema, the title
+// first and the others sorted by name.
+func schemaColumns(schema PropertyConfigs) []string {
+	var title string
+	names := make([]string, 0, len(schema))
+	for name, config := range schema {
+		if config != nil && config.GetType() == PropertyConfigTypeTitle {
+			title = name
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if title != "" {
+		names = append([]string{title}, names...)
+	}
+	return names
+}
+
+// PropertyText returns the value of a property as text, as in the cells of
+// CSV exports: the plain text of rich text, the names of options and users,
+// the IDs of related pages, the URLs of files, dates and times in RFC 3339
+// format with "/" between the start and the end of ranges, and the values of
+// formulas and rollups. The elements of lists are separated by ", ". Empty
+// values and nil properties are "".
+func PropertyText(property Property) string {
+	if property == nil {
+		return ""
+	}
+	switch p := propertyPointer(property).(type) {
+	case *TitleProperty:
+		return concatenateRichText(p.Title)
+	case *RichTextProperty:
+		return concatenateRichText(p.RichText)
+	case *TextProperty:
+		return concatenateRichText(p.Text)
+	case *NumberProperty:
+		if p.IsEmpty() {
+			return ""
+		}
+		return formatNumber(p.Number)
+	case *SelectProperty:
+		return p.Select.Name
+	case *StatusProperty:
+		return p.Status.Name
+	case *MultiSelectProperty:
+		names := make([]string, len(p.MultiSelect))
+		for i, option := range p.MultiSelect {
+			names[i] = option.Name
+		}
+		return strings.Join(names, ", ")
+	case *DateProperty:
+		return dateObjectText(p.Date)
+	case *FormulaProperty:
+		switch p.Formula.Type {
+		case FormulaTypeString:
+			return p.Formula.String
+		case FormulaTypeNumber:
+			return formatNumber(p.Formula.Number)
+		case FormulaTypeBoolean:
+			return strconv.FormatBool(p.Formula.Boolean)
+		case FormulaTypeDate:
+			return dateObjectText(p.Formula.Date)
+		}
+	case *RelationProperty:
+		ids := make([]string, len(p.Relation))
+		for i, relation := range p.Relation {
+			ids[i] = relation.ID.String()
+		}
+		return strings.Join(ids, ", ")
+	case *RollupProperty:
+		switch p.Rollup.Type {
+		case RollupTypeNumber:
+			return formatNumber(p.Rollup.Number)
+		case RollupTypeDate:
+			return dateObjectText(p.Rollup.Date)
+		case RollupTypeArray:
+			var items []string
+			for _, item := range p.Rollup.Array {
+				if text := PropertyText(item); text != "" {
+					items = append(items, text)
+				}
+			}
+			return strings.Join(items, ", ")
+		}
+	case *PeopleProperty:
+		names := make([]string, len(p.People))
+		for i, user := range p.People {
+			names[i] = userText(&user)
+		}
+		return strings.Join(names, ", ")
+	case *FilesProperty:
+		urls := make([]string, len(p.Files))
+		for i, file := range p.Files {
+			urls[i] = file.GetURL()
+		}
+		return strings.Join(urls, ", ")
+	case *CheckboxProperty:
+		return strconv.FormatBool(p.Checkbox)
+	case *URLProperty:
+		return p.URL
+	case *EmailProperty:
+		return p.Email
+	case *PhoneNumberProperty:
+		return p.PhoneNumber
+	case *CreatedTimeProperty:
+		return p.CreatedTime.Format(time.RFC3339)
+	case *LastEditedTimeProperty:
+		return p.LastEditedTime.Format(time.RFC3339)
+	case *CreatedByProperty:
+		return userText(&p.CreatedBy)
+	case *LastEditedByProperty:
+		return userText(&p.LastEditedBy)
+	case *UniqueIDProperty:
+		return p.UniqueID.String()
+	case *VerificationProperty:
+		return string(p.Verification.State)
+	}
+	return ""
+}
+
+func formatNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
+
+func dateObjectText(date *DateObject) string {
+	if date == nil || date.Start == nil {
+		return ""
+	}
+	text := date.Start.String()
+	if date.End != nil {
+		text += "/" + date.End.String()
+	}
+	return text
+}
+
+// userText returns the name of a user, or its ID for the partial users
+// without name.
+func userText(user *User) string {
+	if user.Name != "" {
+		return user.Name
+	}
+	return user.ID.String()
+}
@@ -0,0 +1,220 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportCSV creates a page in the database with the ID specified for each row
+// of the CSV read from r, whose first row is a header, and returns the
+// result of the creation of each row at its index, the header excluded.
+//
+// mapping maps the names of the columns to the names of the properties they
+// set; the columns missing from it are ignored. When mapping is nil, the
+// columns set the properties with the same names, and the columns of the
+// properties computed by Notion, such as formulas, are ignored: the files
+// written by ExportCSV can be imported back.
+//
+// The cells are read in the formats PropertyText writes: numbers, dates in
+// RFC 3339 format or as 2006-01-02 with "/" between the start and the end of
+// ranges, true or false, yes or no for checkboxes, and lists separated by
+// commas for multi-selects, relations, people and files. People are given by
+// their names, email addresses or IDs, looked up with Client.PeopleFromText.
+// The options of selects and multi-selects that do not exist are created by
+// Notion. Empty cells leave the properties empty.
+//
+// The pages are created like with CreatePages. The rows that cannot be read
+// or created are reported with a *BatchError whose keys are their indexes.
+func (c *Client) ImportCSV(ctx context.Context, id DatabaseID, r io.Reader, mapping map[string]string) ([]CreatePageResult, error) {
+	database, err := c.Database.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	in := csv.NewReader(r)
+	header, err := in.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	// properties are the names of the properties set by the columns, "" for
+	// the columns ignored
+	properties := make([]string, len(header))
+	for i, column := range header {
+		name := column
+		if mapping != nil {
+			if name = mapping[column]; name == "" {
+				continue
+			}
+		}
+		config, ok := database.Properties[name]
+		if !ok {
+			return nil, fmt.Errorf("column %q: %w: %q", column, ErrPropertyNotFound, name)
+		}
+		if readOnlyPropertyTypes[PropertyType(config.GetType())] {
+			if mapping == nil {
+				continue
+			}
+			return nil, fmt.Errorf("column %q: properties of type %s cannot be set", column, config.GetType())
+		}
+		properties[i] = name
+	}
+
+	var (
+		results  []CreatePageResult
+		requests []PageCreateRequest
+		// indexes are the indexes in results of the rows of requests
+		indexes []int
+	)
+	for {
+		record, err := in.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, CreatePageResult{})
+		request := PageCreateRequest{Parent: Parent{Type: ParentTypeDatabaseID, DatabaseID: id}, Properties: Properties{}}
+		for i, value := range record {
+			if i >= len(properties) || properties[i] == "" || strings.TrimSpace(value) == "" {
+				continue
+			}
+			var property Property
+			if config := database.Properties[properties[i]]; config.GetType() == PropertyConfigTypePeople {
+				property, err = c.PeopleFromText(ctx, splitCSVList(value)...)
+			} else {
+				property, err = csvProperty(config, value)
+			}
+			if err != nil {
+				results[len(results)-1].Err = fmt.Errorf("column %q: %w", header[i], err)
+				break
+			}
+			request.Properties[properties[i]] = property
+		}
+		if results[len(results)-1].Err == nil {
+			requests = append(requests, request)
+			indexes = append(indexes, len(results)-1)
+		}
+	}
+
+	created, _ := c.CreatePages(ctx, requests, nil)
+	for i, result := range created {
+		results[indexes[i]] = result
+	}
+	errs := make(map[string]error)
+	for i, result := range results {
+		if result.Err != nil {
+			errs[strconv.Itoa(i)] = result.Err
+		}
+	}
+	if len(errs) > 0 {
+		return results, &BatchError{Errors: errs, Total: len(results)}
+	}
+	return results, nil
+}
+
+// csvProperty returns the value of a property configured by config read from
+// a CSV cell.
+func csvProperty(config PropertyConfig, value string) (Property, error) {
+	value = strings.TrimSpace(value)
+	switch config.GetType() {
+	case PropertyConfigTypeTitle:
+		return &TitleProperty{Type: PropertyTypeTitle, Title: textRichText(value)}, nil
+	case PropertyConfigTypeRichText:
+		return &RichTextProperty{Type: PropertyTypeRichText, RichText: textRichText(value)}, nil
+	case PropertyConfigTypeNumber:
+		number, err := strconv.ParseFloat(strings.Replace(value, ",", "", -1), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return &NumberProperty{Type: PropertyTypeNumber, Number: number}, nil
+	case PropertyConfigTypeSelect:
+		return &SelectProperty{Type: PropertyTypeSelect, Select: Option{Name: value}}, nil
+	case PropertyConfigStatus:
+		return &StatusProperty{Type: PropertyTypeStatus, Status: Status{Name: value}}, nil
+	case PropertyConfigTypeMultiSelect:
+		names := splitCSVList(value)
+		options := make([]Option, len(names))
+		for i, name := range names {
+			options[i] = Option{Name: name}
+		}
+		return &MultiSelectProperty{Type: PropertyTypeMultiSelect, MultiSelect: options}, nil
+	case PropertyConfigTypeDate:
+		date := &DateObject{}
+		bounds := strings.SplitN(value, "/", 2)
+		for i, bound := range bounds {
+			t, err := time.Parse(time.RFC3339, strings.TrimSpace(bound))
+			if err != nil {
+				if t, err = time.Parse("2006-01-02", strings.TrimSpace(bound)); err != nil {
+					return nil, fmt.Errorf("%q is not a date", value)
+				}
+			}
+			d := Date(t)
+			if i == 0 {
+				date.Start = &d
+			} else {
+				date.End = &d
+			}
+		}
+		return &DateProperty{Type: PropertyTypeDate, Date: date}, nil
+	case PropertyConfigTypeCheckbox:
+		checked, err := strconv.ParseBool(value)
+		if err != nil {
+			switch strings.ToLower(value) {
+			case "yes":
+				checked = true
+			case "no":
+			default:
+				return nil, fmt.Errorf("%q is not a boolean", value)
+			}
+		}
+		return &CheckboxProperty{Type: PropertyTypeCheckbox, Checkbox: checked}, nil
+	case PropertyConfigTypeURL:
+		return &URLProperty{Type: PropertyTypeURL, URL: value}, nil
+	case PropertyConfigTypeEmail:
+		return &EmailProperty{Type: PropertyTypeEmail, Email: value}, nil
+	case PropertyConfigTypePhoneNumber:
+		return &PhoneNumberProperty{Type: PropertyTypePhoneNumber, PhoneNumber: value}, nil
+	case PropertyConfigTypeRelation:
+		items := splitCSVList(value)
+		relations := make([]Relation, len(items))
+		for i, item := range items {
+			id, err := ParsePageID(item)
+			if err != nil {
+				return nil, err
+			}
+			relations[i] = Relation{ID: id}
+		}
+		return &RelationProperty{Type: PropertyTypeRelation, Relation: relations}, nil
+	case PropertyConfigTypeFiles:
+		items := splitCSVList(value)
+		files := make([]File, len(items))
+		for i, item := range items {
+			files[i] = File{Name: urlBase(item), Type: FileTypeExternal, External: &FileObject{URL: item}}
+		}
+		return &FilesProperty{Type: PropertyTypeFiles, Files: files}, nil
+	}
+	return nil, fmt.Errorf("properties of type %s cannot be imported", config.GetType())
+}
+
+// splitCSVList returns the non-empty elements of a list separated by commas.
+func splitCSVList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// urlBase returns the last element of the path of a URL, which names files.
+func urlBase(url string) string {
+	url = strings.SplitN(strings.SplitN(url, "?", 2)[0], "#", 2)[0]
+	return url[strings.LastIndex(url, "/")+1:]
+}
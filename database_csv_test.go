@@ -0,0 +1,257 @@
+package notionapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientExportCSV(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			return newJSONResponse(http.StatusOK, `{"object":"database","id":"database_id","properties":{
+				"Tags": {"id": "a", "type": "multi_select", "multi_select": {"options": []}},
+				"Name": {"id": "title", "type": "title", "title": {}},
+				"Due": {"id": "b", "type": "date", "date": {}},
+				"Done": {"id": "c", "type": "checkbox", "checkbox": {}}
+			}}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+			{"object":"page","id":"page_1","properties":{
+				"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Buy milk, eggs"}, "plain_text": "Buy milk, eggs"}]},
+				"Tags": {"id": "a", "type": "multi_select", "multi_select": [{"name": "home"}, {"name": "urgent"}]},
+				"Due": {"id": "b", "type": "date", "date": {"start": "2024-05-01T10:00:00Z", "end": "2024-05-02T10:00:00Z"}},
+				"Done": {"id": "c", "type": "checkbox", "checkbox": true}
+			}},
+			{"object":"page","id":"page_2","properties":{
+				"Name": {"id": "title", "type": "title", "title": []},
+				"Tags": {"id": "a", "type": "multi_select", "multi_select": []},
+				"Due": {"id": "b", "type": "date", "date": null},
+				"Done": {"id": "c", "type": "checkbox", "checkbox": false}
+			}}
+		]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tests := []struct {
+		name string
+		opts *notionapi.ExportCSVOptions
+		want string
+	}{
+		{
+			name: "all properties",
+			want: "Name,Done,Due,Tags\n" +
+				"\"Buy milk, eggs\",true,2024-05-01T10:00:00Z/2024-05-02T10:00:00Z,\"home, urgent\"\n" +
+				",false,,\n",
+		},
+		{
+			name: "properties specified",
+			opts: &notionapi.ExportCSVOptions{Properties: []string{"Done", "Name"}},
+			want: "Done,Name\ntrue,\"Buy milk, eggs\"\nfalse,\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := client.ExportCSV(context.Background(), "database_id", &b, tt.opts); err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("ExportCSV() got:\n%s\nwant:\n%s", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPropertyText(t *testing.T) {
+	prefix := "TASK"
+	var empty notionapi.NumberProperty
+	if err := json.Unmarshal([]byte(`{"type":"number","number":null}`), &empty); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		property notionapi.Property
+		want     string
+	}{
+		{name: "nil", want: ""},
+		{name: "number", property: notionapi.NumberProperty{Number: 1.5}, want: "1.5"},
+		{name: "zero", property: &notionapi.NumberProperty{}, want: "0"},
+		{name: "empty number", property: &empty, want: ""},
+		{name: "formula", property: &notionapi.FormulaProperty{Formula: notionapi.Formula{Type: notionapi.FormulaTypeBoolean, Boolean: true}}, want: "true"},
+		{
+			name: "rollup array",
+			property: &notionapi.RollupProperty{Rollup: notionapi.Rollup{Type: notionapi.RollupTypeArray, Array: notionapi.PropertyArray{
+				&notionapi.NumberProperty{Number: 1},
+				&notionapi.NumberProperty{Number: 2},
+			}}},
+			want: "1, 2",
+		},
+		{name: "people", property: &notionapi.PeopleProperty{People: []notionapi.User{{Name: "Ada"}, {ID: "user_id"}}}, want: "Ada, user_id"},
+		{name: "unique id", property: &notionapi.UniqueIDProperty{UniqueID: notionapi.UniqueID{Prefix: &prefix, Number: 7}}, want: "TASK-7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := notionapi.PropertyText(tt.property); got != tt.want {
+				t.Errorf("PropertyText() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientImportCSV(t *testing.T) {
+	var bodies []string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			return newJSONResponse(http.StatusOK, `{"object":"database","id":"database_id","properties":{
+				"Name": {"id": "title", "type": "title", "title": {}},
+				"Price": {"id": "a", "type": "number", "number": {}},
+				"Tags": {"id": "b", "type": "multi_select", "multi_select": {"options": []}},
+				"Due": {"id": "c", "type": "date", "date": {}},
+				"Done": {"id": "d", "type": "checkbox", "checkbox": {}},
+				"Total": {"id": "e", "type": "formula", "formula": {"expression": "1"}}
+			}}`)
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bodies = append(bodies, string(body))
+		return newJSONResponse(http.StatusOK, `{"object":"page","id":"page_id","properties":{}}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tests := []struct {
+		name       string
+		csv        string
+		mapping    map[string]string
+		wantBodies []string
+		wantErrs   []string
+		wantErr    bool
+	}{
+		{
+			name: "columns named after the properties",
+			csv: "Name,Price,Tags,Due,Done,Total\n" +
+				"Milk,\"1,200.5\",\"a, b\",2024-05-01/2024-05-03,yes,2\n" +
+				"Eggs,cheap,,,,\n",
+			wantBodies: []string{
+				`{"parent":{"type":"database_id","database_id":"database_id"},"properties":{` +
+					`"Done":{"type":"checkbox","checkbox":true},` +
+					`"Due":{"type":"date","date":{"start":"2024-05-01T00:00:00Z","end":"2024-05-03T00:00:00Z"}},` +
+					`"Name":{"type":"title","title":[{"type":"text","text":{"content":"Milk"},"plain_text":"Milk"}]},` +
+					`"Price":{"type":"number","number":1200.5},` +
+					`"Tags":{"type":"multi_select","multi_select":[{"name":"a"},{"name":"b"}]}}}`,
+			},
+			wantErrs: []string{"1"},
+		},
+		{
+			name:       "mapping",
+			csv:        "Item,Ignored\nBread,x\n",
+			mapping:    map[string]string{"Item": "Name"},
+			wantBodies: []string{`{"parent":{"type":"database_id","database_id":"database_id"},"properties":{"Name":{"type":"title","title":[{"type":"text","text":{"content":"Bread"},"plain_text":"Bread"}]}}}`},
+		},
+		{
+			name:    "unknown column",
+			csv:     "Unknown\nx\n",
+			wantErr: true,
+		},
+		{
+			name:    "mapping to a formula",
+			csv:     "Total\n1\n",
+			mapping: map[string]string{"Total": "Total"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bodies = nil
+			results, err := client.ImportCSV(context.Background(), "database_id", strings.NewReader(tt.csv), tt.mapping)
+			var batchErr *notionapi.BatchError
+			if tt.wantErr {
+				if err == nil || errors.As(err, &batchErr) {
+					t.Fatalf("ImportCSV() error = %v, want an error before any creation", err)
+				}
+				return
+			}
+			var gotErrs []string
+			if errors.As(err, &batchErr) {
+				for key := range batchErr.Errors {
+					gotErrs = append(gotErrs, key)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotErrs, tt.wantErrs) {
+				t.Errorf("ImportCSV() errors for rows %v, want %v", gotErrs, tt.wantErrs)
+			}
+			if len(results) != strings.Count(tt.csv, "\n")-1 {
+				t.Errorf("ImportCSV() got %d results", len(results))
+			}
+			if !reflect.DeepEqual(bodies, tt.wantBodies) {
+				t.Errorf("ImportCSV() sent %v\nwant %v", bodies, tt.wantBodies)
+			}
+		})
+	}
+}
+
+func TestClientCSVRoundTrip(t *testing.T) {
+	var bodies []string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		switch {
+		case req.URL.Path == "/v1/users":
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+				{"object":"user","id":"user_1","type":"person","name":"Ada"},
+				{"object":"user","id":"user_2","type":"person","name":"Grace"},
+				{"object":"user","id":"user_3","type":"person"}]}`)
+		case req.Method == http.MethodGet:
+			return newJSONResponse(http.StatusOK, `{"object":"database","id":"database_id","properties":{
+				"Name": {"id": "title", "type": "title", "title": {}},
+				"Owners": {"id": "a", "type": "people", "people": {}}
+			}}`)
+		case strings.HasSuffix(req.URL.Path, "/query"):
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+				{"object":"page","id":"page_1","properties":{
+					"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Launch"}, "plain_text": "Launch"}]},
+					"Owners": {"id": "a", "type": "people", "people": [
+						{"object": "user", "id": "user_2", "name": "Grace"},
+						{"object": "user", "id": "user_3"}]}
+				}}
+			]}`)
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bodies = append(bodies, string(body))
+		return newJSONResponse(http.StatusOK, `{"object":"page","id":"page_id","properties":{}}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	var csv bytes.Buffer
+	if err := client.ExportCSV(context.Background(), "database_id", &csv, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ImportCSV(context.Background(), "database_id", &csv, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{`{"parent":{"type":"database_id","database_id":"database_id"},"properties":{` +
+		`"Name":{"type":"title","title":[{"type":"text","text":{"content":"Launch"},"plain_text":"Launch"}]},` +
+		`"Owners":{"type":"people","people":[{"object":"user","id":"user_2"},{"object":"user","id":"user_3"}]}}}`}
+	if !reflect.DeepEqual(bodies, want) {
+		t.Errorf("ImportCSV() bodies = %v\nwant %v", bodies, want)
+	}
+
+	_, err := client.ImportCSV(context.Background(), "database_id", strings.NewReader("Name,Owners\nLaunch,Nobody\n"), nil)
+	var batchErr *notionapi.BatchError
+	if !errors.As(err, &batchErr) || !errors.Is(batchErr.Errors["0"], notionapi.ErrUserNotFound) {
+		t.Errorf("ImportCSV() of an unknown person error = %v, want ErrUserNotFound", err)
+	}
+}
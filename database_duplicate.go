@@ -0,0 +1,284 @@
+package notionapi
+
+import (
+	"context"
+	"sort"
+)
+
+// DuplicateDatabaseResult is the result of Client.DuplicateDatabase.
+type DuplicateDatabaseResult struct {
+	// Database is the copy of the database.
+	Database *Database
+	// Pages maps the IDs of the pages of the source database to the IDs of
+	// their copies, when the rows are copied.
+	Pages map[PageID]PageID
+	// Skipped are the names of the properties of the source database that
+	// could not be copied: the status properties, which cannot be created
+	// with the API.
+	Skipped []string
+}
+
+// DuplicateDatabase copies the database with the ID specified to destParent,
+// a page: its title, description, icon, cover and schema, and its pages when
+// withRows is true. The public API has no way to duplicate databases, so the
+// copy is created again.
+//
+// The relations of the source database to itself relate the copy to itself,
+// and the relations to other databases relate the copy to the same
+// databases: their dual relations get a new synced property in the other
+// database, named by Notion. The formulas are copied as they are.
+//
+// The pages are copied with their properties and their icon when it is an
+// emoji or an external file, but not their content: see DuplicatePage. The
+// relations between the pages of the source database relate their copies,
+// and the properties computed by Notion are computed again. The pages that
+// cannot be copied are reported with a *BatchError whose keys are the IDs of
+// the source pages, along with the result.
+func (c *Client) DuplicateDatabase(ctx context.Context, source DatabaseID, destParent Parent, withRows bool) (*DuplicateDatabaseResult, error) {
+	database, err := c.Database.Get(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	schema := newSchemaCopy(database)
+
+	created, err := c.Database.Create(ctx, &DatabaseCreateRequest{
+		Parent:      destParent,
+		Title:       database.Title,
+		Description: database.Description,
+		Icon:        database.Icon,
+		Cover:       database.Cover,
+		IsInline:    database.IsInline,
+		Properties:  schema.initial,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &DuplicateDatabaseResult{Database: created, Pages: map[PageID]PageID{}, Skipped: schema.skipped}
+
+	// the rollups of the relations are added once the relations exist
+	id := DatabaseID(created.ID)
+	for _, properties := range []PropertyConfigs{schema.relationsTo(id), schema.rollups} {
+		if len(properties) == 0 {
+			continue
+		}
+		if created, err = c.Database.Update(ctx, id, &DatabaseUpdateRequest{Properties: properties}); err != nil {
+			return result, err
+		}
+		result.Database = created
+	}
+	if !withRows {
+		return result, nil
+	}
+	return result, c.duplicateRows(ctx, source, DatabaseID(created.ID), schema, result)
+}
+
+// schemaCopy is the schema of a copy of a database.
+type schemaCopy struct {
+	source DatabaseID
+	// initial is the schema the copy is created with.
+	initial PropertyConfigs
+	// selfRelations are the relations of the source database to itself,
+	// added once the copy is created, and rollups the rollups of them.
+	selfRelations map[string]*RelationPropertyConfig
+	rollups       PropertyConfigs
+	// synced are the relations created by the dual self-relations synced with
+	// them.
+	synced  map[string]bool
+	skipped []string
+}
+
+func newSchemaCopy(database *Database) *schemaCopy {
+	source := DatabaseID(database.ID)
+	schema := &schemaCopy{
+		source:        source,
+		initial:       PropertyConfigs{},
+		selfRelations: map[string]*RelationPropertyConfig{},
+		rollups:       PropertyConfigs{},
+		synced:        map[string]bool{},
+	}
+	for name, config := range database.Properties {
+		if relation, ok := config.(*RelationPropertyConfig); ok && pathID(relation.Relation.DatabaseID.String()) == pathID(source.String()) {
+			schema.selfRelations[name] = relation
+		}
+	}
+	for name, relation := range schema.selfRelations {
+		if _, synced := relation.SyncedProperty(); relation.IsDual() && !schema.synced[name] {
+			schema.synced[synced] = true
+		}
+	}
+
+	for name, config := range database.Properties {
+		switch config := config.(type) {
+		case nil:
+		case *StatusPropertyConfig:
+			schema.skipped = append(schema.skipped, name)
+		case *RelationPropertyConfig:
+			if schema.selfRelations[name] == nil {
+				relation := *config
+				relation.Relation = copyRelationConfig(config.Relation, config.Relation.DatabaseID, false)
+				schema.initial[name] = clearConfigIDs(&relation)
+			}
+		case *RollupPropertyConfig:
+			rollup := *config
+			rollup.Rollup.RelationPropertyID = ""
+			rollup.Rollup.RollupPropertyID = ""
+			if schema.selfRelations[rollup.Rollup.RelationPropertyName] != nil {
+				schema.rollups[name] = clearConfigIDs(&rollup)
+			} else {
+				schema.initial[name] = clearConfigIDs(&rollup)
+			}
+		default:
+			raw, err := rawConfig(config)
+			if err != nil {
+				continue
+			}
+			stripOptionIDs(raw)
+			schema.initial[name] = raw
+		}
+	}
+	sort.Strings(schema.skipped)
+	return schema
+}
+
+// relationsTo returns the self-relations of the copy of the database with the
+// ID specified, added once it is created.
+func (s *schemaCopy) relationsTo(id DatabaseID) PropertyConfigs {
+	properties := PropertyConfigs{}
+	for name, config := range s.selfRelations {
+		if s.synced[name] {
+			continue
+		}
+		relation := *config
+		relation.Relation = copyRelationConfig(config.Relation, id, true)
+		properties[name] = clearConfigIDs(&relation)
+	}
+	return properties
+}
+
+// copyRelationConfig returns the configuration of a copy of a relation to the
+// database with the ID specified. The copies of self-relations are synced with
+// the property of the same name as the relation copied.
+func copyRelationConfig(config RelationConfig, database DatabaseID, self bool) RelationConfig {
+	relation := RelationConfig{DatabaseID: database, Type: config.Type}
+	if relation.Type == "" {
+		relation.Type = RelationSingleProperty
+		if config.DualProperty != nil {
+			relation.Type = RelationDualProperty
+		}
+	}
+	if relation.Type == RelationDualProperty {
+		relation.DualProperty = &DualProperty{}
+		if !self {
+			return relation
+		}
+		_, synced := RelationPropertyConfig{Relation: config}.SyncedProperty()
+		relation.DualProperty.SyncedPropertyName = synced
+		return relation
+	}
+	relation.SingleProperty = &SingleProperty{}
+	return relation
+}
+
+// clearConfigIDs returns config, a pointer to a configuration, without its ID
+// and name.
+func clearConfigIDs(config PropertyConfig) PropertyConfig {
+	switch config := config.(type) {
+	case *RelationPropertyConfig:
+		config.ID, config.Name = "", ""
+	case *RollupPropertyConfig:
+		config.ID, config.Name = "", ""
+	}
+	return config
+}
+
+// stripOptionIDs removes the IDs of the options and groups of raw, which are
+// specific to the database they come from.
+func stripOptionIDs(raw rawPropertyConfig) {
+	typed, _ := raw[string(raw.GetType())].(map[string]interface{})
+	for _, key := range []string{"options", "groups"} {
+		items, _ := typed[key].([]interface{})
+		for _, item := range items {
+			if item, ok := item.(map[string]interface{}); ok {
+				delete(item, "id")
+				delete(item, "option_ids")
+			}
+		}
+	}
+}
+
+// duplicateRows copies the pages of the source database to the copy with the
+// ID specified, then relates the copies of related pages.
+func (c *Client) duplicateRows(ctx context.Context, source, dest DatabaseID, schema *schemaCopy, result *DuplicateDatabaseResult) error {
+	var (
+		sources  []Page
+		requests []PageCreateRequest
+		parent   = Parent{Type: ParentTypeDatabaseID, DatabaseID: dest}
+	)
+	it := c.Database.QueryIterator(ctx, source, nil)
+	for it.Next() {
+		page := it.Page()
+		properties := duplicableProperties(page.Properties, parent)
+		for _, name := range schema.skipped {
+			delete(properties, name)
+		}
+		for name := range schema.selfRelations {
+			delete(properties, name)
+		}
+		request := PageCreateRequest{Parent: parent, Properties: properties}
+		if page.Icon != nil && (page.Icon.Type == FileTypeEmoji || page.Icon.Type == FileTypeExternal) {
+			request.Icon = page.Icon
+		}
+		sources = append(sources, *page)
+		requests = append(requests, request)
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	created, _ := c.CreatePages(ctx, requests, nil)
+	errs := map[string]error{}
+	for i, res := range created {
+		if res.Err != nil {
+			errs[sources[i].ID.String()] = res.Err
+			continue
+		}
+		result.Pages[PageID(sources[i].ID)] = PageID(res.Page.ID)
+	}
+
+	// the relations between the pages copied
+	for _, page := range sources {
+		copyID, ok := result.Pages[PageID(page.ID)]
+		if !ok {
+			continue
+		}
+		properties := Properties{}
+		for name := range schema.selfRelations {
+			if schema.synced[name] {
+				// set by the relations synced with them
+				continue
+			}
+			ids, err := page.Properties.Relations(name)
+			if err != nil || len(ids) == 0 {
+				continue
+			}
+			var relation []Relation
+			for _, id := range ids {
+				if related, ok := result.Pages[id]; ok {
+					relation = append(relation, Relation{ID: related})
+				}
+			}
+			properties[name] = &RelationProperty{Type: PropertyTypeRelation, Relation: relation}
+		}
+		if len(properties) == 0 {
+			continue
+		}
+		if _, err := c.Page.Update(ctx, copyID, &PageUpdateRequest{Properties: properties}); err != nil {
+			errs[page.ID.String()] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return &BatchError{Errors: errs, Total: len(sources)}
+	}
+	return nil
+}
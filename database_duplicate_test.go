@@ -0,0 +1,161 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientDuplicateDatabase(t *testing.T) {
+	const source = `{"object":"database","id":"source_id","title":[{"type":"text","text":{"content":"Tasks"},"plain_text":"Tasks"}],
+		"parent":{"type":"page_id","page_id":"parent_id"},
+		"properties":{
+			"Name": {"id":"title","name":"Name","type":"title","title":{}},
+			"Status": {"id":"a","name":"Status","type":"status","status":{"options":[{"id":"s1","name":"Done","color":"green"}]}},
+			"Tags": {"id":"b","name":"Tags","type":"multi_select","multi_select":{"options":[{"id":"t1","name":"home","color":"red"}]}},
+			"Blocked by": {"id":"c","name":"Blocked by","type":"relation","relation":{"database_id":"source_id","type":"single_property","single_property":{}}},
+			"Project": {"id":"d","name":"Project","type":"relation","relation":{"database_id":"projects_id","type":"single_property","single_property":{}}}
+		}}`
+	const rows = `{"object":"list","has_more":false,"results":[
+		{"object":"page","id":"page_1","properties":{
+			"Name": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"one"},"plain_text":"one"}]},
+			"Status": {"id":"a","type":"status","status":{"name":"Done"}},
+			"Tags": {"id":"b","type":"multi_select","multi_select":[{"name":"home"}]},
+			"Blocked by": {"id":"c","type":"relation","relation":[{"id":"page_2"}]},
+			"Project": {"id":"d","type":"relation","relation":[{"id":"project_1"}]}
+		}},
+		{"object":"page","id":"page_2","properties":{
+			"Name": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"two"},"plain_text":"two"}]},
+			"Status": {"id":"a","type":"status","status":{"name":"Done"}},
+			"Tags": {"id":"b","type":"multi_select","multi_select":[]},
+			"Blocked by": {"id":"c","type":"relation","relation":[]},
+			"Project": {"id":"d","type":"relation","relation":[]}
+		}}
+	]}`
+
+	tests := []struct {
+		name      string
+		withRows  bool
+		wantPages map[notionapi.PageID]notionapi.PageID
+		// wantRequests are the requests sent after the source is retrieved.
+		wantRequests []string
+	}{
+		{
+			name:      "schema only",
+			wantPages: map[notionapi.PageID]notionapi.PageID{},
+			wantRequests: []string{
+				"POST /v1/databases",
+				"PATCH /v1/databases/copy_id",
+			},
+		},
+		{
+			name:     "with rows",
+			withRows: true,
+			wantPages: map[notionapi.PageID]notionapi.PageID{
+				"page_1": "copy_one",
+				"page_2": "copy_two",
+			},
+			wantRequests: []string{
+				"POST /v1/databases",
+				"PATCH /v1/databases/copy_id",
+				"POST /v1/databases/source_id/query",
+				"POST /v1/pages",
+				"POST /v1/pages",
+				"PATCH /v1/pages/copy_one",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				requests []string
+				bodies   = map[string]map[string]interface{}{}
+			)
+			c := newTestClient(func(req *http.Request) *http.Response {
+				var body map[string]interface{}
+				if req.Body != nil {
+					data, _ := ioutil.ReadAll(req.Body)
+					_ = json.Unmarshal(data, &body)
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				request := req.Method + " " + req.URL.Path
+				if request == "GET /v1/databases/source_id" {
+					return newJSONResponse(http.StatusOK, source)
+				}
+				requests = append(requests, request)
+				if _, ok := bodies[request]; !ok {
+					bodies[request] = body
+				}
+				switch {
+				case request == "POST /v1/databases/source_id/query":
+					return newJSONResponse(http.StatusOK, rows)
+				case request == "POST /v1/pages":
+					properties, _ := body["properties"].(map[string]interface{})
+					data, _ := json.Marshal(properties["Name"])
+					title := "unknown"
+					for _, name := range []string{"one", "two"} {
+						if strings.Contains(string(data), name) {
+							title = name
+						}
+					}
+					return newJSONResponse(http.StatusOK, `{"object":"page","id":"copy_`+title+`"}`)
+				case strings.HasPrefix(request, "PATCH /v1/pages/"):
+					return newJSONResponse(http.StatusOK, `{"object":"page","id":"copy"}`)
+				}
+				return newJSONResponse(http.StatusOK, `{"object":"database","id":"copy_id"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			got, err := client.DuplicateDatabase(context.Background(), "source_id",
+				notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "dest_id"}, tt.withRows)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Skipped, []string{"Status"}) {
+				t.Errorf("Skipped = %v, want [Status]", got.Skipped)
+			}
+			if !reflect.DeepEqual(got.Pages, tt.wantPages) {
+				t.Errorf("Pages = %v, want %v", got.Pages, tt.wantPages)
+			}
+			if !reflect.DeepEqual(requests, tt.wantRequests) {
+				t.Errorf("requests = %v, want %v", requests, tt.wantRequests)
+			}
+
+			created, _ := bodies["POST /v1/databases"]["properties"].(map[string]interface{})
+			if _, ok := created["Status"]; ok {
+				t.Error("status property created")
+			}
+			if _, ok := created["Blocked by"]; ok {
+				t.Error("self-relation created before the database")
+			}
+			data, _ := json.Marshal(created)
+			if strings.Contains(string(data), `"t1"`) {
+				t.Errorf("option IDs copied: %s", data)
+			}
+			if !strings.Contains(string(data), `"projects_id"`) {
+				t.Errorf("relation to other database not copied: %s", data)
+			}
+
+			updated, _ := json.Marshal(bodies["PATCH /v1/databases/copy_id"])
+			if !strings.Contains(string(updated), `"Blocked by"`) || !strings.Contains(string(updated), `"copy_id"`) {
+				t.Errorf("self-relation not related to the copy: %s", updated)
+			}
+
+			if tt.withRows {
+				related, _ := json.Marshal(bodies["PATCH /v1/pages/copy_one"])
+				if !strings.Contains(string(related), `"copy_two"`) {
+					t.Errorf("relation not mapped to the copies: %s", related)
+				}
+			}
+		})
+	}
+}
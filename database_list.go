@@ -0,0 +1,79 @@
+package notionapi
+
+import "context"
+
+// ListDatabasesOptions configures Client.ListDatabases.
+type ListDatabasesOptions struct {
+	// Query restricts the databases listed to the ones whose title contains
+	// it. All the databases are listed when it is empty.
+	Query string
+	// IncludeArchived lists the archived databases and the databases in the
+	// trash too.
+	IncludeArchived bool
+	// OnlyArchived lists only the archived databases and the databases in the
+	// trash. It supersedes IncludeArchived.
+	OnlyArchived bool
+}
+
+// ListDatabases returns the databases shared with the integration, searched
+// with SearchService.Do, without the archived databases and the databases in
+// the trash unless opts says otherwise.
+//
+// With the versions of the API from NotionVersionDataSources, the search
+// returns data sources: the databases of the data sources found are
+// retrieved, once each.
+func (c *Client) ListDatabases(ctx context.Context, opts *ListDatabasesOptions) ([]Database, error) {
+	if opts == nil {
+		opts = &ListDatabasesOptions{}
+	}
+	filter := SearchFilter{Property: "object", Value: ObjectTypeDatabase.String()}
+	if c.usesDataSources() {
+		filter.Value = ObjectTypeDataSource.String()
+	}
+
+	var (
+		databases []Database
+		seen      = map[string]bool{}
+		request   = &SearchRequest{Query: opts.Query, Filter: filter}
+	)
+	for {
+		res, err := c.Search.Do(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range res.Results {
+			var database *Database
+			switch object := object.(type) {
+			case *Database:
+				database = object
+			case *DataSource:
+				id := object.Parent.DatabaseID
+				if id == "" || seen[pathID(id.String())] {
+					continue
+				}
+				seen[pathID(id.String())] = true
+				if database, err = c.Database.Get(ctx, id); err != nil {
+					return nil, err
+				}
+			default:
+				continue
+			}
+			if opts.listed(database) {
+				databases = append(databases, *database)
+			}
+		}
+		if !res.HasMore || res.NextCursor == "" {
+			return databases, nil
+		}
+		request.StartCursor = res.NextCursor
+	}
+}
+
+// listed reports whether ListDatabases lists database.
+func (opts *ListDatabasesOptions) listed(database *Database) bool {
+	archived := database.Archived || database.InTrash
+	if opts.OnlyArchived {
+		return archived
+	}
+	return opts.IncludeArchived || !archived
+}
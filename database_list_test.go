@@ -0,0 +1,135 @@
+package notionapi_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientListDatabases(t *testing.T) {
+	const databases = `{"object":"list","has_more":false,"results":[
+		{"object":"database","id":"database_1"},
+		{"object":"database","id":"database_2","archived":true},
+		{"object":"database","id":"database_3","in_trash":true}
+	]}`
+
+	tests := []struct {
+		name string
+		opts *notionapi.ListDatabasesOptions
+		want []notionapi.ObjectID
+	}{
+		{
+			name: "without archived",
+			want: []notionapi.ObjectID{"database_1"},
+		},
+		{
+			name: "with archived",
+			opts: &notionapi.ListDatabasesOptions{IncludeArchived: true},
+			want: []notionapi.ObjectID{"database_1", "database_2", "database_3"},
+		},
+		{
+			name: "only archived",
+			opts: &notionapi.ListDatabasesOptions{OnlyArchived: true},
+			want: []notionapi.ObjectID{"database_2", "database_3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(func(req *http.Request) *http.Response {
+				body, _ := ioutil.ReadAll(req.Body)
+				if !strings.Contains(string(body), `"value":"database"`) {
+					t.Errorf("search body = %s, want a database filter", body)
+				}
+				return newJSONResponse(http.StatusOK, databases)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			got, err := client.ListDatabases(context.Background(), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []notionapi.ObjectID
+			for _, database := range got {
+				ids = append(ids, database.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("ListDatabases() = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+
+	t.Run("data sources", func(t *testing.T) {
+		var gets []string
+		c := newTestClient(func(req *http.Request) *http.Response {
+			if req.URL.Path == "/v1/search" {
+				return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+					{"object":"data_source","id":"ds_1","parent":{"type":"database_id","database_id":"database_1"}},
+					{"object":"data_source","id":"ds_2","parent":{"type":"database_id","database_id":"database_1"}},
+					{"object":"data_source","id":"ds_3","parent":{"type":"database_id","database_id":"database_2"}}
+				]}`)
+			}
+			gets = append(gets, req.URL.Path)
+			id := strings.TrimPrefix(req.URL.Path, "/v1/databases/")
+			return newJSONResponse(http.StatusOK, `{"object":"database","id":"`+id+`","in_trash":`+
+				`false,"data_sources":[{"id":"ds_1","name":"Tasks"},{"id":"ds_2","name":"Archive"}]}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c), notionapi.WithVersion(notionapi.NotionVersionDataSources))
+
+		got, err := client.ListDatabases(context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 2 {
+			t.Errorf("ListDatabases() = %d databases, want 2", len(got))
+		}
+		if want := []string{"/v1/databases/database_1", "/v1/databases/database_2"}; !reflect.DeepEqual(gets, want) {
+			t.Errorf("databases retrieved = %v, want %v", gets, want)
+		}
+	})
+}
+
+func TestDatabaseClientArchive(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		restore  bool
+		wantBody string
+	}{
+		{name: "archive", wantBody: `{"archived":true}`},
+		{name: "restore", restore: true, wantBody: `{"archived":false}`},
+		{name: "archive with data sources", version: notionapi.NotionVersionDataSources, wantBody: `{"in_trash":true}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				data, _ := ioutil.ReadAll(req.Body)
+				body = string(data)
+				return newJSONResponse(http.StatusOK, `{"object":"database","id":"database_id","archived":true}`)
+			})
+			opts := []notionapi.ClientOption{notionapi.WithHTTPClient(c)}
+			if tt.version != "" {
+				opts = append(opts, notionapi.WithVersion(tt.version))
+			}
+			client := notionapi.NewClient("some_token", opts...)
+
+			var err error
+			if tt.restore {
+				_, err = client.Database.Restore(context.Background(), "database_id")
+			} else {
+				_, err = client.Database.Archive(context.Background(), "database_id")
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if body != tt.wantBody {
+				t.Errorf("body = %s, want %s", body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrOptionNotFound is returned by the helpers managing the options of
+// properties for the options that do not exist.
+var ErrOptionNotFound = errors.New("option not found")
+
+// PropertyOptions returns the options of the select, multi-select or status
+// property with the name specified of the database with the ID specified.
+func (c *Client) PropertyOptions(ctx context.Context, id DatabaseID, property string) ([]Option, error) {
+	database, err := c.Database.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	options, _, err := configOptions(database.Properties, property)
+	return options, err
+}
+
+// StatusGroups returns the groups of the options of the status property with
+// the name specified of the database with the ID specified, as shown on
+// boards: "To-do", "In progress" and "Complete" by default.
+func (c *Client) StatusGroups(ctx context.Context, id DatabaseID, property string) ([]GroupConfig, error) {
+	database, err := c.Database.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	config, ok := database.Properties[property]
+	if !ok || config == nil {
+		return nil, fmt.Errorf("%w: %q", ErrPropertyNotFound, property)
+	}
+	switch config := config.(type) {
+	case *StatusPropertyConfig:
+		return config.Status.Groups, nil
+	case StatusPropertyConfig:
+		return config.Status.Groups, nil
+	}
+	return nil, fmt.Errorf("property %q is a %s property, not a status property", property, config.GetType())
+}
+
+// Group returns the group the option with the name specified belongs to.
+func (c StatusConfig) Group(option string) (GroupConfig, bool) {
+	for _, o := range c.Options {
+		if o.Name != option {
+			continue
+		}
+		for _, group := range c.Groups {
+			for _, id := range group.OptionIDs {
+				if id.String() == o.ID.String() {
+					return group, true
+				}
+			}
+		}
+	}
+	return GroupConfig{}, false
+}
+
+// UpdateOptions replaces the options of the select or multi-select property
+// with the name specified of the database with the ID specified with the
+// options update returns from its current options, and returns the options
+// of the database updated.
+//
+// The API replaces the whole list of options: the options missing from the
+// list sent are removed, and removed from the pages that have them. The
+// options are matched by ID, so the options returned by update keep the IDs
+// of the options they update, and the new options have none.
+//
+// The options of status properties cannot be updated with the API, and
+// UpdateOptions returns an error for them without making an API call.
+func (c *Client) UpdateOptions(ctx context.Context, id DatabaseID, property string, update func([]Option) ([]Option, error)) ([]Option, error) {
+	database, err := c.Database.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	options, t, err := configOptions(database.Properties, property)
+	if err != nil {
+		return nil, err
+	}
+	if t == PropertyConfigStatus {
+		return nil, fmt.Errorf("property %q: the options of status properties cannot be updated with the API", property)
+	}
+	if options, err = update(append([]Option(nil), options...)); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(options))
+	for _, option := range options {
+		if option.Name == "" {
+			return nil, fmt.Errorf("property %q: option without name", property)
+		}
+		if names[option.Name] {
+			return nil, fmt.Errorf("property %q: duplicate option %q", property, option.Name)
+		}
+		names[option.Name] = true
+	}
+
+	var config PropertyConfig = &SelectPropertyConfig{Type: t, Select: Select{Options: options}}
+	if t == PropertyConfigTypeMultiSelect {
+		config = &MultiSelectPropertyConfig{Type: t, MultiSelect: Select{Options: options}}
+	}
+	// the options are sent even when empty, for all of them to be removed
+	if len(options) == 0 {
+		config = rawPropertyConfig{"type": t, string(t): map[string]interface{}{"options": []Option{}}}
+	}
+	updated, err := c.Database.Update(ctx, id, &DatabaseUpdateRequest{Properties: PropertyConfigs{property: config}})
+	if err != nil {
+		return nil, err
+	}
+	options, _, err = configOptions(updated.Properties, property)
+	return options, err
+}
+
+// AddOption adds an option to the select or multi-select property with the
+// name specified, see UpdateOptions. Notion picks a color for the option when
+// its color is empty.
+func (c *Client) AddOption(ctx context.Context, id DatabaseID, property string, option Option) ([]Option, error) {
+	return c.UpdateOptions(ctx, id, property, func(options []Option) ([]Option, error) {
+		return append(options, Option{Name: option.Name, Color: option.Color}), nil
+	})
+}
+
+// RenameOption renames an option of the select or multi-select property with
+// the name specified, see UpdateOptions. The pages that have the option keep
+// it under its new name.
+func (c *Client) RenameOption(ctx context.Context, id DatabaseID, property, oldName, newName string) ([]Option, error) {
+	return c.UpdateOptions(ctx, id, property, func(options []Option) ([]Option, error) {
+		i, err := optionIndex(options, oldName)
+		if err != nil {
+			return nil, err
+		}
+		options[i].Name = newName
+		return options, nil
+	})
+}
+
+// RecolorOption changes the color of an option of the select or multi-select
+// property with the name specified, see UpdateOptions.
+func (c *Client) RecolorOption(ctx context.Context, id DatabaseID, property, name string, color Color) ([]Option, error) {
+	return c.UpdateOptions(ctx, id, property, func(options []Option) ([]Option, error) {
+		i, err := optionIndex(options, name)
+		if err != nil {
+			return nil, err
+		}
+		options[i].Color = color
+		return options, nil
+	})
+}
+
+// RemoveOption removes an option of the select or multi-select property with
+// the name specified, and from the pages that have it, see UpdateOptions.
+func (c *Client) RemoveOption(ctx context.Context, id DatabaseID, property, name string) ([]Option, error) {
+	return c.UpdateOptions(ctx, id, property, func(options []Option) ([]Option, error) {
+		i, err := optionIndex(options, name)
+		if err != nil {
+			return nil, err
+		}
+		return append(options[:i], options[i+1:]...), nil
+	})
+}
+
+// configOptions returns the options and type of the select, multi-select or
+// status property with the name specified of schema.
+func configOptions(schema PropertyConfigs, property string) ([]Option, PropertyConfigType, error) {
+	config, ok := schema[property]
+	if !ok || config == nil {
+		return nil, "", fmt.Errorf("%w: %q", ErrPropertyNotFound, property)
+	}
+	switch config := config.(type) {
+	case *SelectPropertyConfig:
+		return config.Select.Options, config.GetType(), nil
+	case SelectPropertyConfig:
+		return config.Select.Options, config.GetType(), nil
+	case *MultiSelectPropertyConfig:
+		return config.MultiSelect.Options, config.GetType(), nil
+	case MultiSelectPropertyConfig:
+		return config.MultiSelect.Options, config.GetType(), nil
+	case *StatusPropertyConfig:
+		return config.Status.Options, config.GetType(), nil
+	case StatusPropertyConfig:
+		return config.Status.Options, config.GetType(), nil
+	}
+	return nil, "", fmt.Errorf("property %q is a %s property, which has no options", property, config.GetType())
+}
+
+func optionIndex(options []Option, name string) (int, error) {
+	for i, option := range options {
+		if option.Name == name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrOptionNotFound, name)
+}
@@ -0,0 +1,155 @@
+package notionapi_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+const optionsTestDatabase = `{"object":"database","id":"database_id","properties":{
+	"Tags": {"id": "a", "type": "multi_select", "multi_select": {"options": [
+		{"id": "1", "name": "home", "color": "red"},
+		{"id": "2", "name": "work", "color": "blue"}
+	]}},
+	"Status": {"id": "b", "type": "status", "status": {
+		"options": [{"id": "s1", "name": "Not started", "color": "default"}, {"id": "s2", "name": "Done", "color": "green"}],
+		"groups": [
+			{"id": "g1", "name": "To-do", "color": "gray", "option_ids": ["s1"]},
+			{"id": "g2", "name": "Complete", "color": "green", "option_ids": ["s2"]}
+		]
+	}},
+	"Name": {"id": "title", "type": "title", "title": {}}
+}}`
+
+func TestClientUpdateOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   func(*notionapi.Client) ([]notionapi.Option, error)
+		wantBody string
+		wantErr  error
+	}{
+		{
+			name: "add",
+			update: func(c *notionapi.Client) ([]notionapi.Option, error) {
+				return c.AddOption(context.Background(), "database_id", "Tags", notionapi.Option{Name: "errands", Color: notionapi.ColorGreen})
+			},
+			wantBody: `{"properties":{"Tags":{"type":"multi_select","multi_select":{"options":[{"id":"1","name":"home","color":"red"},{"id":"2","name":"work","color":"blue"},{"name":"errands","color":"green"}]}}}}`,
+		},
+		{
+			name: "rename",
+			update: func(c *notionapi.Client) ([]notionapi.Option, error) {
+				return c.RenameOption(context.Background(), "database_id", "Tags", "work", "office")
+			},
+			wantBody: `{"properties":{"Tags":{"type":"multi_select","multi_select":{"options":[{"id":"1","name":"home","color":"red"},{"id":"2","name":"office","color":"blue"}]}}}}`,
+		},
+		{
+			name: "recolor",
+			update: func(c *notionapi.Client) ([]notionapi.Option, error) {
+				return c.RecolorOption(context.Background(), "database_id", "Tags", "home", notionapi.ColorPink)
+			},
+			wantBody: `{"properties":{"Tags":{"type":"multi_select","multi_select":{"options":[{"id":"1","name":"home","color":"pink"},{"id":"2","name":"work","color":"blue"}]}}}}`,
+		},
+		{
+			name: "remove",
+			update: func(c *notionapi.Client) ([]notionapi.Option, error) {
+				return c.RemoveOption(context.Background(), "database_id", "Tags", "home")
+			},
+			wantBody: `{"properties":{"Tags":{"type":"multi_select","multi_select":{"options":[{"id":"2","name":"work","color":"blue"}]}}}}`,
+		},
+		{
+			name: "remove all",
+			update: func(c *notionapi.Client) ([]notionapi.Option, error) {
+				return c.UpdateOptions(context.Background(), "database_id", "Tags", func([]notionapi.Option) ([]notionapi.Option, error) {
+					return nil, nil
+				})
+			},
+			wantBody: `{"properties":{"Tags":{"multi_select":{"options":[]},"type":"multi_select"}}}`,
+		},
+		{
+			name: "unknown option",
+			update: func(c *notionapi.Client) ([]notionapi.Option, error) {
+				return c.RemoveOption(context.Background(), "database_id", "Tags", "garden")
+			},
+			wantErr: notionapi.ErrOptionNotFound,
+		},
+		{
+			name: "unknown property",
+			update: func(c *notionapi.Client) ([]notionapi.Option, error) {
+				return c.AddOption(context.Background(), "database_id", "Labels", notionapi.Option{Name: "home"})
+			},
+			wantErr: notionapi.ErrPropertyNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if req.Method == http.MethodPatch {
+					data, _ := ioutil.ReadAll(req.Body)
+					body = string(data)
+				}
+				return newJSONResponse(http.StatusOK, optionsTestDatabase)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+			_, err := tt.update(client)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				if body != "" {
+					t.Errorf("unexpected update %s", body)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if body != tt.wantBody {
+				t.Errorf("request body got:\n%s\nwant:\n%s", body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestClientStatusOptions(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected %s request", req.Method)
+		}
+		return newJSONResponse(http.StatusOK, optionsTestDatabase)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	options, err := client.PropertyOptions(context.Background(), "database_id", "Status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(options) != 2 || options[1].Name != "Done" {
+		t.Errorf("PropertyOptions() got = %+v", options)
+	}
+
+	groups, err := client.StatusGroups(context.Background(), "database_id", "Status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 || groups[1].Name != "Complete" {
+		t.Errorf("StatusGroups() got = %+v", groups)
+	}
+	config := notionapi.StatusConfig{Options: options, Groups: groups}
+	group, ok := config.Group("Done")
+	if !ok || !reflect.DeepEqual(group, groups[1]) {
+		t.Errorf("Group() got = %+v, %v", group, ok)
+	}
+
+	if _, err := client.AddOption(context.Background(), "database_id", "Status", notionapi.Option{Name: "Blocked"}); err == nil {
+		t.Error("AddOption() on a status property: expected an error")
+	}
+	if _, err := client.PropertyOptions(context.Background(), "database_id", "Name"); err == nil {
+		t.Error("PropertyOptions() on a title property: expected an error")
+	}
+}
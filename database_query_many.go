@@ -0,0 +1,184 @@
+package notionapi
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DatabasePage is a page returned by Client.QueryMany, with the database it
+// comes from.
+type DatabasePage struct {
+	Database DatabaseID
+	Page     Page
+}
+
+// QueryMany runs the query of requestBody against each of the databases with
+// the IDs specified, DefaultConcurrency at a time, and returns all the
+// pages matching it, merged in the order of requestBody.Sorts. The databases
+// must have the properties of the filter and sorts, by name.
+//
+// The sorts are applied again to merge the pages, comparing the values of
+// number, date and checkbox properties, and the text of the others as
+// formatted by PropertyText, ignoring case. The pages without value come last
+// whatever the direction, as in Notion. The sort is stable: the pages with
+// the same values, and all the pages when there are no sorts, are in the
+// order of ids, then in the order Notion returned them.
+//
+// Every database is queried even if some fail, unless ctx is done: the pages
+// of the other databases are returned along with a *BatchError whose keys are
+// the IDs of the databases that failed.
+func (c *Client) QueryMany(ctx context.Context, ids []DatabaseID, requestBody *DatabaseQueryRequest) ([]DatabasePage, error) {
+	results := make([][]Page, len(ids))
+	errs := forEachConcurrently(ctx, len(ids), DefaultConcurrency, func(i int) error {
+		var request *DatabaseQueryRequest
+		if requestBody != nil {
+			copied := *requestBody
+			request = &copied
+		}
+		it := c.Database.QueryIterator(ctx, ids[i], request)
+		for it.Next() {
+			results[i] = append(results[i], *it.Page())
+		}
+		return it.Err()
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var pages []DatabasePage
+	for i, id := range ids {
+		if errs[i] != nil {
+			continue
+		}
+		for _, page := range results[i] {
+			pages = append(pages, DatabasePage{Database: id, Page: page})
+		}
+	}
+	if requestBody != nil && len(requestBody.Sorts) > 0 {
+		sorts := requestBody.Sorts
+		sort.SliceStable(pages, func(i, j int) bool {
+			return comparePages(&pages[i].Page, &pages[j].Page, sorts) < 0
+		})
+	}
+
+	if err := newBatchError(errs, func(i int) string { return ids[i].String() }); err != nil {
+		return pages, err
+	}
+	return pages, nil
+}
+
+// comparePages compares a and b by sorts, returning a negative number when a
+// comes first, a positive number when b comes first, and 0 otherwise.
+func comparePages(a, b *Page, sorts []SortObject) int {
+	for _, s := range sorts {
+		va, vb := sortValue(a, s), sortValue(b, s)
+		switch {
+		case va == nil && vb == nil:
+			continue
+		case va == nil:
+			return 1
+		case vb == nil:
+			return -1
+		}
+		cmp := compareSortValues(va, vb)
+		if s.Direction == SortOrderDESC {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+// sortValue returns the value of page sorted by s: a float64, a time.Time, a
+// bool or a string, or nil when the page has no value.
+func sortValue(page *Page, s SortObject) interface{} {
+	switch s.Timestamp {
+	case TimestampCreated:
+		return page.CreatedTime
+	case TimestampLastEdited:
+		return page.LastEditedTime
+	}
+	property, err := page.Properties.get(s.Property)
+	if err != nil {
+		return nil
+	}
+	if _, ok := property.(*CheckboxProperty); ok {
+		value, _ := propertyBool(property)
+		return value
+	}
+	if emptyNumber(property) {
+		return nil
+	}
+	if value, ok := propertyNumber(property); ok {
+		return value
+	}
+	if value, ok := propertyTime(property); ok {
+		if value.IsZero() {
+			return nil
+		}
+		return value
+	}
+	if text := PropertyText(property); text != "" {
+		return strings.ToLower(text)
+	}
+	return nil
+}
+
+// compareSortValues compares the values returned by sortValue, which are
+// compared as text when they are of different types.
+func compareSortValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case float64:
+		if b, ok := b.(float64); ok {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			}
+			return 0
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			switch {
+			case a.Before(b):
+				return -1
+			case a.After(b):
+				return 1
+			}
+			return 0
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			switch {
+			case a == b:
+				return 0
+			case !a:
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(toSortText(a), toSortText(b))
+}
+
+func toSortText(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return formatNumber(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package notionapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientQueryMany(t *testing.T) {
+	responses := map[string]string{
+		"team_a": `{"object":"list","has_more":false,"results":[
+			{"object":"page","id":"a_1","created_time":"2024-01-03T00:00:00Z","properties":{
+				"Name": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"apple"},"plain_text":"apple"}]},
+				"Estimate": {"id":"a","type":"number","number":3},
+				"Points": {"id":"p","type":"number","number":null}
+			}},
+			{"object":"page","id":"a_2","created_time":"2024-01-01T00:00:00Z","properties":{
+				"Name": {"id":"title","type":"title","title":[]},
+				"Estimate": {"id":"a","type":"number","number":5},
+				"Points": {"id":"p","type":"number","number":10}
+			}}
+		]}`,
+		"team_b": `{"object":"list","has_more":false,"results":[
+			{"object":"page","id":"b_1","created_time":"2024-01-02T00:00:00Z","properties":{
+				"Name": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"Banana"},"plain_text":"Banana"}]},
+				"Estimate": {"id":"b","type":"number","number":3},
+				"Points": {"id":"p","type":"number","number":2}
+			}}
+		]}`,
+	}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		id := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/v1/databases/"), "/query")
+		if response, ok := responses[id]; ok {
+			return newJSONResponse(http.StatusOK, response)
+		}
+		return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"Could not find database"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tests := []struct {
+		name    string
+		ids     []notionapi.DatabaseID
+		sorts   []notionapi.SortObject
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "in the order of the databases",
+			ids:  []notionapi.DatabaseID{"team_b", "team_a"},
+			want: []string{"team_b/b_1", "team_a/a_1", "team_a/a_2"},
+		},
+		{
+			name:  "by number, stable",
+			ids:   []notionapi.DatabaseID{"team_a", "team_b"},
+			sorts: []notionapi.SortObject{{Property: "Estimate", Direction: notionapi.SortOrderDESC}},
+			want:  []string{"team_a/a_2", "team_a/a_1", "team_b/b_1"},
+		},
+		{
+			name:  "by number, empty values last",
+			ids:   []notionapi.DatabaseID{"team_a", "team_b"},
+			sorts: []notionapi.SortObject{{Property: "Points", Direction: notionapi.SortOrderASC}},
+			want:  []string{"team_b/b_1", "team_a/a_2", "team_a/a_1"},
+		},
+		{
+			name:  "by text, empty values last",
+			ids:   []notionapi.DatabaseID{"team_a", "team_b"},
+			sorts: []notionapi.SortObject{{Property: "Name", Direction: notionapi.SortOrderASC}},
+			want:  []string{"team_a/a_1", "team_b/b_1", "team_a/a_2"},
+		},
+		{
+			name:  "by timestamp",
+			ids:   []notionapi.DatabaseID{"team_a", "team_b"},
+			sorts: []notionapi.SortObject{{Timestamp: notionapi.TimestampCreated, Direction: notionapi.SortOrderASC}},
+			want:  []string{"team_a/a_2", "team_b/b_1", "team_a/a_1"},
+		},
+		{
+			name:    "failed database",
+			ids:     []notionapi.DatabaseID{"team_a", "missing"},
+			want:    []string{"team_a/a_1", "team_a/a_2"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.QueryMany(context.Background(), tt.ids, &notionapi.DatabaseQueryRequest{Sorts: tt.sorts})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("QueryMany() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var batchErr *notionapi.BatchError
+			if tt.wantErr && (!errors.As(err, &batchErr) || batchErr.Errors["missing"] == nil) {
+				t.Errorf("QueryMany() error = %v, want a *BatchError for missing", err)
+			}
+			var ids []string
+			for _, page := range got {
+				ids = append(ids, page.Database.String()+"/"+page.Page.ID.String())
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("QueryMany() = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
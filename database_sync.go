@@ -0,0 +1,272 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrSyncConflict is returned by Client.Sync with the SyncAbortOnConflict
+// policy when pages to change were edited in Notion since the last sync.
+var ErrSyncConflict = errors.New("pages edited since the last sync")
+
+// SyncConflictPolicy is what Client.Sync does with the pages edited in Notion
+// since the last sync that it would change.
+type SyncConflictPolicy string
+
+const (
+	// SyncPreferLocal overwrites the pages edited in Notion with the values of
+	// the items. This is the default.
+	SyncPreferLocal SyncConflictPolicy = "prefer_local"
+	// SyncPreferRemote leaves the pages edited in Notion unchanged. They are
+	// reported in SyncResult.Conflicts, whose pages can be decoded with
+	// DecodePage to update the items.
+	SyncPreferRemote SyncConflictPolicy = "prefer_remote"
+	// SyncAbortOnConflict changes nothing when any page to change was edited
+	// in Notion: ErrSyncConflict is returned along with the conflicts.
+	SyncAbortOnConflict SyncConflictPolicy = "abort"
+)
+
+// SyncOptions configures Client.Sync.
+type SyncOptions struct {
+	// Key is the name of the property identifying the items and the pages,
+	// such as an external ID. It is required, and must be set for every
+	// item.
+	Key string
+	// Query restricts the pages synced to the ones matching its filter. All
+	// the pages of the database are synced when it is nil.
+	Query *DatabaseQueryRequest
+	// ArchiveMissing archives the pages without item of the same key.
+	ArchiveMissing bool
+	// DryRun computes the changes without making them.
+	DryRun bool
+	// Since is the time of the last sync: the pages edited in Notion after
+	// it conflict with the changes of the sync, and are handled according to
+	// Conflicts. No page conflicts when it is zero.
+	Since     time.Time
+	Conflicts SyncConflictPolicy
+}
+
+// SyncChange is a change of a page made by Client.Sync.
+type SyncChange struct {
+	// Key is the value of the key property of the page, as formatted by
+	// PropertyText.
+	Key string
+	// PageID is the ID of the page, empty for the pages to create in a dry
+	// run.
+	PageID PageID
+	// Properties are the properties sent: all the properties of the item for
+	// creations, and only the properties that differ for updates.
+	Properties Properties
+	// Page is the page in Notion before the change, nil for creations.
+	Page *Page
+}
+
+// SyncResult is the result of Client.Sync.
+type SyncResult struct {
+	Created   []SyncChange
+	Updated   []SyncChange
+	Archived  []SyncChange
+	Conflicts []SyncChange
+	// Unchanged is the number of pages already matching their item.
+	Unchanged int
+}
+
+// Sync converges the pages of the database with the ID specified to items, a
+// slice of structs, or pointers to structs, encoded with EncodeProperties.
+// The items and the pages are matched by the value of the opts.Key property:
+// the pages of new items are created, the pages whose properties differ from
+// their item are updated, and the pages without item are archived when
+// opts.ArchiveMissing is set.
+//
+// The pages edited in Notion after opts.Since that Sync would change are
+// conflicts, handled according to opts.Conflicts. The changes made are
+// returned, or the changes that would be made when opts.DryRun is set. The
+// pages that cannot be changed are reported with a *BatchError whose keys are
+// the keys of the pages, along with the result.
+func (c *Client) Sync(ctx context.Context, id DatabaseID, items interface{}, opts *SyncOptions) (*SyncResult, error) {
+	if opts == nil || opts.Key == "" {
+		return nil, errors.New("sync without key property")
+	}
+	local, keys, err := syncItems(items, opts.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := map[string]*Page{}
+	var missing []*Page
+	it := c.Database.QueryIterator(ctx, id, opts.Query)
+	for it.Next() {
+		page := it.Page()
+		key := PropertyText(page.Properties[opts.Key])
+		if _, ok := remote[key]; ok && key != "" {
+			return nil, fmt.Errorf("pages %s and %s have the same key %q", remote[key].ID, page.ID, key)
+		}
+		if _, ok := local[key]; !ok {
+			missing = append(missing, page)
+			continue
+		}
+		remote[key] = page
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{}
+	conflicts := func(page *Page) bool {
+		return !opts.Since.IsZero() && page.LastEditedTime.After(opts.Since)
+	}
+	for _, key := range keys {
+		page, ok := remote[key]
+		if !ok {
+			result.Created = append(result.Created, SyncChange{Key: key, Properties: local[key]})
+			continue
+		}
+		changed := changedProperties(local[key], page.Properties)
+		if len(changed) == 0 {
+			result.Unchanged++
+			continue
+		}
+		change := SyncChange{Key: key, PageID: PageID(page.ID), Properties: changed, Page: page}
+		if conflicts(page) && opts.Conflicts != "" && opts.Conflicts != SyncPreferLocal {
+			result.Conflicts = append(result.Conflicts, change)
+			continue
+		}
+		result.Updated = append(result.Updated, change)
+	}
+	if opts.ArchiveMissing {
+		for _, page := range missing {
+			change := SyncChange{Key: PropertyText(page.Properties[opts.Key]), PageID: PageID(page.ID), Page: page}
+			if conflicts(page) && opts.Conflicts != "" && opts.Conflicts != SyncPreferLocal {
+				result.Conflicts = append(result.Conflicts, change)
+				continue
+			}
+			result.Archived = append(result.Archived, change)
+		}
+	}
+
+	if len(result.Conflicts) > 0 && opts.Conflicts == SyncAbortOnConflict {
+		return result, ErrSyncConflict
+	}
+	if opts.DryRun {
+		return result, nil
+	}
+	return result, c.applySync(ctx, id, result)
+}
+
+// applySync makes the changes of result, setting the IDs of the pages
+// created.
+func (c *Client) applySync(ctx context.Context, id DatabaseID, result *SyncResult) error {
+	errs := map[string]error{}
+
+	requests := make([]PageCreateRequest, len(result.Created))
+	for i, change := range result.Created {
+		requests[i] = PageCreateRequest{
+			Parent:     Parent{Type: ParentTypeDatabaseID, DatabaseID: id},
+			Properties: change.Properties,
+		}
+	}
+	created, err := c.CreatePages(ctx, requests, nil)
+	if err != nil && ctx.Err() != nil {
+		return err
+	}
+	for i, res := range created {
+		if res.Err != nil {
+			errs[result.Created[i].Key] = res.Err
+			continue
+		}
+		result.Created[i].PageID = PageID(res.Page.ID)
+	}
+
+	for _, change := range result.Updated {
+		if _, err := c.Page.Update(ctx, change.PageID, &PageUpdateRequest{Properties: change.Properties}); err != nil {
+			errs[change.Key] = err
+		}
+	}
+	for _, change := range result.Archived {
+		if _, err := c.Page.Archive(ctx, change.PageID); err != nil {
+			errs[change.Key] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return &BatchError{Errors: errs, Total: len(result.Created) + len(result.Updated) + len(result.Archived)}
+	}
+	return nil
+}
+
+// syncItems encodes the items of Client.Sync, returning their properties by
+// key and the keys in the order of the items.
+func syncItems(items interface{}, key string) (map[string]Properties, []string, error) {
+	rv := reflect.ValueOf(items)
+	if rv.Kind() != reflect.Slice {
+		return nil, nil, fmt.Errorf("Sync of %T, not a slice", items)
+	}
+	local := make(map[string]Properties, rv.Len())
+	keys := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		properties, err := EncodeProperties(rv.Index(i).Interface())
+		if err != nil {
+			return nil, nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		value := PropertyText(properties[key])
+		if value == "" {
+			return nil, nil, fmt.Errorf("item %d: no value for the key property %q", i, key)
+		}
+		if _, ok := local[value]; ok {
+			return nil, nil, fmt.Errorf("item %d: duplicate key %q", i, value)
+		}
+		local[value] = properties
+		keys = append(keys, value)
+	}
+	return local, keys, nil
+}
+
+// changedProperties returns the properties of local whose values differ from
+// the values of the properties of the same name of remote.
+func changedProperties(local, remote Properties) Properties {
+	changed := Properties{}
+	for name, property := range local {
+		if current, ok := remote[name]; ok && syncValue(current) == syncValue(property) {
+			continue
+		}
+		changed[name] = property
+	}
+	return changed
+}
+
+// syncValue returns the value of property compared by Client.Sync: the IDs of
+// the users and pages of people and relations rather than their names, and
+// the instants of dates rather than their formatting.
+func syncValue(property Property) string {
+	switch p := propertyPointer(property).(type) {
+	case *PeopleProperty:
+		ids := make([]string, len(p.People))
+		for i, user := range p.People {
+			ids[i] = pathID(user.ID.String())
+		}
+		sort.Strings(ids)
+		return strings.Join(ids, ",")
+	case *RelationProperty:
+		ids := make([]string, len(p.Relation))
+		for i, relation := range p.Relation {
+			ids[i] = pathID(relation.ID.String())
+		}
+		sort.Strings(ids)
+		return strings.Join(ids, ",")
+	case *DateProperty:
+		if p.Date == nil || p.Date.Start == nil {
+			return ""
+		}
+		value := time.Time(*p.Date.Start).UTC().Format(time.RFC3339)
+		if p.Date.End != nil {
+			value += "/" + time.Time(*p.Date.End).UTC().Format(time.RFC3339)
+		}
+		return value
+	}
+	return PropertyText(property)
+}
@@ -0,0 +1,157 @@
+package notionapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+type syncTask struct {
+	Key      string  `notion:"Key,title"`
+	Estimate float64 `notion:"Estimate"`
+}
+
+func TestClientSync(t *testing.T) {
+	const pages = `{"object":"list","has_more":false,"results":[
+		{"object":"page","id":"page_1","last_edited_time":"2024-01-01T00:00:00Z","properties":{
+			"Key": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"ext-1"},"plain_text":"ext-1"}]},
+			"Estimate": {"id":"a","type":"number","number":1}
+		}},
+		{"object":"page","id":"page_2","last_edited_time":"2024-03-01T00:00:00Z","properties":{
+			"Key": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"ext-2"},"plain_text":"ext-2"}]},
+			"Estimate": {"id":"a","type":"number","number":2}
+		}},
+		{"object":"page","id":"page_3","last_edited_time":"2024-01-01T00:00:00Z","properties":{
+			"Key": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"ext-3"},"plain_text":"ext-3"}]},
+			"Estimate": {"id":"a","type":"number","number":3}
+		}}
+	]}`
+	items := []syncTask{{Key: "ext-1", Estimate: 1}, {Key: "ext-2", Estimate: 5}, {Key: "ext-4", Estimate: 8}}
+	since := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	keys := func(changes []notionapi.SyncChange) []string {
+		var keys []string
+		for _, change := range changes {
+			keys = append(keys, change.Key)
+		}
+		return keys
+	}
+
+	tests := []struct {
+		name          string
+		opts          *notionapi.SyncOptions
+		wantCreated   []string
+		wantUpdated   []string
+		wantArchived  []string
+		wantConflicts []string
+		wantRequests  []string
+		wantErr       error
+	}{
+		{
+			name:         "create and update",
+			opts:         &notionapi.SyncOptions{Key: "Key"},
+			wantCreated:  []string{"ext-4"},
+			wantUpdated:  []string{"ext-2"},
+			wantRequests: []string{"PATCH /v1/pages/page_2", "POST /v1/pages"},
+		},
+		{
+			name:         "archive missing",
+			opts:         &notionapi.SyncOptions{Key: "Key", ArchiveMissing: true},
+			wantCreated:  []string{"ext-4"},
+			wantUpdated:  []string{"ext-2"},
+			wantArchived: []string{"ext-3"},
+			wantRequests: []string{"PATCH /v1/pages/page_2", "PATCH /v1/pages/page_3", "POST /v1/pages"},
+		},
+		{
+			name:         "dry run",
+			opts:         &notionapi.SyncOptions{Key: "Key", ArchiveMissing: true, DryRun: true},
+			wantCreated:  []string{"ext-4"},
+			wantUpdated:  []string{"ext-2"},
+			wantArchived: []string{"ext-3"},
+		},
+		{
+			name:         "prefer local",
+			opts:         &notionapi.SyncOptions{Key: "Key", Since: since},
+			wantCreated:  []string{"ext-4"},
+			wantUpdated:  []string{"ext-2"},
+			wantRequests: []string{"PATCH /v1/pages/page_2", "POST /v1/pages"},
+		},
+		{
+			name:          "prefer remote",
+			opts:          &notionapi.SyncOptions{Key: "Key", Since: since, Conflicts: notionapi.SyncPreferRemote},
+			wantCreated:   []string{"ext-4"},
+			wantConflicts: []string{"ext-2"},
+			wantRequests:  []string{"POST /v1/pages"},
+		},
+		{
+			name:          "abort on conflict",
+			opts:          &notionapi.SyncOptions{Key: "Key", Since: since, Conflicts: notionapi.SyncAbortOnConflict},
+			wantCreated:   []string{"ext-4"},
+			wantConflicts: []string{"ext-2"},
+			wantErr:       notionapi.ErrSyncConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				requests []string
+			)
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if req.URL.Path == "/v1/databases/database_id/query" {
+					return newJSONResponse(http.StatusOK, pages)
+				}
+				mu.Lock()
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				mu.Unlock()
+				return newJSONResponse(http.StatusOK, `{"object":"page","id":"page_new"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			got, err := client.Sync(context.Background(), "database_id", items, tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Sync() error = %v, want %v", err, tt.wantErr)
+			}
+			sort.Strings(requests)
+			if !reflect.DeepEqual(requests, tt.wantRequests) {
+				t.Errorf("requests = %v, want %v", requests, tt.wantRequests)
+			}
+			for _, check := range []struct {
+				name      string
+				got, want []string
+			}{
+				{"Created", keys(got.Created), tt.wantCreated},
+				{"Updated", keys(got.Updated), tt.wantUpdated},
+				{"Archived", keys(got.Archived), tt.wantArchived},
+				{"Conflicts", keys(got.Conflicts), tt.wantConflicts},
+			} {
+				if !reflect.DeepEqual(check.got, check.want) {
+					t.Errorf("%s = %v, want %v", check.name, check.got, check.want)
+				}
+			}
+			if got.Unchanged != 1 {
+				t.Errorf("Unchanged = %d, want 1", got.Unchanged)
+			}
+			for _, change := range got.Updated {
+				if _, ok := change.Properties["Key"]; ok {
+					t.Errorf("unchanged property Key sent: %v", change.Properties)
+				}
+			}
+		})
+	}
+
+	t.Run("duplicate keys", func(t *testing.T) {
+		client := notionapi.NewClient("some_token")
+		_, err := client.Sync(context.Background(), "database_id", []syncTask{{Key: "a"}, {Key: "a"}}, &notionapi.SyncOptions{Key: "Key"})
+		if err == nil {
+			t.Error("Sync() error = nil, want an error")
+		}
+	})
+}
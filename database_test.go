@@ -2,6 +2,7 @@ package notionapi_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"reflect"
 	"testing"
@@ -568,3 +569,238 @@ func TestDatabaseQueryRequest_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseUpdateRequest_MarshalJSON(t *testing.T) {
+	inline := true
+	tests := []struct {
+		name string
+		req  *notionapi.DatabaseUpdateRequest
+		want string
+	}{
+		{
+			name: "only sends the fields set",
+			req: &notionapi.DatabaseUpdateRequest{
+				Description: []notionapi.RichText{{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "Things to buy"}}},
+				IsInline:    &inline,
+			},
+			want: `{"description":[{"type":"text","text":{"content":"Things to buy"}}],"is_inline":true}`,
+		},
+		{
+			name: "removes properties set to nil",
+			req: &notionapi.DatabaseUpdateRequest{
+				Properties: notionapi.PropertyConfigs{"Old": nil},
+				Icon:       notionapi.EmojiIcon("🛒"),
+			},
+			want: `{"properties":{"Old":null},"icon":{"type":"emoji","emoji":"🛒"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDatabaseCreateRequest_Validate(t *testing.T) {
+	title := notionapi.PropertyConfigs{"Name": notionapi.TitlePropertyConfig{Type: notionapi.PropertyConfigTypeTitle}}
+	tests := []struct {
+		name    string
+		req     *notionapi.DatabaseCreateRequest
+		wantErr bool
+	}{
+		{
+			name: "inline database in a page",
+			req: &notionapi.DatabaseCreateRequest{
+				Parent:     notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page_id"},
+				Properties: title,
+				IsInline:   true,
+			},
+		},
+		{
+			name: "full-page database in a page",
+			req: &notionapi.DatabaseCreateRequest{
+				Parent:     notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page_id"},
+				Properties: title,
+			},
+		},
+		{
+			name: "block parent",
+			req: &notionapi.DatabaseCreateRequest{
+				Parent:     notionapi.Parent{Type: notionapi.ParentTypeBlockID, BlockID: "block_id"},
+				Properties: title,
+				IsInline:   true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "workspace parent",
+			req: &notionapi.DatabaseCreateRequest{
+				Parent:     notionapi.Parent{Type: notionapi.ParentTypeWorkspace, Workspace: true},
+				Properties: title,
+			},
+			wantErr: true,
+		},
+		{
+			name: "page parent without id",
+			req: &notionapi.DatabaseCreateRequest{
+				Parent:     notionapi.Parent{Type: notionapi.ParentTypePageID},
+				Properties: title,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no title property",
+			req: &notionapi.DatabaseCreateRequest{
+				Parent:     notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page_id"},
+				Properties: notionapi.PropertyConfigs{"Done": notionapi.CheckboxPropertyConfig{Type: notionapi.PropertyConfigTypeCheckbox}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("Create does not send invalid requests", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			t.Fatal("unexpected request")
+			return nil
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+		if _, err := client.Database.Create(context.Background(), tests[2].req); err == nil {
+			t.Error("Create() expected an error")
+		}
+	})
+}
+
+func TestSortBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *notionapi.SortBuilder
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "property and timestamps",
+			builder: notionapi.NewSortBuilder().
+				Property("Priority", notionapi.SortOrderDESC).
+				CreatedTime(notionapi.SortOrderASC).
+				LastEditedTime(notionapi.SortOrderDESC),
+			want: `[{"property":"Priority","direction":"descending"},{"timestamp":"created_time","direction":"ascending"},{"timestamp":"last_edited_time","direction":"descending"}]`,
+		},
+		{
+			name:    "invalid direction",
+			builder: notionapi.NewSortBuilder().Property("Priority", "up"),
+			wantErr: true,
+		},
+		{
+			name:    "property without name",
+			builder: notionapi.NewSortBuilder().Property("", notionapi.SortOrderASC),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorts, err := tt.builder.Build()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := json.Marshal(sorts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Build() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDatabaseClientQueryAll(t *testing.T) {
+	var requests []map[string]interface{}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		requests = append(requests, body)
+		switch body["start_cursor"] {
+		case nil:
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[{"object":"page","id":"page_1"},{"object":"page","id":"page_2"}],"has_more":true,"next_cursor":"cursor_1"}`)
+		case "cursor_1":
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[{"object":"page","id":"page_3"}],"has_more":false,"next_cursor":null}`)
+		}
+		return newJSONResponse(http.StatusBadRequest, `{"object":"error","status":400,"code":"validation_error","message":"invalid cursor"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tests := []struct {
+		name       string
+		maxResults int
+		want       []notionapi.ObjectID
+		wantSizes  []interface{}
+	}{
+		{
+			name:      "follows the cursors",
+			want:      []notionapi.ObjectID{"page_1", "page_2", "page_3"},
+			wantSizes: []interface{}{nil, nil},
+		},
+		{
+			name:       "stops at max results",
+			maxResults: 2,
+			want:       []notionapi.ObjectID{"page_1", "page_2"},
+			wantSizes:  []interface{}{float64(2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = nil
+			pages, err := client.Database.QueryAll(context.Background(), "some_id", &notionapi.DatabaseQueryRequest{
+				Sorts: []notionapi.SortObject{{Timestamp: notionapi.TimestampCreated, Direction: notionapi.SortOrderASC}},
+			}, tt.maxResults)
+			if err != nil {
+				t.Fatalf("QueryAll() error = %v", err)
+			}
+			var got []notionapi.ObjectID
+			for _, page := range pages {
+				got = append(got, page.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("QueryAll() got = %v, want %v", got, tt.want)
+			}
+			var sizes []interface{}
+			for _, request := range requests {
+				if request["sorts"] == nil {
+					t.Errorf("QueryAll() sent a request without sorts: %v", request)
+				}
+				sizes = append(sizes, request["page_size"])
+			}
+			if !reflect.DeepEqual(sizes, tt.wantSizes) {
+				t.Errorf("QueryAll() sent page sizes %v, want %v", sizes, tt.wantSizes)
+			}
+		})
+	}
+
+	t.Run("iterator error", func(t *testing.T) {
+		it := client.Database.QueryIterator(context.Background(), "some_id", &notionapi.DatabaseQueryRequest{StartCursor: "unknown"})
+		if it.Next() {
+			t.Fatal("Next() got = true, want false")
+		}
+		if it.Err() == nil {
+			t.Error("Err() got = nil, want an error")
+		}
+	})
+}
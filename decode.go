@@ -0,0 +1,342 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// PropertyUnmarshaler is implemented by the types that decode the values of
+// properties themselves with DecodePage.
+type PropertyUnmarshaler interface {
+	UnmarshalProperty(Property) error
+}
+
+var (
+	propertyUnmarshalerType = reflect.TypeOf((*PropertyUnmarshaler)(nil)).Elem()
+	propertyType            = reflect.TypeOf((*Property)(nil)).Elem()
+	timeType                = reflect.TypeOf(time.Time{})
+)
+
+// DecodePage stores the values of the properties of page in the struct v
+// points to, like json.Unmarshal does with objects.
+//
+// The fields are set from the properties named after them, or after the name
+// of their notion tag. The fields tagged with "-" are ignored, and the field
+// tagged with ",id" is set to the ID of the page:
+//
+//	type Task struct {
+//		ID    notionapi.PageID `notion:",id"`
+//		Name  string           `notion:"Name"`
+//		Done  bool             `notion:"Done"`
+//		Due   time.Time        `notion:"Due date"`
+//		Tags  []string         `notion:"Tags"`
+//		Notes string           `notion:"-"`
+//	}
+//
+// The values are converted to the types of the fields:
+//   - strings are set to the value of any property as formatted by
+//     PropertyText.
+//   - numbers are set from number, unique ID, formula and rollup properties.
+//   - booleans are set from checkbox and formula properties.
+//   - time.Time is set to the start of date, formula and rollup properties,
+//     and to the time of created and last edited time properties.
+//   - slices of strings are set to the names of the options of
+//     multi-selects, the IDs of related pages, the names of people, the URLs
+//     of files and the items of rollups. The slices tagged with the people
+//     type, as EncodeProperties encodes them, are set to the IDs of the
+//     people instead.
+//   - fields of the type of the property, or of the Property interface, are
+//     set to it, and the types implementing PropertyUnmarshaler decode it.
+//
+// Pointers are left nil for empty values, and allocated otherwise. The
+// properties missing from page leave their fields unchanged.
+func DecodePage(page *Page, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("DecodePage of %T, not a pointer to a struct", v)
+	}
+	return decodeFields(page, rv.Elem())
+}
+
+// QueryInto runs the query of requestBody on the database with the ID
+// specified, following pagination, and appends the pages to the slice dest
+// points to, decoded with DecodePage. The elements of the slice are structs
+// or pointers to structs.
+func (c *Client) QueryInto(ctx context.Context, id DatabaseID, requestBody *DatabaseQueryRequest, dest interface{}) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("QueryInto of %T, not a pointer to a slice", dest)
+	}
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Ptr
+	if isPtr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("QueryInto of %T, not a slice of structs", dest)
+	}
+
+	it := c.Database.QueryIterator(ctx, id, requestBody)
+	for it.Next() {
+		elem := reflect.New(elemType)
+		if err := decodeFields(it.Page(), elem.Elem()); err != nil {
+			return fmt.Errorf("page %s: %w", it.Page().ID, err)
+		}
+		if !isPtr {
+			elem = elem.Elem()
+		}
+		slice.Set(reflect.Append(slice, elem))
+	}
+	return it.Err()
+}
+
+// structField is a field of a struct mapped to a property.
+type structField struct {
+	index []int
+	name  string
+	// id is true for the field of the ID of the page.
+	id bool
+	// omitEmpty is true for the fields whose zero values are not encoded.
+	omitEmpty bool
+	// propertyType is the type of the property the field is encoded as,
+	// empty for the default type of the field.
+	propertyType PropertyType
+}
+
+// structFields returns the fields of t mapped to properties, with the fields
+// of its embedded structs.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup("notion")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && !hasTag && f.Type.Kind() == reflect.Struct {
+			for _, embedded := range structFields(f.Type) {
+				embedded.index = append([]int{i}, embedded.index...)
+				fields = append(fields, embedded)
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			// unexported
+			continue
+		}
+		field := structField{index: []int{i}, name: f.Name}
+		parts := strings.Split(tag, ",")
+		if parts[0] != "" {
+			field.name = parts[0]
+		}
+		for _, option := range parts[1:] {
+			switch option {
+			case "id":
+				field.id = true
+			case "omitempty":
+				field.omitEmpty = true
+			default:
+				field.propertyType = PropertyType(option)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func decodeFields(page *Page, v reflect.Value) error {
+	for _, field := range structFields(v.Type()) {
+		fv := v.FieldByIndex(field.index)
+		if field.id {
+			if fv.Kind() != reflect.String {
+				return fmt.Errorf("field %s: the ID of the page cannot be stored in %s", field.name, fv.Type())
+			}
+			fv.SetString(page.ID.String())
+			continue
+		}
+		property, ok := page.Properties[field.name]
+		if !ok || property == nil {
+			continue
+		}
+		property = propertyPointer(property)
+		if people, ok := property.(*PeopleProperty); ok && field.propertyType == PropertyTypePeople &&
+			fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String {
+			// the fields encoded as people by EncodeProperties are the IDs of
+			// the users, rather than their names
+			ids := reflect.MakeSlice(fv.Type(), len(people.People), len(people.People))
+			for i, user := range people.People {
+				ids.Index(i).SetString(user.ID.String())
+			}
+			fv.Set(ids)
+			continue
+		}
+		if err := decodeField(property, fv); err != nil {
+			return fmt.Errorf("property %q: %w", field.name, err)
+		}
+	}
+	return nil
+}
+
+// errCannotDecode is returned when a property cannot be stored in a field.
+var errCannotDecode = errors.New("cannot be stored in")
+
+func decodeField(property Property, v reflect.Value) error {
+	if v.CanAddr() && v.Addr().Type().Implements(propertyUnmarshalerType) {
+		return v.Addr().Interface().(PropertyUnmarshaler).UnmarshalProperty(property)
+	}
+	pv := reflect.ValueOf(property)
+	switch {
+	case v.Type() == propertyType:
+		v.Set(pv)
+		return nil
+	case pv.Type().AssignableTo(v.Type()):
+		v.Set(pv)
+		return nil
+	case pv.Elem().Type().AssignableTo(v.Type()):
+		v.Set(pv.Elem())
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if PropertyText(property) == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		elem := reflect.New(v.Type().Elem())
+		if err := decodeField(property, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.String:
+		v.SetString(PropertyText(property))
+		return nil
+	case reflect.Bool:
+		if checked, ok := propertyBool(property); ok {
+			v.SetBool(checked)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if number, ok := propertyNumber(property); ok {
+			v.SetFloat(number)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if number, ok := propertyNumber(property); ok {
+			v.SetInt(int64(number))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if number, ok := propertyNumber(property); ok && number >= 0 {
+			v.SetUint(uint64(number))
+			return nil
+		}
+	case reflect.Struct:
+		if v.Type() == timeType {
+			if t, ok := propertyTime(property); ok {
+				v.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.String {
+			if items, ok := propertyStrings(property); ok {
+				slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+				for i, item := range items {
+					slice.Index(i).SetString(item)
+				}
+				v.Set(slice)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%s property %w %s", property.GetType(), errCannotDecode, v.Type())
+}
+
+func propertyBool(property Property) (bool, bool) {
+	switch p := property.(type) {
+	case *CheckboxProperty:
+		return p.Checkbox, true
+	case *FormulaProperty:
+		return p.Formula.AsBool()
+	}
+	return false, false
+}
+
+func propertyNumber(property Property) (float64, bool) {
+	switch p := property.(type) {
+	case *NumberProperty:
+		return p.Number, true
+	case *UniqueIDProperty:
+		return float64(p.UniqueID.Number), true
+	case *FormulaProperty:
+		return p.Formula.AsNumber()
+	case *RollupProperty:
+		return p.Rollup.AsNumber()
+	}
+	return 0, false
+}
+
+func propertyTime(property Property) (time.Time, bool) {
+	var (
+		date *DateObject
+		ok   bool
+	)
+	switch p := property.(type) {
+	case *DateProperty:
+		date, ok = p.Date, true
+	case *FormulaProperty:
+		date, ok = p.Formula.AsDate()
+	case *RollupProperty:
+		date, ok = p.Rollup.AsDate()
+	case *CreatedTimeProperty:
+		return p.CreatedTime, true
+	case *LastEditedTimeProperty:
+		return p.LastEditedTime, true
+	}
+	if date == nil || date.Start == nil {
+		return time.Time{}, ok
+	}
+	return time.Time(*date.Start), true
+}
+
+func propertyStrings(property Property) ([]string, bool) {
+	var items []string
+	switch p := property.(type) {
+	case *MultiSelectProperty:
+		for _, option := range p.MultiSelect {
+			items = append(items, option.Name)
+		}
+	case *RelationProperty:
+		for _, relation := range p.Relation {
+			items = append(items, relation.ID.String())
+		}
+	case *PeopleProperty:
+		for i := range p.People {
+			items = append(items, userText(&p.People[i]))
+		}
+	case *FilesProperty:
+		for _, file := range p.Files {
+			items = append(items, file.GetURL())
+		}
+	case *RollupProperty:
+		array, ok := p.Rollup.AsArray()
+		if !ok {
+			return nil, false
+		}
+		for _, item := range array {
+			if text := PropertyText(item); text != "" {
+				items = append(items, text)
+			}
+		}
+	default:
+		return nil, false
+	}
+	return items, true
+}
@@ -0,0 +1,152 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+const decodeTestPage = `{"object":"page","id":"page_1","properties":{
+	"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Buy milk"}, "plain_text": "Buy milk"}]},
+	"Done": {"id": "a", "type": "checkbox", "checkbox": true},
+	"Estimate": {"id": "b", "type": "number", "number": 2.5},
+	"Due date": {"id": "c", "type": "date", "date": {"start": "2024-05-01T10:00:00Z"}},
+	"Tags": {"id": "d", "type": "multi_select", "multi_select": [{"name": "home"}, {"name": "urgent"}]},
+	"Priority": {"id": "e", "type": "select", "select": {"name": "High"}},
+	"Notes": {"id": "f", "type": "rich_text", "rich_text": []},
+	"Ticket": {"id": "g", "type": "unique_id", "unique_id": {"prefix": "TASK", "number": 42}}
+}}`
+
+type priority int
+
+func (p *priority) UnmarshalProperty(property notionapi.Property) error {
+	switch notionapi.PropertyText(property) {
+	case "High":
+		*p = 2
+	case "Low":
+		*p = 1
+	}
+	return nil
+}
+
+type decodedTask struct {
+	ID       notionapi.PageID `notion:",id"`
+	Name     string
+	Done     bool
+	Estimate float64
+	Due      time.Time `notion:"Due date"`
+	Tags     []string
+	Priority priority
+	Ticket   int
+	Notes    *string
+	Missing  string
+	Ignored  string `notion:"-"`
+}
+
+func TestDecodePage(t *testing.T) {
+	var page notionapi.Page
+	if err := json.Unmarshal([]byte(decodeTestPage), &page); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("struct", func(t *testing.T) {
+		got := decodedTask{Missing: "unchanged", Ignored: "unchanged"}
+		if err := notionapi.DecodePage(&page, &got); err != nil {
+			t.Fatal(err)
+		}
+		want := decodedTask{
+			ID:       "page_1",
+			Name:     "Buy milk",
+			Done:     true,
+			Estimate: 2.5,
+			Due:      time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			Tags:     []string{"home", "urgent"},
+			Priority: 2,
+			Ticket:   42,
+			Missing:  "unchanged",
+			Ignored:  "unchanged",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("DecodePage() got = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("property types", func(t *testing.T) {
+		var got struct {
+			Priority *notionapi.SelectProperty
+			Name     notionapi.Property
+			Estimate *float64
+		}
+		if err := notionapi.DecodePage(&page, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Priority == nil || got.Priority.Select.Name != "High" {
+			t.Errorf("DecodePage() Priority = %+v", got.Priority)
+		}
+		if _, ok := got.Name.(*notionapi.TitleProperty); !ok {
+			t.Errorf("DecodePage() Name = %T, want *notionapi.TitleProperty", got.Name)
+		}
+		if got.Estimate == nil || *got.Estimate != 2.5 {
+			t.Errorf("DecodePage() Estimate = %v, want 2.5", got.Estimate)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr string
+	}{
+		{name: "not a pointer", v: decodedTask{}, wantErr: "not a pointer to a struct"},
+		{name: "type mismatch", v: &struct{ Tags bool }{}, wantErr: `property "Tags": multi_select property cannot be stored in bool`},
+		{name: "id in a number", v: &struct {
+			ID int `notion:",id"`
+		}{}, wantErr: "the ID of the page cannot be stored in int"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := notionapi.DecodePage(&page, tt.v)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("DecodePage() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClientQueryInto(t *testing.T) {
+	calls := 0
+	c := newTestClient(func(req *http.Request) *http.Response {
+		calls++
+		if calls == 1 {
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":true,"next_cursor":"cursor","results":[`+decodeTestPage+`]}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+			{"object":"page","id":"page_2","properties":{"Name": {"id": "title", "type": "title", "title": []}}}
+		]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	var tasks []*decodedTask
+	if err := client.QueryInto(context.Background(), "database_id", &notionapi.DatabaseQueryRequest{}, &tasks); err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 2 || tasks[0].Name != "Buy milk" || tasks[1].ID != "page_2" {
+		t.Errorf("QueryInto() got %+v", tasks)
+	}
+
+	var notSlice decodedTask
+	if err := client.QueryInto(context.Background(), "database_id", nil, &notSlice); err == nil {
+		t.Error("QueryInto() into a struct: expected an error")
+	}
+	var strs []string
+	err := client.QueryInto(context.Background(), "database_id", nil, &strs)
+	if err == nil || errors.Unwrap(err) != nil {
+		t.Errorf("QueryInto() into []string error = %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package notionapi
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Discussion is a thread of comments sharing a discussion ID, as returned by
+// Client.ListDiscussions.
+type Discussion struct {
+	ID DiscussionID `json:"id"`
+	// Comments are the comments of the discussion, from the first to the
+	// last created.
+	Comments []Comment `json:"comments"`
+}
+
+// CreatedTime returns when the first comment of the discussion was created.
+func (d *Discussion) CreatedTime() time.Time {
+	if len(d.Comments) == 0 {
+		return time.Time{}
+	}
+	return d.Comments[0].CreatedTime
+}
+
+// ListDiscussions lists all the un-resolved comments of a page or block,
+// with CommentService.ListIterator, and groups them into discussions. The
+// discussions are ordered by the creation of their first comment, the oldest
+// first.
+func (c *Client) ListDiscussions(ctx context.Context, id BlockID) ([]Discussion, error) {
+	var (
+		discussions []Discussion
+		index       = map[DiscussionID]int{}
+	)
+	it := c.Comment.ListIterator(ctx, id)
+	for it.Next() {
+		comment := it.Comment()
+		i, ok := index[comment.DiscussionID]
+		if !ok {
+			i = len(discussions)
+			index[comment.DiscussionID] = i
+			discussions = append(discussions, Discussion{ID: comment.DiscussionID})
+		}
+		discussions[i].Comments = append(discussions[i].Comments, *comment)
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range discussions {
+		comments := discussions[i].Comments
+		sort.SliceStable(comments, func(i, j int) bool {
+			return comments[i].CreatedTime.Before(comments[j].CreatedTime)
+		})
+	}
+	sort.SliceStable(discussions, func(i, j int) bool {
+		return discussions[i].CreatedTime().Before(discussions[j].CreatedTime())
+	})
+	return discussions, nil
+}
@@ -0,0 +1,136 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+// DownloadableFile is anything that points at the contents of a file, either
+// hosted by Notion or external. It is implemented by DownloadableFileBlock and
+// PageFile.
+type DownloadableFile interface {
+	GetURL() string
+	GetExpiryTime() *time.Time
+}
+
+// PageFile is a file of a files property of a page.
+type PageFile struct {
+	PageID PageID
+	// Property is the name of the files property holding the file.
+	Property string
+	File     File
+}
+
+// GetURL implements DownloadableFile interface for PageFile
+func (f PageFile) GetURL() string {
+	return f.File.GetURL()
+}
+
+// GetExpiryTime implements DownloadableFile interface for PageFile
+func (f PageFile) GetExpiryTime() *time.Time {
+	return f.File.GetExpiryTime()
+}
+
+// Download fetches the contents of file. The caller must close the returned
+// reader.
+//
+// Files hosted by Notion are served from signed URLs that expire after an
+// hour. When the URL of a block or page file has expired, or is rejected as
+// such, the block or page is retrieved again to get a fresh URL. Page files
+// without a PageID cannot be refreshed.
+func (c *Client) Download(ctx context.Context, file DownloadableFile) (io.ReadCloser, error) {
+	if expiry := file.GetExpiryTime(); expiry != nil && !expiry.After(time.Now()) && refreshable(file) {
+		refreshed, err := c.refreshDownloadableFile(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		file = refreshed
+	}
+
+	res, err := c.download(ctx, file.GetURL())
+	if err != nil {
+		return nil, err
+	}
+	// Expired signatures are rejected with 400 or 403 depending on the storage.
+	if (res.StatusCode == http.StatusForbidden || res.StatusCode == http.StatusBadRequest) && refreshable(file) {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+		refreshed, err := c.refreshDownloadableFile(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		if res, err = c.download(ctx, refreshed.GetURL()); err != nil {
+			return nil, err
+		}
+	}
+	if res.StatusCode != http.StatusOK {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+		return nil, fmt.Errorf("download: unexpected status code: %d", res.StatusCode)
+	}
+	return res.Body, nil
+}
+
+// download requests url without the Notion headers, which signed URLs and
+// external hosts do not expect.
+func (c *Client) download(ctx context.Context, url string) (*http.Response, error) {
+	if url == "" {
+		return nil, fmt.Errorf("download: file has no url")
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.httpClient.Do(req.WithContext(ctx))
+}
+
+// refreshable reports whether file knows the block or page to retrieve again
+// for a fresh URL.
+func refreshable(file DownloadableFile) bool {
+	switch f := file.(type) {
+	case DownloadableFileBlock:
+		return f.GetID() != ""
+	case PageFile:
+		return f.PageID != ""
+	}
+	return false
+}
+
+// refreshDownloadableFile retrieves again the block or page file points at, to
+// get a fresh URL.
+func (c *Client) refreshDownloadableFile(ctx context.Context, file DownloadableFile) (DownloadableFile, error) {
+	switch f := file.(type) {
+	case DownloadableFileBlock:
+		block, err := c.Block.Get(ctx, f.GetID())
+		if err != nil {
+			return nil, err
+		}
+		refreshed, ok := block.(DownloadableFileBlock)
+		if !ok {
+			return nil, fmt.Errorf("download: block %s of type %s has no file", f.GetID(), block.GetType())
+		}
+		return refreshed, nil
+	case PageFile:
+		page, err := c.Page.Get(ctx, f.PageID)
+		if err != nil {
+			return nil, err
+		}
+		property, ok := page.Properties[f.Property].(*FilesProperty)
+		if !ok {
+			return nil, fmt.Errorf("download: page %s has no files property %q", f.PageID, f.Property)
+		}
+		for _, refreshed := range property.Files {
+			if refreshed.Name == f.File.Name {
+				return PageFile{PageID: f.PageID, Property: f.Property, File: refreshed}, nil
+			}
+		}
+		return nil, fmt.Errorf("download: page %s has no file %q in property %q", f.PageID, f.File.Name, f.Property)
+	}
+	return nil, fmt.Errorf("download: url of %T has expired and cannot be refreshed", file)
+}
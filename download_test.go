@@ -0,0 +1,116 @@
+package notionapi_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientDownload(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name string
+		file notionapi.DownloadableFile
+		// responses by URL
+		responses map[string]*http.Response
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "downloads external files",
+			file: notionapi.PageFile{File: notionapi.File{
+				Name:     "a.txt",
+				External: &notionapi.FileObject{URL: "https://example.com/a.txt"},
+			}},
+			responses: map[string]*http.Response{
+				"https://example.com/a.txt": newJSONResponse(http.StatusOK, "external"),
+			},
+			want: "external",
+		},
+		{
+			name: "refreshes expired block urls",
+			file: &notionapi.ImageBlock{
+				BasicBlock: notionapi.BasicBlock{ID: "some_id", Type: notionapi.BlockTypeImage},
+				Image: notionapi.Image{
+					Type: notionapi.FileTypeFile,
+					File: &notionapi.FileObject{URL: "https://files.example.com/old.png", ExpiryTime: &expired},
+				},
+			},
+			responses: map[string]*http.Response{
+				"https://api.notion.com/v1/blocks/some_id": newJSONResponse(http.StatusOK, `{"object":"block","id":"some_id","type":"image",
+					"image":{"type":"file","file":{"url":"https://files.example.com/new.png"}}}`),
+				"https://files.example.com/new.png": newJSONResponse(http.StatusOK, "fresh"),
+			},
+			want: "fresh",
+		},
+		{
+			name: "refreshes page file urls rejected as expired",
+			file: notionapi.PageFile{PageID: "some_page", Property: "Files", File: notionapi.File{
+				Name: "a.pdf",
+				File: &notionapi.FileObject{URL: "https://files.example.com/old.pdf"},
+			}},
+			responses: map[string]*http.Response{
+				"https://files.example.com/old.pdf": newJSONResponse(http.StatusForbidden, "AccessDenied"),
+				"https://api.notion.com/v1/pages/some_page": newJSONResponse(http.StatusOK, `{"object":"page","id":"some_page","properties":{
+					"Files":{"id":"abc","type":"files","files":[{"name":"a.pdf","type":"file","file":{"url":"https://files.example.com/new.pdf"}}]}}}`),
+				"https://files.example.com/new.pdf": newJSONResponse(http.StatusOK, "fresh"),
+			},
+			want: "fresh",
+		},
+		{
+			name: "does not refresh page files without page",
+			file: notionapi.PageFile{Property: "Files", File: notionapi.File{
+				Name:     "a.txt",
+				External: &notionapi.FileObject{URL: "https://example.com/private.txt"},
+			}},
+			responses: map[string]*http.Response{
+				"https://example.com/private.txt": newJSONResponse(http.StatusForbidden, "AccessDenied"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error for missing files",
+			file: notionapi.PageFile{File: notionapi.File{
+				External: &notionapi.FileObject{URL: "https://example.com/missing.txt"},
+			}},
+			responses: map[string]*http.Response{
+				"https://example.com/missing.txt": newJSONResponse(http.StatusNotFound, "not found"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(func(req *http.Request) *http.Response {
+				res, ok := tt.responses[req.URL.String()]
+				if !ok {
+					t.Fatalf("unexpected request to %s", req.URL)
+				}
+				return res
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			body, err := client.Download(context.Background(), tt.file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Download() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer body.Close()
+			got, err := ioutil.ReadAll(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Download() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
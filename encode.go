@@ -0,0 +1,216 @@
+package notionapi
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// PropertyMarshaler is implemented by the types that encode themselves as the
+// values of properties with EncodeProperties.
+type PropertyMarshaler interface {
+	MarshalProperty() (Property, error)
+}
+
+var propertyMarshalerType = reflect.TypeOf((*PropertyMarshaler)(nil)).Elem()
+
+// EncodeProperties returns the properties of the struct v, or v points to,
+// for the requests creating and updating pages. It maps the fields to
+// properties with the same tags as DecodePage, and is its inverse for the
+// fields tagged with the types of their properties.
+//
+// The type of the properties is given after the name in the tags, and
+// defaults to rich_text for strings, number for numbers, checkbox for
+// booleans, date for time.Time and multi_select for slices of strings:
+//
+//	type Task struct {
+//		ID       notionapi.PageID `notion:",id"`
+//		Name     string           `notion:"Name,title"`
+//		Status   string           `notion:"Status,status,omitempty"`
+//		Estimate *float64         `notion:"Estimate"`
+//		Due      time.Time        `notion:"Due date"`
+//		Tags     []string         `notion:"Tags"`
+//		Assignee []string         `notion:"Assignee,people"`
+//	}
+//
+// Strings can also be encoded as select, status, url, email and phone_number
+// properties, and slices of strings as relation, people and files properties
+// of the IDs of pages and users and the URLs of files. Zero times clear date
+// properties, and empty strings clear those properties.
+//
+// The fields that are nil pointers, or zero values tagged with "omitempty",
+// are omitted: the properties they map to are left unchanged by updates. The
+// field of the ID of the page is omitted too. The fields of the type of a
+// property are encoded as is, and the types implementing PropertyMarshaler
+// encode themselves.
+func EncodeProperties(v interface{}) (Properties, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("EncodeProperties of %T, not a struct", v)
+	}
+
+	properties := Properties{}
+	for _, field := range structFields(rv.Type()) {
+		if field.id {
+			continue
+		}
+		fv := rv.FieldByIndex(field.index)
+		if field.omitEmpty && fv.IsZero() {
+			continue
+		}
+		property, err := encodeField(fv, field.propertyType)
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", field.name, err)
+		}
+		if property == nil {
+			continue
+		}
+		if readOnlyPropertyTypes[property.GetType()] {
+			return nil, fmt.Errorf("property %q: properties of type %s cannot be set", field.name, property.GetType())
+		}
+		properties[field.name] = property
+	}
+	return properties, nil
+}
+
+// encodeField returns the property of type t, or the default type of the
+// field, of the value v of a field, nil for nil pointers.
+func encodeField(v reflect.Value, t PropertyType) (Property, error) {
+	if v.Type().Implements(propertyMarshalerType) {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil, nil
+		}
+		return v.Interface().(PropertyMarshaler).MarshalProperty()
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(propertyMarshalerType) {
+		return v.Addr().Interface().(PropertyMarshaler).MarshalProperty()
+	}
+	if v.Type().Implements(propertyType) {
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+			return nil, nil
+		}
+		return v.Interface().(Property), nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeField(v.Elem(), t)
+	}
+
+	if t == "" {
+		t = defaultPropertyType(v.Type())
+	}
+	switch t {
+	case PropertyTypeTitle, PropertyTypeRichText, PropertyTypeSelect, PropertyTypeStatus,
+		PropertyTypeURL, PropertyTypeEmail, PropertyTypePhoneNumber:
+		if v.Kind() == reflect.String {
+			return stringProperty(t, v.String()), nil
+		}
+	case PropertyTypeNumber:
+		switch v.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return &NumberProperty{Type: PropertyTypeNumber, Number: v.Float()}, nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return &NumberProperty{Type: PropertyTypeNumber, Number: float64(v.Int())}, nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return &NumberProperty{Type: PropertyTypeNumber, Number: float64(v.Uint())}, nil
+		}
+	case PropertyTypeCheckbox:
+		if v.Kind() == reflect.Bool {
+			return &CheckboxProperty{Type: PropertyTypeCheckbox, Checkbox: v.Bool()}, nil
+		}
+	case PropertyTypeDate:
+		if v.Type() == timeType {
+			property := &DateProperty{Type: PropertyTypeDate}
+			if t := v.Interface().(time.Time); !t.IsZero() {
+				start := Date(t)
+				property.Date = &DateObject{Start: &start}
+			}
+			return property, nil
+		}
+	case PropertyTypeMultiSelect, PropertyTypeRelation, PropertyTypePeople, PropertyTypeFiles:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String {
+			items := make([]string, v.Len())
+			for i := range items {
+				items[i] = v.Index(i).String()
+			}
+			return listProperty(t, items), nil
+		}
+	case "":
+		return nil, fmt.Errorf("%s has no default property type", v.Type())
+	}
+	return nil, fmt.Errorf("%s cannot be encoded as a %s property", v.Type(), t)
+}
+
+// defaultPropertyType returns the type of the properties the values of type t
+// are encoded as by default, "" for none.
+func defaultPropertyType(t reflect.Type) PropertyType {
+	switch t.Kind() {
+	case reflect.String:
+		return PropertyTypeRichText
+	case reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return PropertyTypeNumber
+	case reflect.Bool:
+		return PropertyTypeCheckbox
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.String {
+			return PropertyTypeMultiSelect
+		}
+	}
+	if t == timeType {
+		return PropertyTypeDate
+	}
+	return ""
+}
+
+func stringProperty(t PropertyType, value string) Property {
+	switch t {
+	case PropertyTypeTitle:
+		return &TitleProperty{Type: t, Title: textRichText(value)}
+	case PropertyTypeSelect:
+		return &SelectProperty{Type: t, Select: Option{Name: value}}
+	case PropertyTypeStatus:
+		return &StatusProperty{Type: t, Status: Status{Name: value}}
+	case PropertyTypeURL:
+		return &URLProperty{Type: t, URL: value}
+	case PropertyTypeEmail:
+		return &EmailProperty{Type: t, Email: value}
+	case PropertyTypePhoneNumber:
+		return &PhoneNumberProperty{Type: t, PhoneNumber: value}
+	}
+	return &RichTextProperty{Type: PropertyTypeRichText, RichText: textRichText(value)}
+}
+
+func listProperty(t PropertyType, items []string) Property {
+	switch t {
+	case PropertyTypeRelation:
+		relation := make([]Relation, len(items))
+		for i, item := range items {
+			relation[i] = Relation{ID: PageID(item)}
+		}
+		return &RelationProperty{Type: t, Relation: relation}
+	case PropertyTypePeople:
+		people := make([]User, len(items))
+		for i, item := range items {
+			people[i] = User{Object: ObjectTypeUser, ID: UserID(item)}
+		}
+		return &PeopleProperty{Type: t, People: people}
+	case PropertyTypeFiles:
+		files := make([]File, len(items))
+		for i, item := range items {
+			files[i] = File{Name: urlBase(item), Type: FileTypeExternal, External: &FileObject{URL: item}}
+		}
+		return &FilesProperty{Type: t, Files: files}
+	}
+	options := make([]Option, len(items))
+	for i, item := range items {
+		options[i] = Option{Name: item}
+	}
+	return &MultiSelectProperty{Type: PropertyTypeMultiSelect, MultiSelect: options}
+}
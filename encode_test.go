@@ -0,0 +1,204 @@
+package notionapi_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+type encodedPriority int
+
+func (p encodedPriority) MarshalProperty() (notionapi.Property, error) {
+	name := "Low"
+	if p > 1 {
+		name = "High"
+	}
+	return &notionapi.SelectProperty{Type: notionapi.PropertyTypeSelect, Select: notionapi.Option{Name: name}}, nil
+}
+
+func TestEncodeProperties(t *testing.T) {
+	estimate := 2.5
+	tests := []struct {
+		name    string
+		v       interface{}
+		want    string
+		wantErr string
+	}{
+		{
+			name: "struct",
+			v: &struct {
+				ID       notionapi.PageID `notion:",id"`
+				Name     string           `notion:"Name,title"`
+				Status   string           `notion:"Status,status,omitempty"`
+				Estimate *float64
+				Count    int
+				Done     bool
+				Due      time.Time `notion:"Due date"`
+				Tags     []string
+				Links    []string `notion:"Links,relation"`
+				Priority encodedPriority
+				Notes    *string
+				Ignored  string `notion:"-"`
+			}{
+				ID:       "page_1",
+				Name:     "Buy milk",
+				Estimate: &estimate,
+				Count:    3,
+				Due:      time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+				Tags:     []string{"home"},
+				Links:    []string{"page_2"},
+				Priority: 2,
+				Ignored:  "ignored",
+			},
+			want: `{` +
+				`"Count":{"type":"number","number":3},` +
+				`"Done":{"type":"checkbox","checkbox":false},` +
+				`"Due date":{"type":"date","date":{"start":"2024-05-01T10:00:00Z","end":null}},` +
+				`"Estimate":{"type":"number","number":2.5},` +
+				`"Links":{"type":"relation","relation":[{"id":"page_2"}]},` +
+				`"Name":{"type":"title","title":[{"type":"text","text":{"content":"Buy milk"},"plain_text":"Buy milk"}]},` +
+				`"Priority":{"type":"select","select":{"name":"High"}},` +
+				`"Tags":{"type":"multi_select","multi_select":[{"name":"home"}]}` +
+				`}`,
+		},
+		{
+			name: "zero time clears the date",
+			v:    struct{ Due time.Time }{},
+			want: `{"Due":{"type":"date","date":null}}`,
+		},
+		{
+			name: "property values",
+			v: struct {
+				Status *notionapi.StatusProperty
+				Other  notionapi.Property
+			}{Status: &notionapi.StatusProperty{Type: notionapi.PropertyTypeStatus, Status: notionapi.Status{Name: "Done"}}},
+			want: `{"Status":{"type":"status","status":{"name":"Done"}}}`,
+		},
+		{
+			name:    "not a struct",
+			v:       "value",
+			wantErr: "not a struct",
+		},
+		{
+			name: "type mismatch",
+			v: struct {
+				Done bool `notion:"Done,url"`
+			}{},
+			wantErr: `property "Done": bool cannot be encoded as a url property`,
+		},
+		{
+			name:    "no default type",
+			v:       struct{ Size struct{} }{},
+			wantErr: "struct {} has no default property type",
+		},
+		{
+			name: "read-only property",
+			v: struct {
+				Ticket *notionapi.UniqueIDProperty
+			}{Ticket: &notionapi.UniqueIDProperty{Type: notionapi.PropertyTypeUniqueID}},
+			wantErr: "properties of type unique_id cannot be set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := notionapi.EncodeProperties(tt.v)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("EncodeProperties() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("EncodeProperties() got:\n%s\nwant:\n%s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodePropertiesDecodePage(t *testing.T) {
+	type task struct {
+		Name      string   `notion:"Name,title"`
+		Assignees []string `notion:"Assignees,people"`
+		Reviewers []string `notion:"Reviewers"`
+	}
+	var page notionapi.Page
+	err := json.Unmarshal([]byte(`{"object":"page","id":"page_id","properties":{
+		"Name": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"Review"},"plain_text":"Review"}]},
+		"Assignees": {"id":"a","type":"people","people":[{"object":"user","id":"user_1","name":"Ada"}]},
+		"Reviewers": {"id":"b","type":"people","people":[{"object":"user","id":"user_2","name":"Grace"}]}
+	}}`), &page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded task
+	if err := notionapi.DecodePage(&page, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Assignees[0] != "user_1" || decoded.Reviewers[0] != "Grace" {
+		t.Errorf("DecodePage() = %+v, want the IDs of the people tagged people and the names of the others", decoded)
+	}
+
+	properties, err := notionapi.EncodeProperties(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	people, err := properties.People("Assignees")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(people) != 1 || people[0].ID != "user_1" {
+		t.Errorf("EncodeProperties() people = %v, want user_1", people)
+	}
+}
+
+func TestEncodePropertiesEmptyStrings(t *testing.T) {
+	type contact struct {
+		Name  string `notion:"Name,title"`
+		Stage string `notion:"Stage,select"`
+		State string `notion:"State,status"`
+		Site  string `notion:"Site,url"`
+		Mail  string `notion:"Mail,email"`
+		Phone string `notion:"Phone,phone_number"`
+	}
+	var page notionapi.Page
+	err := json.Unmarshal([]byte(`{"object":"page","id":"page_id","properties":{
+		"Name": {"id":"title","type":"title","title":[{"type":"text","text":{"content":"Ada"},"plain_text":"Ada"}]},
+		"Stage": {"id":"a","type":"select","select":null},
+		"State": {"id":"b","type":"status","status":null},
+		"Site": {"id":"c","type":"url","url":null},
+		"Mail": {"id":"d","type":"email","email":null},
+		"Phone": {"id":"e","type":"phone_number","phone_number":null}
+	}}`), &page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded contact
+	if err := notionapi.DecodePage(&page, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	properties, err := notionapi.EncodeProperties(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(properties, "Name")
+	got, err := json.Marshal(properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Mail":{"type":"email","email":null},"Phone":{"type":"phone_number","phone_number":null},"Site":{"type":"url","url":null},"Stage":{"type":"select","select":null},"State":{"type":"status","status":null}}`
+	if string(got) != want {
+		t.Errorf("EncodeProperties() = %s, want %s", got, want)
+	}
+}
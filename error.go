@@ -1,18 +1,39 @@
 package notionapi
 
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
 type ErrorCode string
 
+func (ec ErrorCode) String() string {
+	return string(ec)
+}
+
+// Error is the error object returned by the Notion API.
+//
+// See https://developers.notion.com/reference/status-codes
 type Error struct {
 	Object  ObjectType `json:"object"`
 	Status  int        `json:"status"`
 	Code    ErrorCode  `json:"code"`
 	Message string     `json:"message"`
+	// RequestID identifies the request when contacting Notion support.
+	RequestID string `json:"request_id,omitempty"`
 }
 
 func (e *Error) Error() string {
 	return e.Message
 }
 
+// IsErrorCode reports whether err is, or wraps, a Notion API error with the given code.
+func IsErrorCode(err error, code ErrorCode) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
 type RateLimitedError struct {
 	Message string
 }
@@ -21,6 +42,54 @@ func (e *RateLimitedError) Error() string {
 	return e.Message
 }
 
+// FileUploadLimitError is returned when a file upload would exceed one of the
+// limits documented by Notion. It is detected before any request is made.
+type FileUploadLimitError struct {
+	Message string
+}
+
+func (e *FileUploadLimitError) Error() string {
+	return e.Message
+}
+
+// BatchError is returned by the operations on many objects that failed for
+// some of them. The operation succeeded for the objects not in Errors.
+type BatchError struct {
+	// Errors maps the IDs of the objects the operation failed for to the
+	// error.
+	Errors map[string]error
+	// Total is the number of objects of the operation.
+	Total int
+}
+
+func (e *BatchError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	if len(ids) == 0 {
+		return fmt.Sprintf("failed for 0 of %d objects", e.Total)
+	}
+	return fmt.Sprintf("failed for %d of %d objects, %s: %v", len(ids), e.Total, ids[0], e.Errors[ids[0]])
+}
+
+// UnsupportedEndpointError is returned when the version of the API the client
+// uses does not support the endpoint of a request. See WithVersion.
+type UnsupportedEndpointError struct {
+	Endpoint string
+	Version  string
+	Err      error
+}
+
+func (e *UnsupportedEndpointError) Error() string {
+	return fmt.Sprintf("endpoint %s is not supported with Notion-Version %s: %v", e.Endpoint, e.Version, e.Err)
+}
+
+func (e *UnsupportedEndpointError) Unwrap() error {
+	return e.Err
+}
+
 type TokenCreateError struct {
 	Code    ErrorCode `json:"error"`
 	Message string    `json:"error_description"`
@@ -29,3 +98,39 @@ type TokenCreateError struct {
 func (e *TokenCreateError) Error() string {
 	return e.Message
 }
+
+// ValidationError is returned by ValidateBlocks and ValidateFilter when blocks
+// or filters exceed the limits documented by Notion.
+type ValidationError struct {
+	Violations []LimitViolation
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Violations) == 1 {
+		return e.Violations[0].String()
+	}
+	return fmt.Sprintf("%s, and %d more violations", e.Violations[0], len(e.Violations)-1)
+}
+
+// BlockNotCreatableError is returned when blocks of a type the API only
+// returns, such as link_preview, are sent to be created. It is detected before
+// any request is made.
+type BlockNotCreatableError struct {
+	Type BlockType
+}
+
+func (e *BlockNotCreatableError) Error() string {
+	return fmt.Sprintf("blocks of type %s cannot be created through the API", e.Type)
+}
+
+// PropertyTypeError is returned by the accessors of Properties when the
+// property is not of the type of the accessor.
+type PropertyTypeError struct {
+	Name string
+	Type PropertyType
+	Want PropertyType
+}
+
+func (e *PropertyTypeError) Error() string {
+	return fmt.Sprintf("property %q is of type %s, not %s", e.Name, e.Type, e.Want)
+}
@@ -3,14 +3,23 @@ package notionapi
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -33,6 +42,21 @@ const (
 	FileUploadModeExternalURL FileUploadMode = "external_url"
 )
 
+// Limits of file uploads documented by Notion.
+// See https://developers.notion.com/docs/working-with-files-and-media#supported-file-types
+const (
+	// FileUploadMaxSinglePartSize is the largest file that can be sent with single_part mode.
+	FileUploadMaxSinglePartSize = 20 * 1024 * 1024
+	// FileUploadMinPartSize is the smallest part of a multi_part upload, except for the last one.
+	FileUploadMinPartSize = 5 * 1024 * 1024
+	// FileUploadMaxPartSize is the largest part of a multi_part upload.
+	FileUploadMaxPartSize = 20 * 1024 * 1024
+	// FileUploadMaxParts is the largest number of parts of a multi_part upload.
+	FileUploadMaxParts = 1000
+	// FileUploadDefaultPartSize is the part size recommended by Notion.
+	FileUploadDefaultPartSize = 10 * 1024 * 1024
+)
+
 // FileUploadStatus defines the current state of a file upload.
 type FileUploadStatus string
 
@@ -63,11 +87,89 @@ type FileUploadService interface {
 	// fileName is the name of the file being uploaded (e.g., "image.png").
 	// partNumber is required and indicates the current part number when mode is multi_part. Should be >= 1.
 	Send(ctx context.Context, id FileUploadID, file io.Reader, fileName string, partNumber *int) error
+	// SendWithRecreate behaves like Send, but when the file upload has expired
+	// it creates a new one from request and sends file to it instead.
+	// It returns the ID the file contents were eventually sent to.
+	SendWithRecreate(ctx context.Context, id FileUploadID, request *FileUploadCreateRequest, file io.ReadSeeker, fileName string, partNumber *int) (FileUploadID, error)
+	// Get retrieves a file upload object, e.g. to check its status.
+	Get(ctx context.Context, id FileUploadID) (*FileUpload, error)
+	// UploadFile uploads a local file, splitting it in parts when needed.
+	UploadFile(ctx context.Context, filePath string, opts *UploadFileOptions) (*FileUploadResult, error)
+	// Complete finalizes a multi_part file upload once all parts have been sent.
+	Complete(ctx context.Context, id FileUploadID) (*FileUpload, error)
+	// WaitUntilUploaded polls a file upload until it is uploaded, failed or expired.
+	WaitUntilUploaded(ctx context.Context, id FileUploadID, opts *WaitUntilUploadedOptions) (*FileUpload, error)
 }
 
 // FileUploadClient implements FileUploadService.
 type FileUploadClient struct {
-	apiClient *Client // Assumes Client has a method like `request` that can handle different body types and content types.
+	apiClient *Client
+
+	// pending holds what the API returned about the pending file uploads, so
+	// that their upload_url, complete_url and content_type are used by Send
+	// and Complete instead of being guessed.
+	mu      sync.Mutex
+	pending map[FileUploadID]pendingFileUpload
+}
+
+type pendingFileUpload struct {
+	uploadURL   string
+	completeURL string
+	contentType string
+}
+
+// remember records a pending file upload, or forgets it once it is no longer pending.
+func (fuc *FileUploadClient) remember(upload *FileUpload) {
+	fuc.mu.Lock()
+	defer fuc.mu.Unlock()
+	if upload.Status != FileUploadStatusPending {
+		delete(fuc.pending, upload.ID)
+		return
+	}
+	if fuc.pending == nil {
+		fuc.pending = make(map[FileUploadID]pendingFileUpload)
+	}
+	fuc.pending[upload.ID] = pendingFileUpload{
+		uploadURL:   upload.UploadURL,
+		completeURL: upload.CompleteURL,
+		contentType: upload.ContentType,
+	}
+}
+
+// uploadURL returns the upload_url of the file upload if it is known.
+func (fuc *FileUploadClient) uploadURL(id FileUploadID) string {
+	fuc.mu.Lock()
+	defer fuc.mu.Unlock()
+	if u := fuc.pending[id].uploadURL; u != "" {
+		return u
+	}
+	return fmt.Sprintf("file_uploads/%s/send", pathID(id.String()))
+}
+
+// completeURL returns the complete_url of the file upload if it is known.
+func (fuc *FileUploadClient) completeURL(id FileUploadID) string {
+	fuc.mu.Lock()
+	defer fuc.mu.Unlock()
+	if u := fuc.pending[id].completeURL; u != "" {
+		return u
+	}
+	return fmt.Sprintf("file_uploads/%s/complete", pathID(id.String()))
+}
+
+// partContentType returns the content type to send the contents of the file
+// upload with: its content_type if it is known, otherwise the one of the
+// extension of fileName.
+func (fuc *FileUploadClient) partContentType(id FileUploadID, fileName string) string {
+	fuc.mu.Lock()
+	contentType := fuc.pending[id].contentType
+	fuc.mu.Unlock()
+	if contentType != "" {
+		return contentType
+	}
+	if contentType = mime.TypeByExtension(filepath.Ext(fileName)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
 }
 
 // FileUploadCreateRequest represents the request body for FileUploadClient.Create.
@@ -86,14 +188,53 @@ type FileUploadCreateRequest struct {
 	ExternalURL string `json:"external_url,omitempty"`
 }
 
+// Validate checks the request against the limits documented by Notion, so that
+// invalid requests fail without making an API call.
+func (r *FileUploadCreateRequest) Validate() error {
+	if r == nil {
+		return errors.New("nil request")
+	}
+	switch r.Mode {
+	case "", FileUploadModeSinglePart:
+	case FileUploadModeMultiPart:
+		if r.Filename == "" {
+			return &FileUploadLimitError{Message: "filename is required when mode is multi_part"}
+		}
+		if r.NumberOfParts == nil || *r.NumberOfParts < 1 || *r.NumberOfParts > FileUploadMaxParts {
+			return &FileUploadLimitError{Message: fmt.Sprintf("number_of_parts must be between 1 and %d when mode is multi_part", FileUploadMaxParts)}
+		}
+	case FileUploadModeExternalURL:
+		if r.Filename == "" {
+			return &FileUploadLimitError{Message: "filename is required when mode is external_url"}
+		}
+		if !strings.HasPrefix(r.ExternalURL, "https://") {
+			return &FileUploadLimitError{Message: "external_url must be an HTTPS URL when mode is external_url"}
+		}
+	default:
+		return &FileUploadLimitError{Message: fmt.Sprintf("unsupported file upload mode %q", r.Mode)}
+	}
+	if r.Mode != FileUploadModeMultiPart && r.NumberOfParts != nil {
+		return &FileUploadLimitError{Message: "number_of_parts can only be set when mode is multi_part"}
+	}
+	if r.Mode != FileUploadModeExternalURL && r.ExternalURL != "" {
+		return &FileUploadLimitError{Message: "external_url can only be set when mode is external_url"}
+	}
+	return nil
+}
+
 // Create initiates the process of uploading a file to Notion.
 // It returns a FileUpload object with a status of "pending" and an upload_url.
+// The upload_url and complete_url are used by Send and Complete afterwards.
+//
+// Like every method of FileUploadClient, API failures are returned as *Error,
+// possibly wrapped, so their code can be checked with IsErrorCode.
 // See https://developers.notion.com/reference/create-file-upload
 func (fuc *FileUploadClient) Create(ctx context.Context, requestBody *FileUploadCreateRequest) (*FileUpload, error) {
-	// This assumes your apiClient.request method can take a struct, marshal it to JSON,
-	// and set Content-Type to "application/json" by default if the last argument (contentTypeOverride) is empty.
-	// Example call: apiClient.request(ctx, method, path, queryParams, requestStruct, "")
-	res, err := fuc.apiClient.request(ctx, http.MethodPost, "file_uploads", nil, requestBody, "")
+	if err := requestBody.Validate(); err != nil {
+		return nil, fmt.Errorf("FileUploadClient.Create: %w", err)
+	}
+
+	res, err := fuc.apiClient.request(ctx, http.MethodPost, "file_uploads", nil, requestBody, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -104,32 +245,52 @@ func (fuc *FileUploadClient) Create(ctx context.Context, requestBody *FileUpload
 		}
 	}()
 
-	if res.StatusCode != http.StatusOK {
-		// Consider handling specific Notion API error structures here if desired
-		return nil, fmt.Errorf("FileUploadClient.Create: unexpected status code: %d", res.StatusCode)
+	upload, err := handleFileUploadResponse(res)
+	if err != nil {
+		return nil, err
 	}
-
-	return handleFileUploadResponse(res)
+	fuc.remember(upload)
+	return upload, nil
 }
 
+// quoteEscaper escapes file names in Content-Disposition like mime/multipart does.
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
 // Send transmits file contents to Notion for a file upload initiated by Create.
 // For this endpoint, Content-Type must be multipart/form-data.
 // file is an io.Reader providing the content of the file (or part of the file).
 // fileName is the name that will be associated with the file in the form data.
+// The part is sent with the content_type given to Create, or else the content
+// type of the extension of fileName.
 // partNumber is required if the upload was created with mode=multi_part, it specifies the chunk number.
+// Parts larger than FileUploadMaxPartSize, or than the size set with
+// WithMaxFileUploadSize, are rejected with a *FileUploadLimitError before being sent.
 // See https://developers.notion.com/reference/send-file-upload
 func (fuc *FileUploadClient) Send(ctx context.Context, id FileUploadID, file io.Reader, fileName string, partNumber *int) error {
+	if partNumber != nil && (*partNumber < 1 || *partNumber > FileUploadMaxParts) {
+		return fmt.Errorf("FileUploadClient.Send: %w", &FileUploadLimitError{Message: fmt.Sprintf("part number %d is not between 1 and %d", *partNumber, FileUploadMaxParts)})
+	}
+
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
-	// Add file part
-	formFile, err := writer.CreateFormFile("file", fileName)
+	// Add file part. Unlike CreateFormFile, the part carries the content type
+	// of the file so that Notion can validate images, PDFs, etc.
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(fileName)))
+	header.Set("Content-Type", fuc.partContentType(id, fileName))
+	formFile, err := writer.CreatePart(header)
 	if err != nil {
 		return fmt.Errorf("FileUploadClient.Send: failed to create form file: %w", err)
 	}
-	if _, err = io.Copy(formFile, file); err != nil {
+	// Reading one byte more than allowed is enough to tell the part is too large.
+	size, err := io.Copy(formFile, io.LimitReader(file, FileUploadMaxPartSize+1))
+	if err != nil {
 		return fmt.Errorf("FileUploadClient.Send: failed to copy file to form: %w", err)
 	}
+	if err = fuc.checkSize(size, FileUploadMaxPartSize); err != nil {
+		return fmt.Errorf("FileUploadClient.Send: %w", err)
+	}
 
 	// Add part_number field if provided (required for multi_part)
 	if partNumber != nil {
@@ -142,9 +303,10 @@ func (fuc *FileUploadClient) Send(ctx context.Context, id FileUploadID, file io.
 		return fmt.Errorf("FileUploadClient.Send: failed to close multipart writer: %w", err)
 	}
 
-	uploadURL := fmt.Sprintf("file_uploads/%s/send", id.String())
+	uploadURL := fuc.uploadURL(id)
 
-	res, err := fuc.apiClient.request(ctx, http.MethodPost, uploadURL, nil, body, ContentTypeFormData)
+	// The boundary is part of the content type, so it has to come from the writer.
+	res, err := fuc.apiClient.request(ctx, http.MethodPost, uploadURL, nil, body, ContentType(writer.FormDataContentType()))
 	if err != nil {
 		return fmt.Errorf("FileUploadClient.Send: request failed: %w", err)
 	}
@@ -155,19 +317,157 @@ func (fuc *FileUploadClient) Send(ctx context.Context, id FileUploadID, file io.
 		}
 	}()
 
-	if res.StatusCode != http.StatusOK {
-		// Handle error response, potentially by reading the body for a Notion error object
-		// For now, just returning a generic error
-		// bodyBytes, _ := io.ReadAll(res.Body) // Example: read error body
-		return fmt.Errorf("FileUploadClient.Send: unexpected status code: %d", res.StatusCode)
+	// The response is the updated file upload, which is uploaded for single
+	// part uploads and still pending for multi part ones.
+	if upload, err := handleFileUploadResponse(res); err == nil {
+		fuc.remember(upload)
 	}
-
-	// The "Send a file upload" endpoint documentation doesn't specify a JSON response body.
-	// It's used to transmit data. Success is indicated by HTTP 200 OK.
-	// One would typically then retrieve the FileUpload object again to check its status.
 	return nil
 }
 
+// SendWithRecreate transmits file contents like Send. If sending fails because
+// the file upload has expired, a new file upload is created with the same
+// request parameters and the contents are sent to it instead.
+//
+// request must hold the parameters id was originally created with. The
+// returned ID is the one the contents were sent to; callers should use it in
+// place of id from then on. file is rewound before it is sent again.
+//
+// Recreating a multi_part upload discards the parts already sent, so for those
+// it is only useful when sending the first part.
+func (fuc *FileUploadClient) SendWithRecreate(ctx context.Context, id FileUploadID, request *FileUploadCreateRequest, file io.ReadSeeker, fileName string, partNumber *int) (FileUploadID, error) {
+	err := fuc.Send(ctx, id, file, fileName, partNumber)
+	if err == nil {
+		return id, nil
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return id, err
+	}
+	upload, getErr := fuc.Get(ctx, id)
+	if getErr != nil || upload.Status != FileUploadStatusExpired {
+		return id, err
+	}
+
+	upload, err = fuc.Create(ctx, request)
+	if err != nil {
+		return id, fmt.Errorf("FileUploadClient.SendWithRecreate: failed to recreate expired file upload %s: %w", id, err)
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return id, fmt.Errorf("FileUploadClient.SendWithRecreate: failed to rewind file: %w", err)
+	}
+	if err = fuc.Send(ctx, upload.ID, file, fileName, partNumber); err != nil {
+		return upload.ID, err
+	}
+	return upload.ID, nil
+}
+
+// Complete finalizes a multi_part file upload after all of its parts have been
+// sent, using the complete_url returned by Create when it is known.
+//
+// See https://developers.notion.com/reference/complete-a-file-upload
+func (fuc *FileUploadClient) Complete(ctx context.Context, id FileUploadID) (*FileUpload, error) {
+	res, err := fuc.apiClient.request(ctx, http.MethodPost, fuc.completeURL(id), nil, nil, ContentTypeJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Printf("FileUploadClient.Complete: failed to close response body: %v", errClose)
+		}
+	}()
+
+	upload, err := handleFileUploadResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	fuc.remember(upload)
+	return upload, nil
+}
+
+// Get retrieves a file upload object using the ID specified.
+//
+// See https://developers.notion.com/reference/retrieve-a-file-upload
+func (fuc *FileUploadClient) Get(ctx context.Context, id FileUploadID) (*FileUpload, error) {
+	res, err := fuc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("file_uploads/%s", pathID(id.String())), nil, nil, ContentTypeJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Printf("FileUploadClient.Get: failed to close response body: %v", errClose)
+		}
+	}()
+
+	upload, err := handleFileUploadResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	fuc.remember(upload)
+	return upload, nil
+}
+
+// WaitUntilUploadedOptions configures the polling of FileUploadClient.WaitUntilUploaded.
+// Zero values are replaced by the defaults.
+type WaitUntilUploadedOptions struct {
+	// Delay before the first retry. Defaults to 500ms.
+	InitialInterval time.Duration
+	// Upper bound of the delay between two retries. Defaults to 10s.
+	MaxInterval time.Duration
+	// Factor the delay is multiplied by after each retry. Defaults to 2.
+	Multiplier float64
+}
+
+// WaitUntilUploaded retrieves the file upload until its status is no longer
+// pending, waiting exponentially longer between retries. It is typically used
+// after an external_url import or after completing a multi_part upload.
+//
+// The file upload is returned along with an error if it ended up failed or
+// expired. Polling stops with the context error when ctx is done.
+func (fuc *FileUploadClient) WaitUntilUploaded(ctx context.Context, id FileUploadID, opts *WaitUntilUploadedOptions) (*FileUpload, error) {
+	interval, maxInterval, multiplier := 500*time.Millisecond, 10*time.Second, 2.0
+	if opts != nil {
+		if opts.InitialInterval > 0 {
+			interval = opts.InitialInterval
+		}
+		if opts.MaxInterval > 0 {
+			maxInterval = opts.MaxInterval
+		}
+		if opts.Multiplier >= 1 {
+			multiplier = opts.Multiplier
+		}
+	}
+
+	for {
+		upload, err := fuc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch upload.Status {
+		case FileUploadStatusUploaded:
+			return upload, nil
+		case FileUploadStatusFailed, FileUploadStatusExpired:
+			if upload.FileImportResult != "" {
+				return upload, fmt.Errorf("FileUploadClient.WaitUntilUploaded: file upload %s is %s: %s", id, upload.Status, upload.FileImportResult)
+			}
+			return upload, fmt.Errorf("FileUploadClient.WaitUntilUploaded: file upload %s is %s", id, upload.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return upload, ctx.Err()
+		case <-time.After(interval):
+		}
+		if interval = time.Duration(float64(interval) * multiplier); interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
 // FileUpload represents the Notion File Upload object.
 // See https://developers.notion.com/reference/file-upload-object
 type FileUpload struct {
@@ -197,6 +497,18 @@ type FileUpload struct {
 	FileImportResult string `json:"file_import_result,omitempty"`
 }
 
+// MarshalJSON sends a FileUpload holding only its ID, as used to reference an
+// upload in blocks, as the ID alone: the API rejects the other fields there.
+func (fu FileUpload) MarshalJSON() ([]byte, error) {
+	if (fu == FileUpload{ID: fu.ID}) {
+		return json.Marshal(struct {
+			ID FileUploadID `json:"id"`
+		}{fu.ID})
+	}
+	type fileUpload FileUpload
+	return json.Marshal(fileUpload(fu))
+}
+
 func handleFileUploadResponse(res *http.Response) (*FileUpload, error) {
 	var response FileUpload
 	err := json.NewDecoder(res.Body).Decode(&response)
@@ -206,6 +518,244 @@ func handleFileUploadResponse(res *http.Response) (*FileUpload, error) {
 	return &response, nil
 }
 
+// UploadFileOptions configures FileUploadClient.UploadFile.
+type UploadFileOptions struct {
+	// ContentType of the file. Defaults to the content type of its extension.
+	ContentType string
+	// PartSize is the size of the parts of files larger than
+	// FileUploadMaxSinglePartSize. Defaults to FileUploadDefaultPartSize.
+	PartSize int64
+	// VerifyContentLength checks that the content_length Notion reports once
+	// the file is uploaded matches the number of bytes that were sent.
+	VerifyContentLength bool
+	// Cache, if set, deduplicates uploads: a file whose contents were already
+	// uploaded is not sent again as long as that upload is still usable.
+	Cache FileUploadCache
+}
+
+// FileUploadCache remembers file uploads by the SHA-256 of their contents.
+// Implementations must be safe for concurrent use.
+type FileUploadCache interface {
+	Get(sha256 string) (FileUploadID, bool)
+	Set(sha256 string, id FileUploadID)
+	Delete(sha256 string)
+}
+
+// MemoryFileUploadCache is a FileUploadCache held in memory.
+type MemoryFileUploadCache struct {
+	mu  sync.Mutex
+	ids map[string]FileUploadID
+}
+
+// NewMemoryFileUploadCache returns an empty MemoryFileUploadCache.
+func NewMemoryFileUploadCache() *MemoryFileUploadCache {
+	return &MemoryFileUploadCache{ids: make(map[string]FileUploadID)}
+}
+
+func (c *MemoryFileUploadCache) Get(sha256 string) (FileUploadID, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id, ok := c.ids[sha256]
+	return id, ok
+}
+
+func (c *MemoryFileUploadCache) Set(sha256 string, id FileUploadID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ids[sha256] = id
+}
+
+func (c *MemoryFileUploadCache) Delete(sha256 string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.ids, sha256)
+}
+
+// FileUploadResult describes a file uploaded by FileUploadClient.UploadFile.
+type FileUploadResult struct {
+	// FileUpload is the file upload as returned once the contents were sent.
+	FileUpload *FileUpload
+	// Size is the number of bytes sent.
+	Size int64
+	// MD5 and SHA256 are the hex encoded checksums of the whole file.
+	MD5    string
+	SHA256 string
+	// Parts describes each part of a multi_part upload, or the single part.
+	// It is empty when the upload was deduplicated.
+	Parts []FileUploadPart
+	// Deduplicated is true when FileUpload is an earlier upload of the same
+	// contents found in UploadFileOptions.Cache.
+	Deduplicated bool
+}
+
+// FileUploadPart describes a part of a file upload.
+type FileUploadPart struct {
+	Number int
+	Size   int64
+	MD5    string
+	SHA256 string
+}
+
+// UploadFile uploads the file at filePath: it creates a file upload, sends the
+// contents in one or more parts depending on the size of the file, and
+// completes multi_part uploads. Checksums of the file and of each part are
+// computed while the contents are streamed.
+func (fuc *FileUploadClient) UploadFile(ctx context.Context, filePath string, opts *UploadFileOptions) (*FileUploadResult, error) {
+	if opts == nil {
+		opts = &UploadFileOptions{}
+	}
+	partSize := opts.PartSize
+	if partSize == 0 {
+		partSize = FileUploadDefaultPartSize
+	}
+	if partSize < FileUploadMinPartSize || partSize > FileUploadMaxPartSize {
+		return nil, &FileUploadLimitError{Message: fmt.Sprintf("part size must be between %d and %d bytes", FileUploadMinPartSize, FileUploadMaxPartSize)}
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("UploadFile: failed to open file %s: %w", filePath, err)
+	}
+	defer func() {
+		if errClose := file.Close(); errClose != nil {
+			log.Printf("UploadFile: failed to close file %s: %v", filePath, errClose)
+		}
+	}()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("UploadFile: failed to get file info for %s: %w", filePath, err)
+	}
+	if err = fuc.checkWorkspaceSize(info.Size()); err != nil {
+		return nil, fmt.Errorf("UploadFile: %s: %w", filePath, err)
+	}
+
+	if opts.Cache != nil {
+		result, err := fuc.uploadFromCache(ctx, file, opts.Cache)
+		if err != nil || result != nil {
+			return result, err
+		}
+	}
+
+	request := &FileUploadCreateRequest{
+		Filename:    info.Name(),
+		ContentType: opts.ContentType,
+	}
+	if request.ContentType == "" {
+		request.ContentType = mime.TypeByExtension(filepath.Ext(info.Name()))
+	}
+	numberOfParts := 1
+	if info.Size() > FileUploadMaxSinglePartSize {
+		numberOfParts = int((info.Size() + partSize - 1) / partSize)
+		if numberOfParts > FileUploadMaxParts {
+			return nil, &FileUploadLimitError{Message: fmt.Sprintf("UploadFile: %s needs %d parts of %d bytes, more than %d", filePath, numberOfParts, partSize, FileUploadMaxParts)}
+		}
+		n := int32(numberOfParts)
+		request.Mode = FileUploadModeMultiPart
+		request.NumberOfParts = &n
+	}
+
+	upload, err := fuc.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FileUploadResult{}
+	fileMD5, fileSHA256 := md5.New(), sha256.New()
+	for i := 1; i <= numberOfParts; i++ {
+		partMD5, partSHA256 := md5.New(), sha256.New()
+		counter := &countingWriter{}
+		part := io.TeeReader(io.LimitReader(file, partSize), io.MultiWriter(fileMD5, fileSHA256, partMD5, partSHA256, counter))
+
+		var partNumber *int
+		if request.Mode == FileUploadModeMultiPart {
+			n := i
+			partNumber = &n
+		}
+		if err = fuc.Send(ctx, upload.ID, part, info.Name(), partNumber); err != nil {
+			return nil, err
+		}
+		result.Size += counter.n
+		result.Parts = append(result.Parts, FileUploadPart{
+			Number: i,
+			Size:   counter.n,
+			MD5:    hex.EncodeToString(partMD5.Sum(nil)),
+			SHA256: hex.EncodeToString(partSHA256.Sum(nil)),
+		})
+	}
+	result.MD5 = hex.EncodeToString(fileMD5.Sum(nil))
+	result.SHA256 = hex.EncodeToString(fileSHA256.Sum(nil))
+
+	switch {
+	case request.Mode == FileUploadModeMultiPart:
+		upload, err = fuc.Complete(ctx, upload.ID)
+	case opts.VerifyContentLength:
+		upload, err = fuc.Get(ctx, upload.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.FileUpload = upload
+
+	if opts.Cache != nil {
+		opts.Cache.Set(result.SHA256, upload.ID)
+	}
+
+	if opts.VerifyContentLength && (upload.ContentLength == nil || int64(*upload.ContentLength) != result.Size) {
+		got := "unknown"
+		if upload.ContentLength != nil {
+			got = strconv.Itoa(*upload.ContentLength)
+		}
+		return result, fmt.Errorf("UploadFile: %s: sent %d bytes but Notion reports a content length of %s", filePath, result.Size, got)
+	}
+	return result, nil
+}
+
+// uploadFromCache returns the result of an earlier upload of the contents of
+// file if the cache holds one that can still be used, or nil. file is rewound
+// in both cases.
+func (fuc *FileUploadClient) uploadFromCache(ctx context.Context, file io.ReadSeeker, cache FileUploadCache) (*FileUploadResult, error) {
+	fileMD5, fileSHA256 := md5.New(), sha256.New()
+	size, err := io.Copy(io.MultiWriter(fileMD5, fileSHA256), file)
+	if err != nil {
+		return nil, fmt.Errorf("UploadFile: failed to hash file: %w", err)
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("UploadFile: failed to rewind file: %w", err)
+	}
+
+	hash := hex.EncodeToString(fileSHA256.Sum(nil))
+	id, ok := cache.Get(hash)
+	if !ok {
+		return nil, nil
+	}
+	upload, err := fuc.Get(ctx, id)
+	if err != nil && !IsErrorCode(err, ErrorCodeObjectNotFound) {
+		return nil, err
+	}
+	// Uploads are usable once uploaded, until they expire if left unattached.
+	if err != nil || upload.Status != FileUploadStatusUploaded || (upload.ExpiryTime != nil && !upload.ExpiryTime.After(time.Now())) {
+		cache.Delete(hash)
+		return nil, nil
+	}
+	return &FileUploadResult{
+		FileUpload:   upload,
+		Size:         size,
+		MD5:          hex.EncodeToString(fileMD5.Sum(nil)),
+		SHA256:       hash,
+		Deduplicated: true,
+	}, nil
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
 // --- Helper function to use Send with a file path ---
 
 // SendFileByPath is a convenience wrapper around Send for uploading a file from a local path.
@@ -230,5 +780,29 @@ func (fuc *FileUploadClient) SendFileByPath(ctx context.Context, id FileUploadID
 	}
 	fileName := info.Name()
 
+	if partNumber == nil {
+		if err = fuc.checkSize(info.Size(), FileUploadMaxSinglePartSize); err != nil {
+			return fmt.Errorf("SendFileByPath: %s: %w", filePath, err)
+		}
+	}
+
 	return fuc.Send(ctx, id, file, fileName, partNumber)
 }
+
+// checkSize returns a *FileUploadLimitError if size exceeds limit or the
+// workspace limit set with WithMaxFileUploadSize.
+func (fuc *FileUploadClient) checkSize(size int64, limit int64) error {
+	if size > limit {
+		return &FileUploadLimitError{Message: fmt.Sprintf("file exceeds the limit of %d bytes", limit)}
+	}
+	return fuc.checkWorkspaceSize(size)
+}
+
+// checkWorkspaceSize returns a *FileUploadLimitError if size exceeds the
+// workspace limit set with WithMaxFileUploadSize.
+func (fuc *FileUploadClient) checkWorkspaceSize(size int64) error {
+	if max := fuc.apiClient.maxFileUploadSize; max > 0 && size > max {
+		return &FileUploadLimitError{Message: fmt.Sprintf("file of %d bytes exceeds the workspace limit of %d bytes", size, max)}
+	}
+	return nil
+}
@@ -0,0 +1,527 @@
+package notionapi_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func newJSONResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestFileUploadClient(t *testing.T) {
+	t.Run("Send", func(t *testing.T) {
+		var gotContentType, gotBody string
+		c := newTestClient(func(req *http.Request) *http.Response {
+			gotContentType = req.Header.Get("Content-Type")
+			b, _ := ioutil.ReadAll(req.Body)
+			gotBody = string(b)
+			return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"uploaded"}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		err := client.FileUpload.Send(context.Background(), "some_id", strings.NewReader("hello"), "hello.txt", nil)
+		if err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+		if !strings.HasPrefix(gotContentType, "multipart/form-data; boundary=") {
+			t.Errorf("Send() content type = %q, want multipart/form-data with boundary", gotContentType)
+		}
+		if !strings.Contains(gotBody, "hello") || !strings.Contains(gotBody, `filename="hello.txt"`) {
+			t.Errorf("Send() body does not contain the file: %q", gotBody)
+		}
+	})
+
+	t.Run("SendWithRecreate", func(t *testing.T) {
+		var sentTo []string
+		c := newTestClient(func(req *http.Request) *http.Response {
+			switch {
+			case req.Method == http.MethodPost && req.URL.Path == "/v1/file_uploads/expired_id/send":
+				sentTo = append(sentTo, "expired_id")
+				return newJSONResponse(http.StatusBadRequest, `{"object":"error","status":400,"code":"validation_error","message":"File upload is expired."}`)
+			case req.Method == http.MethodGet && req.URL.Path == "/v1/file_uploads/expired_id":
+				return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"expired_id","status":"expired"}`)
+			case req.Method == http.MethodPost && req.URL.Path == "/v1/file_uploads":
+				return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"new_id","status":"pending"}`)
+			case req.Method == http.MethodPost && req.URL.Path == "/v1/file_uploads/new_id/send":
+				b, _ := ioutil.ReadAll(req.Body)
+				if !strings.Contains(string(b), "hello") {
+					t.Errorf("contents were not resent to the new upload: %q", b)
+				}
+				sentTo = append(sentTo, "new_id")
+				return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"new_id","status":"uploaded"}`)
+			}
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			return newJSONResponse(http.StatusNotFound, `{}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		request := &notionapi.FileUploadCreateRequest{Filename: "hello.txt"}
+		id, err := client.FileUpload.SendWithRecreate(context.Background(), "expired_id", request, strings.NewReader("hello"), "hello.txt", nil)
+		if err != nil {
+			t.Fatalf("SendWithRecreate() error = %v", err)
+		}
+		if id != "new_id" {
+			t.Errorf("SendWithRecreate() id = %s, want new_id", id)
+		}
+		if strings.Join(sentTo, ",") != "expired_id,new_id" {
+			t.Errorf("SendWithRecreate() sent to %v", sentTo)
+		}
+	})
+
+	t.Run("SendWithRecreate keeps errors of uploads that are not expired", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			if req.Method == http.MethodGet {
+				return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"pending"}`)
+			}
+			if req.URL.Path == "/v1/file_uploads" {
+				t.Error("file upload must not be recreated")
+			}
+			return newJSONResponse(http.StatusBadRequest, `{"object":"error","status":400,"code":"validation_error","message":"bad"}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		id, err := client.FileUpload.SendWithRecreate(context.Background(), "some_id", &notionapi.FileUploadCreateRequest{}, strings.NewReader("hello"), "hello.txt", nil)
+		if err == nil {
+			t.Fatal("SendWithRecreate() expected an error")
+		}
+		if id != "some_id" {
+			t.Errorf("SendWithRecreate() id = %s, want some_id", id)
+		}
+	})
+}
+
+type countingLimiter struct {
+	calls int
+}
+
+func (l *countingLimiter) Wait(context.Context) error {
+	l.calls++
+	return nil
+}
+
+func TestFileUploadRateLimit(t *testing.T) {
+	attempts := 0
+	c := newTestClient(func(req *http.Request) *http.Response {
+		attempts++
+		b, _ := ioutil.ReadAll(req.Body)
+		if !strings.Contains(string(b), "hello") {
+			t.Errorf("attempt %d was sent without the file contents", attempts)
+		}
+		if attempts == 1 {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": []string{"0"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"uploaded"}`)
+	})
+	limiter := &countingLimiter{}
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c), notionapi.WithRateLimiter(limiter))
+
+	err := client.FileUpload.Send(context.Background(), "some_id", strings.NewReader("hello"), "hello.txt", nil)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Send() attempts = %d, want 2", attempts)
+	}
+	if limiter.calls != 2 {
+		t.Errorf("rate limiter was waited on %d times, want 2", limiter.calls)
+	}
+}
+
+func TestFileUploadValidation(t *testing.T) {
+	parts := func(n int32) *int32 { return &n }
+	tests := []struct {
+		name    string
+		request *notionapi.FileUploadCreateRequest
+		wantErr bool
+	}{
+		{
+			name:    "single part",
+			request: &notionapi.FileUploadCreateRequest{},
+		},
+		{
+			name:    "multi part",
+			request: &notionapi.FileUploadCreateRequest{Mode: notionapi.FileUploadModeMultiPart, Filename: "big.bin", NumberOfParts: parts(3)},
+		},
+		{
+			name:    "multi part without number of parts",
+			request: &notionapi.FileUploadCreateRequest{Mode: notionapi.FileUploadModeMultiPart, Filename: "big.bin"},
+			wantErr: true,
+		},
+		{
+			name:    "multi part with too many parts",
+			request: &notionapi.FileUploadCreateRequest{Mode: notionapi.FileUploadModeMultiPart, Filename: "big.bin", NumberOfParts: parts(1001)},
+			wantErr: true,
+		},
+		{
+			name:    "external url over http",
+			request: &notionapi.FileUploadCreateRequest{Mode: notionapi.FileUploadModeExternalURL, Filename: "a.png", ExternalURL: "http://example.com/a.png"},
+			wantErr: true,
+		},
+		{
+			name:    "external url",
+			request: &notionapi.FileUploadCreateRequest{Mode: notionapi.FileUploadModeExternalURL, Filename: "a.png", ExternalURL: "https://example.com/a.png"},
+		},
+		{
+			name:    "external url with number of parts",
+			request: &notionapi.FileUploadCreateRequest{Mode: notionapi.FileUploadModeExternalURL, Filename: "a.png", ExternalURL: "https://example.com/a.png", NumberOfParts: parts(2)},
+			wantErr: true,
+		},
+		{
+			name:    "single part with number of parts",
+			request: &notionapi.FileUploadCreateRequest{NumberOfParts: parts(1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if tt.wantErr {
+					t.Error("invalid request must not be sent")
+				}
+				return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"pending"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+			_, err := client.FileUpload.Create(context.Background(), tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var limitErr *notionapi.FileUploadLimitError
+			if tt.wantErr && !errors.As(err, &limitErr) {
+				t.Errorf("Create() error = %T, want *notionapi.FileUploadLimitError", err)
+			}
+		})
+	}
+
+	t.Run("Create rejects nil requests", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			t.Error("nil request must not be sent")
+			return newJSONResponse(http.StatusOK, `{}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+		if _, err := client.FileUpload.Create(context.Background(), nil); err == nil {
+			t.Error("Create() error = nil, want an error")
+		}
+	})
+
+	t.Run("Send rejects parts over the workspace limit", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			t.Error("part over the limit must not be sent")
+			return newJSONResponse(http.StatusOK, `{}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c), notionapi.WithMaxFileUploadSize(4))
+		err := client.FileUpload.Send(context.Background(), "some_id", strings.NewReader("hello"), "hello.txt", nil)
+		var limitErr *notionapi.FileUploadLimitError
+		if !errors.As(err, &limitErr) {
+			t.Errorf("Send() error = %v, want *notionapi.FileUploadLimitError", err)
+		}
+	})
+}
+
+func TestFileUploadWaitUntilUploaded(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		wantErr  bool
+	}{
+		{
+			name:     "returns once uploaded",
+			statuses: []string{"pending", "pending", "uploaded"},
+		},
+		{
+			name:     "returns an error when the import failed",
+			statuses: []string{"pending", "failed"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			c := newTestClient(func(req *http.Request) *http.Response {
+				status := tt.statuses[calls]
+				calls++
+				return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"`+status+`"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			opts := &notionapi.WaitUntilUploadedOptions{InitialInterval: time.Millisecond}
+			got, err := client.FileUpload.WaitUntilUploaded(context.Background(), "some_id", opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WaitUntilUploaded() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != len(tt.statuses) {
+				t.Errorf("WaitUntilUploaded() polled %d times, want %d", calls, len(tt.statuses))
+			}
+			if want := tt.statuses[len(tt.statuses)-1]; string(got.Status) != want {
+				t.Errorf("WaitUntilUploaded() status = %s, want %s", got.Status, want)
+			}
+		})
+	}
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"pending"}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		opts := &notionapi.WaitUntilUploadedOptions{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+		_, err := client.FileUpload.WaitUntilUploaded(ctx, "some_id", opts)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("WaitUntilUploaded() error = %v, want %v", err, context.DeadlineExceeded)
+		}
+	})
+}
+
+func TestFileUploadURLs(t *testing.T) {
+	var got []string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		got = append(got, req.Method+" "+req.URL.String()+" "+req.Header.Get("Authorization"))
+		switch req.URL.Path {
+		case "/v1/file_uploads":
+			return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"pending",
+				"upload_url":"https://uploads.example.com/some_id/send","complete_url":"https://uploads.example.com/some_id/complete"}`)
+		case "/some_id/send":
+			return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"pending",
+				"upload_url":"https://uploads.example.com/some_id/send","complete_url":"https://uploads.example.com/some_id/complete"}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"uploaded"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+	ctx := context.Background()
+
+	parts := int32(1)
+	upload, err := client.FileUpload.Create(ctx, &notionapi.FileUploadCreateRequest{
+		Mode:          notionapi.FileUploadModeMultiPart,
+		Filename:      "big.bin",
+		NumberOfParts: &parts,
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	part := 1
+	if err = client.FileUpload.Send(ctx, upload.ID, strings.NewReader("hello"), "big.bin", &part); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if _, err = client.FileUpload.Complete(ctx, upload.ID); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+
+	want := []string{
+		"POST https://api.notion.com/v1/file_uploads Bearer some_token",
+		"POST https://uploads.example.com/some_id/send ",
+		"POST https://uploads.example.com/some_id/complete ",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("requests = %v, want %v", got, want)
+	}
+}
+
+func TestFileUploadErrors(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found",
+			"message":"Could not find file upload with ID: some_id.","request_id":"some_request_id"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	err := client.FileUpload.Send(context.Background(), "some_id", strings.NewReader("hello"), "hello.txt", nil)
+	if !notionapi.IsErrorCode(err, notionapi.ErrorCodeObjectNotFound) {
+		t.Fatalf("Send() error = %v, want %s", err, notionapi.ErrorCodeObjectNotFound)
+	}
+	var apiErr *notionapi.Error
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "some_request_id" || apiErr.Status != http.StatusNotFound {
+		t.Errorf("Send() error = %#v", apiErr)
+	}
+	if notionapi.IsErrorCode(err, notionapi.ErrorCodeValidation) {
+		t.Errorf("Send() error must not be a %s", notionapi.ErrorCodeValidation)
+	}
+}
+
+func TestFileUploadPartContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		fileName    string
+		want        string
+	}{
+		{
+			name:        "uses the content type of the file upload",
+			contentType: "application/pdf",
+			fileName:    "report",
+			want:        "application/pdf",
+		},
+		{
+			name:     "falls back to the extension of the file name",
+			fileName: "image.png",
+			want:     "image/png",
+		},
+		{
+			name:     "falls back to binary data",
+			fileName: "data",
+			want:     "application/octet-stream",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if req.URL.Path == "/v1/file_uploads" {
+					return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"pending","content_type":"`+tt.contentType+`"}`)
+				}
+				_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				part, err := multipart.NewReader(req.Body, params["boundary"]).NextPart()
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = part.Header.Get("Content-Type")
+				return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"uploaded"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+			ctx := context.Background()
+
+			upload, err := client.FileUpload.Create(ctx, &notionapi.FileUploadCreateRequest{ContentType: tt.contentType})
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if err = client.FileUpload.Send(ctx, upload.ID, strings.NewReader("hello"), tt.fileName, nil); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Send() part content type = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileUploadUploadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "notionapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filePath := filepath.Join(dir, "hello.txt")
+	if err = ioutil.WriteFile(filePath, []byte("hello"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		contentLength string
+		wantErr       bool
+	}{
+		{
+			name:          "returns checksums of the file",
+			contentLength: "5",
+		},
+		{
+			name:          "returns an error when the content length does not match",
+			contentLength: "4",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(func(req *http.Request) *http.Response {
+				switch req.Method + " " + req.URL.Path {
+				case "POST /v1/file_uploads":
+					return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"pending","content_type":"text/plain"}`)
+				case "POST /v1/file_uploads/some_id/send":
+					return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"uploaded"}`)
+				}
+				return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"uploaded","content_length":`+tt.contentLength+`}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			got, err := client.FileUpload.UploadFile(context.Background(), filePath, &notionapi.UploadFileOptions{VerifyContentLength: true})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UploadFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Size != 5 || len(got.Parts) != 1 {
+				t.Errorf("UploadFile() size = %d, parts = %d", got.Size, len(got.Parts))
+			}
+			if want := "5d41402abc4b2a76b9719d911017c592"; got.MD5 != want || got.Parts[0].MD5 != want {
+				t.Errorf("UploadFile() md5 = %s, want %s", got.MD5, want)
+			}
+			if want := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"; got.SHA256 != want {
+				t.Errorf("UploadFile() sha256 = %s, want %s", got.SHA256, want)
+			}
+		})
+	}
+}
+
+func TestFileUploadUploadFileDeduplication(t *testing.T) {
+	dir, err := ioutil.TempDir("", "notionapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"a.txt", "b.txt"} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte("hello"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	created := 0
+	status := "uploaded"
+	c := newTestClient(func(req *http.Request) *http.Response {
+		switch req.Method + " " + req.URL.Path {
+		case "POST /v1/file_uploads":
+			created++
+			return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"pending"}`)
+		case "GET /v1/file_uploads/some_id":
+			return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"`+status+`"}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"file_upload","id":"some_id","status":"uploaded"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+	opts := &notionapi.UploadFileOptions{Cache: notionapi.NewMemoryFileUploadCache()}
+	ctx := context.Background()
+
+	if _, err = client.FileUpload.UploadFile(ctx, filepath.Join(dir, "a.txt"), opts); err != nil {
+		t.Fatalf("UploadFile() error = %v", err)
+	}
+	got, err := client.FileUpload.UploadFile(ctx, filepath.Join(dir, "b.txt"), opts)
+	if err != nil {
+		t.Fatalf("UploadFile() error = %v", err)
+	}
+	if !got.Deduplicated || got.FileUpload.ID != "some_id" || created != 1 {
+		t.Errorf("UploadFile() deduplicated = %v, created = %d", got.Deduplicated, created)
+	}
+
+	status = "expired"
+	got, err = client.FileUpload.UploadFile(ctx, filepath.Join(dir, "b.txt"), opts)
+	if err != nil {
+		t.Fatalf("UploadFile() error = %v", err)
+	}
+	if got.Deduplicated || created != 2 {
+		t.Errorf("UploadFile() reused an expired upload")
+	}
+}
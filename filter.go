@@ -2,6 +2,7 @@ package notionapi
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 type FilterOperator string
@@ -34,6 +35,93 @@ func (f OrCompoundFilter) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// MaxFilterNesting is the maximum number of levels of compound filters, the
+// top level included.
+const MaxFilterNesting = 2
+
+// ValidateFilter checks that the compound filters of f are nested no more than
+// MaxFilterNesting levels deep, and returns a *ValidationError locating the
+// ones nested deeper. FlattenFilter removes the levels that are not needed.
+func ValidateFilter(f Filter) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	var violations []LimitViolation
+	validateFilter("filter", value, 0, &violations)
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// validateFilter checks value, a filter, where depth is the number of levels
+// of compound filters above it.
+func validateFilter(path string, value interface{}, depth int, violations *[]LimitViolation) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, operator := range []string{"and", "or"} {
+		filters, ok := object[operator].([]interface{})
+		if !ok {
+			continue
+		}
+		if depth >= MaxFilterNesting {
+			*violations = append(*violations, LimitViolation{
+				Path:    path + "." + operator,
+				Message: fmt.Sprintf("compound filter nested more than %d levels deep", MaxFilterNesting),
+			})
+			continue
+		}
+		for i, filter := range filters {
+			validateFilter(fmt.Sprintf("%s.%s[%d]", path, operator, i), filter, depth+1, violations)
+		}
+	}
+}
+
+// FlattenFilter returns f without the levels of compound filters that do not
+// change its meaning: the and filters directly in and filters, and the or
+// filters directly in or filters, are merged with their parent, and the
+// compound filters of a single filter are replaced with it.
+func FlattenFilter(f Filter) Filter {
+	switch f := f.(type) {
+	case AndCompoundFilter:
+		var flat AndCompoundFilter
+		for _, child := range f {
+			child = FlattenFilter(child)
+			if and, ok := child.(AndCompoundFilter); ok {
+				flat = append(flat, and...)
+			} else {
+				flat = append(flat, child)
+			}
+		}
+		if len(flat) == 1 {
+			return flat[0]
+		}
+		return flat
+	case OrCompoundFilter:
+		var flat OrCompoundFilter
+		for _, child := range f {
+			child = FlattenFilter(child)
+			if or, ok := child.(OrCompoundFilter); ok {
+				flat = append(flat, or...)
+			} else {
+				flat = append(flat, child)
+			}
+		}
+		if len(flat) == 1 {
+			return flat[0]
+		}
+		return flat
+	}
+	return f
+}
+
 type Condition string
 
 type TimestampFilter struct {
@@ -45,20 +133,28 @@ type TimestampFilter struct {
 func (f TimestampFilter) filter() {}
 
 type PropertyFilter struct {
-	Property    string                      `json:"property"`
-	RichText    *TextFilterCondition        `json:"rich_text,omitempty"`
-	Number      *NumberFilterCondition      `json:"number,omitempty"`
-	Checkbox    *CheckboxFilterCondition    `json:"checkbox,omitempty"`
-	Select      *SelectFilterCondition      `json:"select,omitempty"`
-	MultiSelect *MultiSelectFilterCondition `json:"multi_select,omitempty"`
-	Date        *DateFilterCondition        `json:"date,omitempty"`
-	People      *PeopleFilterCondition      `json:"people,omitempty"`
-	Files       *FilesFilterCondition       `json:"files,omitempty"`
-	Relation    *RelationFilterCondition    `json:"relation,omitempty"`
-	Formula     *FormulaFilterCondition     `json:"formula,omitempty"`
-	Rollup      *RollupFilterCondition      `json:"rollup,omitempty"`
-	Status      *StatusFilterCondition      `json:"status,omitempty"`
-	UniqueId    *UniqueIdFilterCondition    `json:"unique_id,omitempty"`
+	Property       string                      `json:"property"`
+	Title          *TextFilterCondition        `json:"title,omitempty"`
+	RichText       *TextFilterCondition        `json:"rich_text,omitempty"`
+	URL            *TextFilterCondition        `json:"url,omitempty"`
+	Email          *TextFilterCondition        `json:"email,omitempty"`
+	PhoneNumber    *TextFilterCondition        `json:"phone_number,omitempty"`
+	CreatedTime    *DateFilterCondition        `json:"created_time,omitempty"`
+	LastEditedTime *DateFilterCondition        `json:"last_edited_time,omitempty"`
+	CreatedBy      *PeopleFilterCondition      `json:"created_by,omitempty"`
+	LastEditedBy   *PeopleFilterCondition      `json:"last_edited_by,omitempty"`
+	Number         *NumberFilterCondition      `json:"number,omitempty"`
+	Checkbox       *CheckboxFilterCondition    `json:"checkbox,omitempty"`
+	Select         *SelectFilterCondition      `json:"select,omitempty"`
+	MultiSelect    *MultiSelectFilterCondition `json:"multi_select,omitempty"`
+	Date           *DateFilterCondition        `json:"date,omitempty"`
+	People         *PeopleFilterCondition      `json:"people,omitempty"`
+	Files          *FilesFilterCondition       `json:"files,omitempty"`
+	Relation       *RelationFilterCondition    `json:"relation,omitempty"`
+	Formula        *FormulaFilterCondition     `json:"formula,omitempty"`
+	Rollup         *RollupFilterCondition      `json:"rollup,omitempty"`
+	Status         *StatusFilterCondition      `json:"status,omitempty"`
+	UniqueId       *UniqueIdFilterCondition    `json:"unique_id,omitempty"`
 }
 
 func (f PropertyFilter) filter() {}
@@ -95,6 +191,26 @@ type CheckboxFilterCondition struct {
 	DoesNotEqual bool `json:"does_not_equal,omitempty"`
 }
 
+// UnmarshalJSON decodes the conditions on false values as the opposite
+// conditions on true values, which are the ones marshaled.
+func (c *CheckboxFilterCondition) UnmarshalJSON(data []byte) error {
+	var tmp struct {
+		Equals       *bool `json:"equals"`
+		DoesNotEqual *bool `json:"does_not_equal"`
+	}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	*c = CheckboxFilterCondition{}
+	if tmp.Equals != nil {
+		c.Equals, c.DoesNotEqual = *tmp.Equals, !*tmp.Equals
+	}
+	if tmp.DoesNotEqual != nil {
+		c.Equals, c.DoesNotEqual = !*tmp.DoesNotEqual, *tmp.DoesNotEqual
+	}
+	return nil
+}
+
 type SelectFilterCondition struct {
 	Equals       string `json:"equals,omitempty"`
 	DoesNotEqual string `json:"does_not_equal,omitempty"`
@@ -166,7 +282,7 @@ type RollupSubfilterCondition struct {
 	Number      *NumberFilterCondition      `json:"number,omitempty"`
 	Checkbox    *CheckboxFilterCondition    `json:"checkbox,omitempty"`
 	Select      *SelectFilterCondition      `json:"select,omitempty"`
-	MultiSelect *MultiSelectFilterCondition `json:"multiSelect,omitempty"`
+	MultiSelect *MultiSelectFilterCondition `json:"multi_select,omitempty"`
 	Relation    *RelationFilterCondition    `json:"relation,omitempty"`
 	Date        *DateFilterCondition        `json:"date,omitempty"`
 	People      *PeopleFilterCondition      `json:"people,omitempty"`
@@ -0,0 +1,689 @@
+// Package filter builds the filters of database queries.
+//
+//	f := filter.Property("Status").Status().Equals("Done").
+//		And(filter.Property("Due").Date().Before(time.Now()))
+//	response, err := client.Database.Query(ctx, id, &notionapi.DatabaseQueryRequest{
+//		Filter: f,
+//	})
+//
+// The conditions available for a property depend on its type, and only the
+// values expected by Notion can be given to them.
+package filter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// Filter is a filter of database queries, which can be combined with other
+// filters. It is a notionapi.Filter.
+type Filter struct {
+	notionapi.Filter
+}
+
+// MarshalJSON marshals the filter as Notion expects it.
+func (f Filter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Filter)
+}
+
+// And returns a filter matching the pages matched by f and all of others.
+// Filters are combined from left to right: a.And(b).Or(c) matches the pages
+// matched by both a and b, or by c.
+func (f Filter) And(others ...Filter) Filter {
+	return And(append([]Filter{f}, others...)...)
+}
+
+// Or returns a filter matching the pages matched by f or any of others.
+func (f Filter) Or(others ...Filter) Filter {
+	return Or(append([]Filter{f}, others...)...)
+}
+
+// And returns a filter matching the pages matched by all of filters. The zero
+// filters are ignored, and the and filters among filters are merged with the
+// filter returned, as with notionapi.FlattenFilter.
+func And(filters ...Filter) Filter {
+	var compound notionapi.AndCompoundFilter
+	for _, f := range filters {
+		if f.Filter != nil {
+			compound = append(compound, f.Filter)
+		}
+	}
+	if len(compound) == 0 {
+		return Filter{}
+	}
+	return Filter{notionapi.FlattenFilter(compound)}
+}
+
+// Or returns a filter matching the pages matched by any of filters. The zero
+// filters are ignored, and the or filters among filters are merged with the
+// filter returned.
+func Or(filters ...Filter) Filter {
+	var compound notionapi.OrCompoundFilter
+	for _, f := range filters {
+		if f.Filter != nil {
+			compound = append(compound, f.Filter)
+		}
+	}
+	if len(compound) == 0 {
+		return Filter{}
+	}
+	return Filter{notionapi.FlattenFilter(compound)}
+}
+
+// Validate checks that the filter is not nested deeper than Notion allows.
+// See notionapi.ValidateFilter.
+func (f Filter) Validate() error {
+	return notionapi.ValidateFilter(f.Filter)
+}
+
+// Parse returns the filter of data, in the JSON form Notion expects, such as
+// the filters of saved queries, to be combined with other filters. See
+// notionapi.UnmarshalFilter. The filter is validated.
+func Parse(data []byte) (Filter, error) {
+	f, err := notionapi.UnmarshalFilter(data)
+	if err != nil {
+		return Filter{}, err
+	}
+	if f == nil {
+		return Filter{}, nil
+	}
+	parsed := Filter{f}
+	if err := parsed.Validate(); err != nil {
+		return Filter{}, err
+	}
+	return parsed, nil
+}
+
+// CreatedTime returns the conditions on the time pages were created.
+func CreatedTime() DateCondition {
+	return DateCondition{func(c *notionapi.DateFilterCondition) Filter {
+		return Filter{notionapi.TimestampFilter{Timestamp: notionapi.TimestampCreated, CreatedTime: c}}
+	}}
+}
+
+// LastEditedTime returns the conditions on the time pages were last edited.
+func LastEditedTime() DateCondition {
+	return DateCondition{func(c *notionapi.DateFilterCondition) Filter {
+		return Filter{notionapi.TimestampFilter{Timestamp: notionapi.TimestampLastEdited, LastEditedTime: c}}
+	}}
+}
+
+// PropertyFilter builds the filters of a property, with the conditions of
+// its type.
+type PropertyFilter struct {
+	name string
+}
+
+// Property returns the builder of the filters of the property with the name
+// or ID specified.
+func Property(name string) PropertyFilter {
+	return PropertyFilter{name: name}
+}
+
+func (p PropertyFilter) filter(f notionapi.PropertyFilter) Filter {
+	f.Property = p.name
+	return Filter{f}
+}
+
+// Title returns the conditions of a title property.
+func (p PropertyFilter) Title() TextCondition {
+	return TextCondition{func(c *notionapi.TextFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Title: c})
+	}}
+}
+
+// RichText returns the conditions of a rich text property.
+func (p PropertyFilter) RichText() TextCondition {
+	return TextCondition{func(c *notionapi.TextFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{RichText: c})
+	}}
+}
+
+// URL returns the conditions of a URL property.
+func (p PropertyFilter) URL() TextCondition {
+	return TextCondition{func(c *notionapi.TextFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{URL: c})
+	}}
+}
+
+// Email returns the conditions of an email property.
+func (p PropertyFilter) Email() TextCondition {
+	return TextCondition{func(c *notionapi.TextFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Email: c})
+	}}
+}
+
+// PhoneNumber returns the conditions of a phone number property.
+func (p PropertyFilter) PhoneNumber() TextCondition {
+	return TextCondition{func(c *notionapi.TextFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{PhoneNumber: c})
+	}}
+}
+
+// Number returns the conditions of a number property.
+func (p PropertyFilter) Number() NumberCondition {
+	return NumberCondition{func(c *notionapi.NumberFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Number: c})
+	}}
+}
+
+// Checkbox returns the conditions of a checkbox property.
+func (p PropertyFilter) Checkbox() CheckboxCondition {
+	return CheckboxCondition{func(c *notionapi.CheckboxFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Checkbox: c})
+	}}
+}
+
+// Select returns the conditions of a select property.
+func (p PropertyFilter) Select() SelectCondition {
+	return SelectCondition{func(c *notionapi.SelectFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Select: c})
+	}}
+}
+
+// Status returns the conditions of a status property.
+func (p PropertyFilter) Status() SelectCondition {
+	return SelectCondition{func(c *notionapi.SelectFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Status: (*notionapi.StatusFilterCondition)(c)})
+	}}
+}
+
+// MultiSelect returns the conditions of a multi-select property.
+func (p PropertyFilter) MultiSelect() MultiSelectCondition {
+	return MultiSelectCondition{func(c *notionapi.MultiSelectFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{MultiSelect: c})
+	}}
+}
+
+// Date returns the conditions of a date property.
+func (p PropertyFilter) Date() DateCondition {
+	return DateCondition{func(c *notionapi.DateFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Date: c})
+	}}
+}
+
+// CreatedTime returns the conditions of a created time property.
+func (p PropertyFilter) CreatedTime() DateCondition {
+	return DateCondition{func(c *notionapi.DateFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{CreatedTime: c})
+	}}
+}
+
+// LastEditedTime returns the conditions of a last edited time property.
+func (p PropertyFilter) LastEditedTime() DateCondition {
+	return DateCondition{func(c *notionapi.DateFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{LastEditedTime: c})
+	}}
+}
+
+// People returns the conditions of a people property.
+func (p PropertyFilter) People() PeopleCondition {
+	return PeopleCondition{func(c *notionapi.PeopleFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{People: c})
+	}}
+}
+
+// CreatedBy returns the conditions of a created by property.
+func (p PropertyFilter) CreatedBy() PeopleCondition {
+	return PeopleCondition{func(c *notionapi.PeopleFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{CreatedBy: c})
+	}}
+}
+
+// LastEditedBy returns the conditions of a last edited by property.
+func (p PropertyFilter) LastEditedBy() PeopleCondition {
+	return PeopleCondition{func(c *notionapi.PeopleFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{LastEditedBy: c})
+	}}
+}
+
+// Files returns the conditions of a files property.
+func (p PropertyFilter) Files() FilesCondition {
+	return FilesCondition{func(c *notionapi.FilesFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Files: c})
+	}}
+}
+
+// Relation returns the conditions of a relation property.
+func (p PropertyFilter) Relation() RelationCondition {
+	return RelationCondition{func(c *notionapi.RelationFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Relation: c})
+	}}
+}
+
+// UniqueID returns the conditions of a unique ID property, on the number of
+// the IDs, without their prefix.
+func (p PropertyFilter) UniqueID() UniqueIDCondition {
+	return UniqueIDCondition{func(c *notionapi.UniqueIdFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{UniqueId: c})
+	}}
+}
+
+// Formula returns the conditions of a formula property, by the type of the
+// result of the formula.
+func (p PropertyFilter) Formula() FormulaCondition {
+	return FormulaCondition{func(c *notionapi.FormulaFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Formula: c})
+	}}
+}
+
+// Rollup returns the conditions of a rollup property.
+func (p PropertyFilter) Rollup() RollupCondition {
+	return RollupCondition{func(c *notionapi.RollupFilterCondition) Filter {
+		return p.filter(notionapi.PropertyFilter{Rollup: c})
+	}}
+}
+
+// TextCondition builds the filters of text values.
+type TextCondition struct {
+	wrap func(*notionapi.TextFilterCondition) Filter
+}
+
+func (c TextCondition) Equals(value string) Filter {
+	return c.wrap(&notionapi.TextFilterCondition{Equals: value})
+}
+
+func (c TextCondition) DoesNotEqual(value string) Filter {
+	return c.wrap(&notionapi.TextFilterCondition{DoesNotEqual: value})
+}
+
+func (c TextCondition) Contains(value string) Filter {
+	return c.wrap(&notionapi.TextFilterCondition{Contains: value})
+}
+
+func (c TextCondition) DoesNotContain(value string) Filter {
+	return c.wrap(&notionapi.TextFilterCondition{DoesNotContain: value})
+}
+
+func (c TextCondition) StartsWith(value string) Filter {
+	return c.wrap(&notionapi.TextFilterCondition{StartsWith: value})
+}
+
+func (c TextCondition) EndsWith(value string) Filter {
+	return c.wrap(&notionapi.TextFilterCondition{EndsWith: value})
+}
+
+func (c TextCondition) IsEmpty() Filter {
+	return c.wrap(&notionapi.TextFilterCondition{IsEmpty: true})
+}
+
+func (c TextCondition) IsNotEmpty() Filter {
+	return c.wrap(&notionapi.TextFilterCondition{IsNotEmpty: true})
+}
+
+// NumberCondition builds the filters of numbers.
+type NumberCondition struct {
+	wrap func(*notionapi.NumberFilterCondition) Filter
+}
+
+func (c NumberCondition) Equals(value float64) Filter {
+	return c.wrap(&notionapi.NumberFilterCondition{Equals: &value})
+}
+
+func (c NumberCondition) DoesNotEqual(value float64) Filter {
+	return c.wrap(&notionapi.NumberFilterCondition{DoesNotEqual: &value})
+}
+
+func (c NumberCondition) GreaterThan(value float64) Filter {
+	return c.wrap(&notionapi.NumberFilterCondition{GreaterThan: &value})
+}
+
+func (c NumberCondition) LessThan(value float64) Filter {
+	return c.wrap(&notionapi.NumberFilterCondition{LessThan: &value})
+}
+
+func (c NumberCondition) GreaterThanOrEqualTo(value float64) Filter {
+	return c.wrap(&notionapi.NumberFilterCondition{GreaterThanOrEqualTo: &value})
+}
+
+func (c NumberCondition) LessThanOrEqualTo(value float64) Filter {
+	return c.wrap(&notionapi.NumberFilterCondition{LessThanOrEqualTo: &value})
+}
+
+func (c NumberCondition) IsEmpty() Filter {
+	return c.wrap(&notionapi.NumberFilterCondition{IsEmpty: true})
+}
+
+func (c NumberCondition) IsNotEmpty() Filter {
+	return c.wrap(&notionapi.NumberFilterCondition{IsNotEmpty: true})
+}
+
+// CheckboxCondition builds the filters of checkboxes.
+type CheckboxCondition struct {
+	wrap func(*notionapi.CheckboxFilterCondition) Filter
+}
+
+// Equals matches the checkboxes that are checked or not. false is omitted
+// from the conditions Notion receives, so Equals(false) is sent as a
+// does_not_equal true condition.
+func (c CheckboxCondition) Equals(checked bool) Filter {
+	if !checked {
+		return c.wrap(&notionapi.CheckboxFilterCondition{DoesNotEqual: true})
+	}
+	return c.wrap(&notionapi.CheckboxFilterCondition{Equals: true})
+}
+
+// DoesNotEqual is the opposite of Equals.
+func (c CheckboxCondition) DoesNotEqual(checked bool) Filter {
+	return c.Equals(!checked)
+}
+
+// SelectCondition builds the filters of select and status values.
+type SelectCondition struct {
+	wrap func(*notionapi.SelectFilterCondition) Filter
+}
+
+func (c SelectCondition) Equals(option string) Filter {
+	return c.wrap(&notionapi.SelectFilterCondition{Equals: option})
+}
+
+func (c SelectCondition) DoesNotEqual(option string) Filter {
+	return c.wrap(&notionapi.SelectFilterCondition{DoesNotEqual: option})
+}
+
+func (c SelectCondition) IsEmpty() Filter {
+	return c.wrap(&notionapi.SelectFilterCondition{IsEmpty: true})
+}
+
+func (c SelectCondition) IsNotEmpty() Filter {
+	return c.wrap(&notionapi.SelectFilterCondition{IsNotEmpty: true})
+}
+
+// MultiSelectCondition builds the filters of multi-select values.
+type MultiSelectCondition struct {
+	wrap func(*notionapi.MultiSelectFilterCondition) Filter
+}
+
+func (c MultiSelectCondition) Contains(option string) Filter {
+	return c.wrap(&notionapi.MultiSelectFilterCondition{Contains: option})
+}
+
+func (c MultiSelectCondition) DoesNotContain(option string) Filter {
+	return c.wrap(&notionapi.MultiSelectFilterCondition{DoesNotContain: option})
+}
+
+func (c MultiSelectCondition) IsEmpty() Filter {
+	return c.wrap(&notionapi.MultiSelectFilterCondition{IsEmpty: true})
+}
+
+func (c MultiSelectCondition) IsNotEmpty() Filter {
+	return c.wrap(&notionapi.MultiSelectFilterCondition{IsNotEmpty: true})
+}
+
+// DateCondition builds the filters of dates and timestamps.
+type DateCondition struct {
+	wrap func(*notionapi.DateFilterCondition) Filter
+}
+
+func (c DateCondition) Equals(t time.Time) Filter {
+	date := notionapi.Date(t)
+	return c.wrap(&notionapi.DateFilterCondition{Equals: &date})
+}
+
+func (c DateCondition) Before(t time.Time) Filter {
+	date := notionapi.Date(t)
+	return c.wrap(&notionapi.DateFilterCondition{Before: &date})
+}
+
+func (c DateCondition) After(t time.Time) Filter {
+	date := notionapi.Date(t)
+	return c.wrap(&notionapi.DateFilterCondition{After: &date})
+}
+
+func (c DateCondition) OnOrBefore(t time.Time) Filter {
+	date := notionapi.Date(t)
+	return c.wrap(&notionapi.DateFilterCondition{OnOrBefore: &date})
+}
+
+func (c DateCondition) OnOrAfter(t time.Time) Filter {
+	date := notionapi.Date(t)
+	return c.wrap(&notionapi.DateFilterCondition{OnOrAfter: &date})
+}
+
+func (c DateCondition) PastWeek() Filter {
+	return c.wrap(&notionapi.DateFilterCondition{PastWeek: &struct{}{}})
+}
+
+func (c DateCondition) PastMonth() Filter {
+	return c.wrap(&notionapi.DateFilterCondition{PastMonth: &struct{}{}})
+}
+
+func (c DateCondition) PastYear() Filter {
+	return c.wrap(&notionapi.DateFilterCondition{PastYear: &struct{}{}})
+}
+
+func (c DateCondition) NextWeek() Filter {
+	return c.wrap(&notionapi.DateFilterCondition{NextWeek: &struct{}{}})
+}
+
+func (c DateCondition) NextMonth() Filter {
+	return c.wrap(&notionapi.DateFilterCondition{NextMonth: &struct{}{}})
+}
+
+func (c DateCondition) NextYear() Filter {
+	return c.wrap(&notionapi.DateFilterCondition{NextYear: &struct{}{}})
+}
+
+func (c DateCondition) IsEmpty() Filter {
+	return c.wrap(&notionapi.DateFilterCondition{IsEmpty: true})
+}
+
+func (c DateCondition) IsNotEmpty() Filter {
+	return c.wrap(&notionapi.DateFilterCondition{IsNotEmpty: true})
+}
+
+// PeopleCondition builds the filters of people, created by and last edited
+// by values.
+type PeopleCondition struct {
+	wrap func(*notionapi.PeopleFilterCondition) Filter
+}
+
+func (c PeopleCondition) Contains(id notionapi.UserID) Filter {
+	return c.wrap(&notionapi.PeopleFilterCondition{Contains: id.String()})
+}
+
+func (c PeopleCondition) DoesNotContain(id notionapi.UserID) Filter {
+	return c.wrap(&notionapi.PeopleFilterCondition{DoesNotContain: id.String()})
+}
+
+func (c PeopleCondition) IsEmpty() Filter {
+	return c.wrap(&notionapi.PeopleFilterCondition{IsEmpty: true})
+}
+
+func (c PeopleCondition) IsNotEmpty() Filter {
+	return c.wrap(&notionapi.PeopleFilterCondition{IsNotEmpty: true})
+}
+
+// FilesCondition builds the filters of files values.
+type FilesCondition struct {
+	wrap func(*notionapi.FilesFilterCondition) Filter
+}
+
+func (c FilesCondition) IsEmpty() Filter {
+	return c.wrap(&notionapi.FilesFilterCondition{IsEmpty: true})
+}
+
+func (c FilesCondition) IsNotEmpty() Filter {
+	return c.wrap(&notionapi.FilesFilterCondition{IsNotEmpty: true})
+}
+
+// RelationCondition builds the filters of relations.
+type RelationCondition struct {
+	wrap func(*notionapi.RelationFilterCondition) Filter
+}
+
+func (c RelationCondition) Contains(id notionapi.PageID) Filter {
+	return c.wrap(&notionapi.RelationFilterCondition{Contains: id.String()})
+}
+
+func (c RelationCondition) DoesNotContain(id notionapi.PageID) Filter {
+	return c.wrap(&notionapi.RelationFilterCondition{DoesNotContain: id.String()})
+}
+
+func (c RelationCondition) IsEmpty() Filter {
+	return c.wrap(&notionapi.RelationFilterCondition{IsEmpty: true})
+}
+
+func (c RelationCondition) IsNotEmpty() Filter {
+	return c.wrap(&notionapi.RelationFilterCondition{IsNotEmpty: true})
+}
+
+// UniqueIDCondition builds the filters of unique IDs.
+type UniqueIDCondition struct {
+	wrap func(*notionapi.UniqueIdFilterCondition) Filter
+}
+
+func (c UniqueIDCondition) Equals(number int) Filter {
+	return c.wrap(&notionapi.UniqueIdFilterCondition{Equals: &number})
+}
+
+func (c UniqueIDCondition) DoesNotEqual(number int) Filter {
+	return c.wrap(&notionapi.UniqueIdFilterCondition{DoesNotEqual: &number})
+}
+
+func (c UniqueIDCondition) GreaterThan(number int) Filter {
+	return c.wrap(&notionapi.UniqueIdFilterCondition{GreaterThan: &number})
+}
+
+func (c UniqueIDCondition) LessThan(number int) Filter {
+	return c.wrap(&notionapi.UniqueIdFilterCondition{LessThan: &number})
+}
+
+func (c UniqueIDCondition) GreaterThanOrEqualTo(number int) Filter {
+	return c.wrap(&notionapi.UniqueIdFilterCondition{GreaterThanOrEqualTo: &number})
+}
+
+func (c UniqueIDCondition) LessThanOrEqualTo(number int) Filter {
+	return c.wrap(&notionapi.UniqueIdFilterCondition{LessThanOrEqualTo: &number})
+}
+
+// FormulaCondition builds the filters of formulas, by the type of their
+// result.
+type FormulaCondition struct {
+	wrap func(*notionapi.FormulaFilterCondition) Filter
+}
+
+func (c FormulaCondition) String() TextCondition {
+	return TextCondition{func(text *notionapi.TextFilterCondition) Filter {
+		return c.wrap(&notionapi.FormulaFilterCondition{String: text})
+	}}
+}
+
+func (c FormulaCondition) Number() NumberCondition {
+	return NumberCondition{func(number *notionapi.NumberFilterCondition) Filter {
+		return c.wrap(&notionapi.FormulaFilterCondition{Number: number})
+	}}
+}
+
+func (c FormulaCondition) Checkbox() CheckboxCondition {
+	return CheckboxCondition{func(checkbox *notionapi.CheckboxFilterCondition) Filter {
+		return c.wrap(&notionapi.FormulaFilterCondition{Checkbox: checkbox})
+	}}
+}
+
+func (c FormulaCondition) Date() DateCondition {
+	return DateCondition{func(date *notionapi.DateFilterCondition) Filter {
+		return c.wrap(&notionapi.FormulaFilterCondition{Date: date})
+	}}
+}
+
+// RollupCondition builds the filters of rollups: the rollups computing a
+// number or a date are filtered by their value, the others by their items.
+type RollupCondition struct {
+	wrap func(*notionapi.RollupFilterCondition) Filter
+}
+
+// Any matches the rollups with at least one item matching the condition.
+func (c RollupCondition) Any() RollupItemCondition {
+	return RollupItemCondition{func(item *notionapi.RollupSubfilterCondition) Filter {
+		return c.wrap(&notionapi.RollupFilterCondition{Any: item})
+	}}
+}
+
+// Every matches the rollups whose items all match the condition.
+func (c RollupCondition) Every() RollupItemCondition {
+	return RollupItemCondition{func(item *notionapi.RollupSubfilterCondition) Filter {
+		return c.wrap(&notionapi.RollupFilterCondition{Every: item})
+	}}
+}
+
+// None matches the rollups with no item matching the condition.
+func (c RollupCondition) None() RollupItemCondition {
+	return RollupItemCondition{func(item *notionapi.RollupSubfilterCondition) Filter {
+		return c.wrap(&notionapi.RollupFilterCondition{None: item})
+	}}
+}
+
+func (c RollupCondition) Number() NumberCondition {
+	return NumberCondition{func(number *notionapi.NumberFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupFilterCondition{Number: number})
+	}}
+}
+
+func (c RollupCondition) Date() DateCondition {
+	return DateCondition{func(date *notionapi.DateFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupFilterCondition{Date: date})
+	}}
+}
+
+// RollupItemCondition builds the conditions on the items of rollups, by the
+// type of the rolled up property.
+type RollupItemCondition struct {
+	wrap func(*notionapi.RollupSubfilterCondition) Filter
+}
+
+func (c RollupItemCondition) RichText() TextCondition {
+	return TextCondition{func(text *notionapi.TextFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{RichText: text})
+	}}
+}
+
+func (c RollupItemCondition) Number() NumberCondition {
+	return NumberCondition{func(number *notionapi.NumberFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{Number: number})
+	}}
+}
+
+func (c RollupItemCondition) Checkbox() CheckboxCondition {
+	return CheckboxCondition{func(checkbox *notionapi.CheckboxFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{Checkbox: checkbox})
+	}}
+}
+
+func (c RollupItemCondition) Select() SelectCondition {
+	return SelectCondition{func(option *notionapi.SelectFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{Select: option})
+	}}
+}
+
+func (c RollupItemCondition) MultiSelect() MultiSelectCondition {
+	return MultiSelectCondition{func(options *notionapi.MultiSelectFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{MultiSelect: options})
+	}}
+}
+
+func (c RollupItemCondition) Relation() RelationCondition {
+	return RelationCondition{func(relation *notionapi.RelationFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{Relation: relation})
+	}}
+}
+
+func (c RollupItemCondition) Date() DateCondition {
+	return DateCondition{func(date *notionapi.DateFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{Date: date})
+	}}
+}
+
+func (c RollupItemCondition) People() PeopleCondition {
+	return PeopleCondition{func(people *notionapi.PeopleFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{People: people})
+	}}
+}
+
+func (c RollupItemCondition) Files() FilesCondition {
+	return FilesCondition{func(files *notionapi.FilesFilterCondition) Filter {
+		return c.wrap(&notionapi.RollupSubfilterCondition{Files: files})
+	}}
+}
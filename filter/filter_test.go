@@ -0,0 +1,104 @@
+package filter_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+	"github.com/robinlbt/notionapi/filter"
+)
+
+func TestFilter(t *testing.T) {
+	due := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		filter filter.Filter
+		want   string
+	}{
+		{
+			name: "and",
+			filter: filter.Property("Status").Status().Equals("Done").
+				And(filter.Property("Due").Date().Before(due)),
+			want: `{"and":[{"property":"Status","status":{"equals":"Done"}},{"property":"Due","date":{"before":"2024-05-01T00:00:00Z"}}]}`,
+		},
+		{
+			name: "chained operators are combined from left to right",
+			filter: filter.Property("Tags").MultiSelect().Contains("a").
+				And(filter.Property("Tags").MultiSelect().Contains("b")).
+				And(filter.Property("Title").Title().StartsWith("c")).
+				Or(filter.Property("Done").Checkbox().Equals(true)),
+			want: `{"or":[{"and":[{"property":"Tags","multi_select":{"contains":"a"}},{"property":"Tags","multi_select":{"contains":"b"}},{"property":"Title","title":{"starts_with":"c"}}]},{"property":"Done","checkbox":{"equals":true}}]}`,
+		},
+		{
+			name:   "zero filters are ignored",
+			filter: filter.And(filter.Filter{}, filter.Property("Price").Number().GreaterThan(0)),
+			want:   `{"property":"Price","number":{"greater_than":0}}`,
+		},
+		{
+			name:   "unchecked checkbox",
+			filter: filter.Property("Done").Checkbox().Equals(false),
+			want:   `{"property":"Done","checkbox":{"does_not_equal":true}}`,
+		},
+		{
+			name:   "timestamp",
+			filter: filter.LastEditedTime().PastWeek(),
+			want:   `{"timestamp":"last_edited_time","last_edited_time":{"past_week":{}}}`,
+		},
+		{
+			name:   "people",
+			filter: filter.Property("Owner").People().Contains("user_id"),
+			want:   `{"property":"Owner","people":{"contains":"user_id"}}`,
+		},
+		{
+			name:   "relation",
+			filter: filter.Property("Project").Relation().IsEmpty(),
+			want:   `{"property":"Project","relation":{"is_empty":true}}`,
+		},
+		{
+			name:   "unique id",
+			filter: filter.Property("ID").UniqueID().LessThanOrEqualTo(42),
+			want:   `{"property":"ID","unique_id":{"less_than_or_equal_to":42}}`,
+		},
+		{
+			name:   "formula",
+			filter: filter.Property("Total").Formula().Number().Equals(0),
+			want:   `{"property":"Total","formula":{"number":{"equals":0}}}`,
+		},
+		{
+			name:   "rollup",
+			filter: filter.Property("Tags of tasks").Rollup().Any().MultiSelect().Contains("urgent"),
+			want:   `{"property":"Tags of tasks","rollup":{"any":{"multi_select":{"contains":"urgent"}}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(&notionapi.DatabaseQueryRequest{Filter: tt.filter})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := `{"filter":` + tt.want + `}`; string(got) != want {
+				t.Errorf("Marshal() got = %s\nwant %s", got, want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	parsed, err := filter.Parse([]byte(`{"or":[{"property":"Done","checkbox":{"equals":true}},{"property":"Tags","multi_select":{"contains":"a"}}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(parsed.And(filter.Property("Title").Title().IsNotEmpty()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"and":[{"or":[{"property":"Done","checkbox":{"equals":true}},{"property":"Tags","multi_select":{"contains":"a"}}]},{"property":"Title","title":{"is_not_empty":true}}]}`
+	if string(data) != want {
+		t.Errorf("Parse() = %s, want %s", data, want)
+	}
+
+	if _, err := filter.Parse([]byte(`{"property":"Done","toggle":{}}`)); err == nil {
+		t.Error("Parse() of an unknown condition error = nil, want an error")
+	}
+}
@@ -0,0 +1,86 @@
+package notionapi_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestValidateFilter(t *testing.T) {
+	leaf := notionapi.PropertyFilter{Property: "Done", Checkbox: &notionapi.CheckboxFilterCondition{Equals: true}}
+	tests := []struct {
+		name   string
+		filter notionapi.Filter
+		want   []string
+	}{
+		{
+			name:   "property filter",
+			filter: leaf,
+		},
+		{
+			name:   "two levels",
+			filter: notionapi.AndCompoundFilter{leaf, notionapi.OrCompoundFilter{leaf, leaf}},
+		},
+		{
+			name: "three levels",
+			filter: notionapi.AndCompoundFilter{
+				leaf,
+				notionapi.OrCompoundFilter{leaf, notionapi.AndCompoundFilter{leaf, leaf}},
+			},
+			want: []string{"filter.and[1].or[1].and: compound filter nested more than 2 levels deep"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := notionapi.ValidateFilter(tt.filter)
+			var got []string
+			var validationErr *notionapi.ValidationError
+			if errors.As(err, &validationErr) {
+				for _, v := range validationErr.Violations {
+					got = append(got, v.String())
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateFilter() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenFilter(t *testing.T) {
+	a := notionapi.PropertyFilter{Property: "a"}
+	b := notionapi.PropertyFilter{Property: "b"}
+	c := notionapi.PropertyFilter{Property: "c"}
+	tests := []struct {
+		name   string
+		filter notionapi.Filter
+		want   notionapi.Filter
+	}{
+		{
+			name:   "merges nested filters of the same operator",
+			filter: notionapi.AndCompoundFilter{a, notionapi.AndCompoundFilter{b, notionapi.AndCompoundFilter{c}}},
+			want:   notionapi.AndCompoundFilter{a, b, c},
+		},
+		{
+			name:   "keeps nested filters of the other operator",
+			filter: notionapi.OrCompoundFilter{a, notionapi.AndCompoundFilter{b, c}},
+			want:   notionapi.OrCompoundFilter{a, notionapi.AndCompoundFilter{b, c}},
+		},
+		{
+			name:   "replaces compound filters of a single filter",
+			filter: notionapi.OrCompoundFilter{notionapi.AndCompoundFilter{a}},
+			want:   a,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := notionapi.FlattenFilter(tt.filter); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlattenFilter() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package notionapi
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// PageMatch is a block of a page whose text matches a query, as returned by
+// PageService.FindInPage.
+type PageMatch struct {
+	Block Block
+	// Path lists the ancestors of Block within the page, from the top-level
+	// block down to its parent.
+	Path []Block
+	// Text is the plain text of Block, as returned by PlainText.
+	Text string
+	// Offsets are the offsets in characters of the matches in Text.
+	Offsets []int
+}
+
+// FindInPage retrieves the content of the page with the ID specified and
+// returns the blocks whose plain text contains query, ignoring case, in the
+// order they appear in the page. The content of child pages is not searched.
+// Empty and blank queries match nothing, without retrieving the page.
+func (pc *PageClient) FindInPage(ctx context.Context, id PageID, query string) ([]PageMatch, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+	tree, err := pc.apiClient.Block.GetTree(ctx, BlockID(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		matches []PageMatch
+		path    []Block
+	)
+	for _, child := range tree.Children {
+		_ = Walk(child, func(block Block, depth int) error {
+			path = append(path[:depth], block)
+			text := blockPlainText(block)
+			if offsets := findOffsets(text, query); len(offsets) > 0 {
+				matches = append(matches, PageMatch{
+					Block:   block,
+					Path:    append([]Block(nil), path[:depth]...),
+					Text:    text,
+					Offsets: offsets,
+				})
+			}
+			return nil
+		})
+	}
+	return matches, nil
+}
+
+// findOffsets returns the offsets in characters of the non-overlapping
+// occurrences of query in text, ignoring case. The characters are compared
+// with simple case folding, which keeps the offsets those of text, unlike
+// lowercasing that may change the number of characters.
+func findOffsets(text, query string) []int {
+	textRunes, queryRunes := []rune(text), []rune(query)
+	if len(queryRunes) == 0 {
+		return nil
+	}
+	var offsets []int
+	for i := 0; i+len(queryRunes) <= len(textRunes); {
+		if equalFoldRunes(textRunes[i:i+len(queryRunes)], queryRunes) {
+			offsets = append(offsets, i)
+			i += len(queryRunes)
+			continue
+		}
+		i++
+	}
+	return offsets
+}
+
+// equalFoldRunes reports whether a and b are equal under simple case folding.
+func equalFoldRunes(a, b []rune) bool {
+	for i := range a {
+		if !equalFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalFold reports whether r and s are equal under simple case folding, as
+// strings.EqualFold does for each character.
+func equalFold(r, s rune) bool {
+	if r == s {
+		return true
+	}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f == s {
+			return true
+		}
+	}
+	return false
+}
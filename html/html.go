@@ -0,0 +1,426 @@
+// Package html renders Notion block trees as HTML.
+package html
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// BlockData is the data the templates of Options.Templates are executed
+// with.
+type BlockData struct {
+	Block notionapi.Block
+	// Content is the rendered rich text of the block, if it has any.
+	Content template.HTML
+	// Children are the rendered children of the block.
+	Children template.HTML
+}
+
+// AssetFunc returns the URL of the file of a block in the rendered HTML, for
+// instance the path it was downloaded to.
+type AssetFunc func(block notionapi.DownloadableFileBlock) (string, error)
+
+// Options configures Render.
+type Options struct {
+	// Templates replace the rendering of the blocks of their type. They are
+	// executed with a *BlockData. Funcs provides functions to render rich
+	// text from templates.
+	Templates map[notionapi.BlockType]*template.Template
+	// Asset returns the URLs of the files of image, file, pdf, video and
+	// audio blocks. Defaults to their URL, which expires after an hour for
+	// files hosted by Notion.
+	Asset AssetFunc
+}
+
+// Funcs returns the functions available to templates: richText renders rich
+// text, such as captions, as HTML.
+func Funcs() template.FuncMap {
+	return template.FuncMap{"richText": RichText}
+}
+
+// Render writes nodes as HTML to w. The nodes are usually the children of a
+// page, as retrieved by BlockService.GetTree.
+//
+// Blocks are rendered as semantic elements, with a class named after the
+// block type where there is no such element, for instance "callout", so
+// they can be styled. Consecutive list items are grouped in ul or ol
+// elements.
+func Render(w io.Writer, nodes []*notionapi.BlockNode, opts *Options) error {
+	if opts == nil {
+		opts = &Options{}
+	}
+	r := &renderer{templates: opts.Templates, asset: opts.Asset}
+	if r.asset == nil {
+		r.asset = func(block notionapi.DownloadableFileBlock) (string, error) {
+			return block.GetURL(), nil
+		}
+	}
+	out, err := r.blocks(nodes)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+type renderer struct {
+	templates map[notionapi.BlockType]*template.Template
+	asset     AssetFunc
+}
+
+// listTag returns the tag of the list holding blocks of the type, if they
+// are list items.
+func listTag(blockType notionapi.BlockType) string {
+	switch blockType {
+	case notionapi.BlockTypeBulletedListItem:
+		return `<ul>`
+	case notionapi.BlockTypeToDo:
+		return `<ul class="to-do">`
+	case notionapi.BlockTypeNumberedListItem:
+		return `<ol>`
+	}
+	return ""
+}
+
+func (r *renderer) blocks(nodes []*notionapi.BlockNode) (string, error) {
+	var (
+		b       strings.Builder
+		openTag string
+	)
+	closeList := func() {
+		if strings.HasPrefix(openTag, "<ol") {
+			b.WriteString("</ol>\n")
+		} else if openTag != "" {
+			b.WriteString("</ul>\n")
+		}
+		openTag = ""
+	}
+
+	for _, node := range nodes {
+		tag := listTag(node.Block.GetType())
+		if tag != openTag {
+			closeList()
+			if tag != "" {
+				b.WriteString(tag + "\n")
+				openTag = tag
+			}
+		}
+		out, err := r.block(node)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(out)
+	}
+	closeList()
+	return b.String(), nil
+}
+
+func (r *renderer) block(node *notionapi.BlockNode) (string, error) {
+	children, err := r.blocks(node.Children)
+	if err != nil {
+		return "", err
+	}
+	content := blockContent(node.Block)
+
+	if tmpl, ok := r.templates[node.Block.GetType()]; ok {
+		var buf bytes.Buffer
+		data := &BlockData{Block: node.Block, Content: content, Children: template.HTML(children)}
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return "", fmt.Errorf("failed to render %s block %s: %w", node.Block.GetType(), node.Block.GetID(), err)
+		}
+		return buf.String(), nil
+	}
+
+	c := string(content)
+	switch block := node.Block.(type) {
+	case *notionapi.ParagraphBlock:
+		return "<p>" + c + "</p>\n" + children, nil
+	case *notionapi.Heading1Block:
+		return heading("h1", block.Heading1, c, children), nil
+	case *notionapi.Heading2Block:
+		return heading("h2", block.Heading2, c, children), nil
+	case *notionapi.Heading3Block:
+		return heading("h3", block.Heading3, c, children), nil
+	case *notionapi.BulletedListItemBlock, *notionapi.NumberedListItemBlock:
+		return "<li>" + c + wrapChildren(children) + "</li>\n", nil
+	case *notionapi.ToDoBlock:
+		checkbox := `<input type="checkbox" disabled>`
+		if block.ToDo.Checked {
+			checkbox = `<input type="checkbox" disabled checked>`
+		}
+		return "<li>" + checkbox + " " + c + wrapChildren(children) + "</li>\n", nil
+	case *notionapi.QuoteBlock:
+		return "<blockquote>" + c + wrapChildren(children) + "</blockquote>\n", nil
+	case *notionapi.CalloutBlock:
+		icon := ""
+		if block.Callout.Icon != nil && block.Callout.Icon.Emoji != nil {
+			icon = `<span class="icon">` + escape(string(*block.Callout.Icon.Emoji)) + "</span> "
+		}
+		return `<aside class="callout">` + icon + c + wrapChildren(children) + "</aside>\n", nil
+	case *notionapi.ToggleBlock:
+		return "<details><summary>" + c + "</summary>\n" + children + "</details>\n", nil
+	case *notionapi.CodeBlock:
+		class := ""
+		if block.Code.Language != "" && block.Code.Language != notionapi.CodeLanguagePlainText.String() {
+			class = ` class="language-` + escape(strings.Replace(block.Code.Language, " ", "-", -1)) + `"`
+		}
+		return "<pre><code" + class + ">" + escape(plainText(block.Code.RichText)) + "</code></pre>\n" + caption(block.Code.Caption), nil
+	case *notionapi.EquationBlock:
+		return `<div class="equation">` + escape(block.Equation.Expression) + "</div>\n", nil
+	case *notionapi.DividerBlock:
+		return "<hr>\n", nil
+	case *notionapi.TableBlock:
+		return table(block.Table, node.Children), nil
+	case *notionapi.ImageBlock:
+		src, err := r.asset(block)
+		if err != nil {
+			return "", err
+		}
+		return `<figure><img src="` + safeURL(src) + `" alt="` + escape(plainText(block.Image.Caption)) + `">` +
+			figcaption(block.Image.Caption) + "</figure>\n", nil
+	case *notionapi.VideoBlock:
+		src, err := r.asset(block)
+		if err != nil {
+			return "", err
+		}
+		return `<figure><video controls src="` + safeURL(src) + `"></video>` + figcaption(block.Video.Caption) + "</figure>\n", nil
+	case *notionapi.AudioBlock:
+		src, err := r.asset(block)
+		if err != nil {
+			return "", err
+		}
+		return `<figure><audio controls src="` + safeURL(src) + `"></audio>` + figcaption(block.Audio.Caption) + "</figure>\n", nil
+	case *notionapi.FileBlock:
+		name := block.File.Name
+		if name == "" {
+			name = plainText(block.File.Caption)
+		}
+		return r.fileLink(block, name)
+	case *notionapi.PdfBlock:
+		return r.fileLink(block, plainText(block.Pdf.Caption))
+	case *notionapi.BookmarkBlock:
+		return `<p class="bookmark">` + link(block.Bookmark.URL, plainText(block.Bookmark.Caption)) + "</p>\n", nil
+	case *notionapi.EmbedBlock:
+		return `<p class="embed">` + link(block.Embed.URL, plainText(block.Embed.Caption)) + "</p>\n", nil
+	case *notionapi.LinkPreviewBlock:
+		return `<p class="link-preview">` + link(block.LinkPreview.URL, "") + "</p>\n", nil
+	case *notionapi.ChildPageBlock:
+		return `<p class="child-page">` + link(pageURL(block.GetID().String()), block.ChildPage.Title) + "</p>\n", nil
+	case *notionapi.ChildDatabaseBlock:
+		return `<p class="child-database">` + link(pageURL(block.GetID().String()), block.ChildDatabase.Title) + "</p>\n", nil
+	case *notionapi.LinkToPageBlock:
+		id := block.LinkToPage.PageID.String()
+		if id == "" {
+			id = block.LinkToPage.DatabaseID.String()
+		}
+		return `<p class="link-to-page">` + link(pageURL(id), "") + "</p>\n", nil
+	case *notionapi.ColumnListBlock:
+		return `<div class="column-list">` + "\n" + children + "</div>\n", nil
+	case *notionapi.ColumnBlock:
+		return `<div class="column">` + "\n" + children + "</div>\n", nil
+	case *notionapi.SyncedBlock, *notionapi.TemplateBlock:
+		return children, nil
+	}
+	return "", nil
+}
+
+func (r *renderer) fileLink(block notionapi.DownloadableFileBlock, name string) (string, error) {
+	href, err := r.asset(block)
+	if err != nil {
+		return "", err
+	}
+	return `<p class="file">` + link(href, name) + "</p>\n", nil
+}
+
+// blockContent returns the rendered rich text of a block.
+func blockContent(block notionapi.Block) template.HTML {
+	switch b := block.(type) {
+	case *notionapi.ParagraphBlock:
+		return RichText(b.Paragraph.RichText)
+	case *notionapi.Heading1Block:
+		return RichText(b.Heading1.RichText)
+	case *notionapi.Heading2Block:
+		return RichText(b.Heading2.RichText)
+	case *notionapi.Heading3Block:
+		return RichText(b.Heading3.RichText)
+	case *notionapi.BulletedListItemBlock:
+		return RichText(b.BulletedListItem.RichText)
+	case *notionapi.NumberedListItemBlock:
+		return RichText(b.NumberedListItem.RichText)
+	case *notionapi.ToDoBlock:
+		return RichText(b.ToDo.RichText)
+	case *notionapi.QuoteBlock:
+		return RichText(b.Quote.RichText)
+	case *notionapi.CalloutBlock:
+		return RichText(b.Callout.RichText)
+	case *notionapi.ToggleBlock:
+		return RichText(b.Toggle.RichText)
+	case *notionapi.CodeBlock:
+		return RichText(b.Code.RichText)
+	}
+	return ""
+}
+
+func heading(tag string, h notionapi.Heading, content, children string) string {
+	out := "<" + tag + ">" + content + "</" + tag + ">\n"
+	if h.IsToggleable {
+		return "<details><summary>" + strings.TrimSuffix(out, "\n") + "</summary>\n" + children + "</details>\n"
+	}
+	return out + children
+}
+
+func wrapChildren(children string) string {
+	if children == "" {
+		return ""
+	}
+	return "\n" + children
+}
+
+func table(t notionapi.Table, rows []*notionapi.BlockNode) string {
+	var (
+		b        strings.Builder
+		bodyOpen bool
+	)
+	b.WriteString("<table>\n")
+	for i, node := range rows {
+		row, ok := node.Block.(*notionapi.TableRowBlock)
+		if !ok {
+			continue
+		}
+		header := i == 0 && t.HasColumnHeader
+		switch {
+		case header:
+			b.WriteString("<thead>\n")
+		case !bodyOpen:
+			b.WriteString("<tbody>\n")
+			bodyOpen = true
+		}
+		b.WriteString("<tr>")
+		for j, cell := range row.TableRow.Cells {
+			tag := "td"
+			if header || (j == 0 && t.HasRowHeader) {
+				tag = "th"
+			}
+			b.WriteString("<" + tag + ">" + string(RichText(cell)) + "</" + tag + ">")
+		}
+		b.WriteString("</tr>\n")
+		if header {
+			b.WriteString("</thead>\n")
+		}
+	}
+	if bodyOpen {
+		b.WriteString("</tbody>\n")
+	}
+	b.WriteString("</table>\n")
+	return b.String()
+}
+
+func caption(richText []notionapi.RichText) string {
+	if len(richText) == 0 {
+		return ""
+	}
+	return `<p class="caption">` + string(RichText(richText)) + "</p>\n"
+}
+
+func figcaption(richText []notionapi.RichText) string {
+	if len(richText) == 0 {
+		return ""
+	}
+	return "<figcaption>" + string(RichText(richText)) + "</figcaption>"
+}
+
+func link(href, text string) string {
+	if text == "" {
+		text = href
+	}
+	return `<a href="` + safeURL(href) + `">` + escape(text) + "</a>"
+}
+
+func pageURL(id string) string {
+	return "https://www.notion.so/" + strings.Replace(id, "-", "", -1)
+}
+
+// RichText renders rich text as HTML.
+func RichText(richText []notionapi.RichText) template.HTML {
+	var b strings.Builder
+	for _, rt := range richText {
+		var out string
+		switch {
+		case rt.Equation != nil:
+			out = `<span class="equation">` + escape(rt.Equation.Expression) + "</span>"
+		default:
+			content := rt.PlainText
+			if rt.Text != nil && content == "" {
+				content = rt.Text.Content
+			}
+			out = strings.Replace(escape(content), "\n", "<br>", -1)
+		}
+
+		if a := rt.Annotations; a != nil {
+			if a.Code {
+				out = "<code>" + out + "</code>"
+			}
+			if a.Strikethrough {
+				out = "<s>" + out + "</s>"
+			}
+			if a.Underline {
+				out = "<u>" + out + "</u>"
+			}
+			if a.Italic {
+				out = "<em>" + out + "</em>"
+			}
+			if a.Bold {
+				out = "<strong>" + out + "</strong>"
+			}
+			if a.Color != "" && a.Color != notionapi.ColorDefault {
+				out = `<span class="color-` + escape(strings.Replace(string(a.Color), "_", "-", -1)) + `">` + out + "</span>"
+			}
+		}
+
+		href := rt.Href
+		if rt.Text != nil && rt.Text.Link != nil {
+			href = rt.Text.Link.Url
+		}
+		if href != "" {
+			out = `<a href="` + safeURL(href) + `">` + out + "</a>"
+		}
+		b.WriteString(out)
+	}
+	return template.HTML(b.String())
+}
+
+func plainText(richText []notionapi.RichText) string {
+	var b strings.Builder
+	for _, rt := range richText {
+		if rt.PlainText == "" && rt.Text != nil {
+			b.WriteString(rt.Text.Content)
+			continue
+		}
+		b.WriteString(rt.PlainText)
+	}
+	return b.String()
+}
+
+func escape(s string) string {
+	return template.HTMLEscapeString(s)
+}
+
+// safeURL escapes rawURL for an attribute, replacing URLs with a scheme
+// other than http, https and mailto.
+func safeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "#"
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https", "mailto":
+		return escape(rawURL)
+	}
+	return "#"
+}
@@ -0,0 +1,91 @@
+package html_test
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+	"github.com/robinlbt/notionapi/html"
+)
+
+func text(content string, annotations *notionapi.Annotations) notionapi.RichText {
+	return notionapi.RichText{
+		Type:        notionapi.ObjectTypeText,
+		Text:        &notionapi.Text{Content: content},
+		Annotations: annotations,
+		PlainText:   content,
+	}
+}
+
+func node(block notionapi.Block, children ...*notionapi.BlockNode) *notionapi.BlockNode {
+	return &notionapi.BlockNode{Block: block, Children: children}
+}
+
+func basic(blockType notionapi.BlockType) notionapi.BasicBlock {
+	return notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: blockType}
+}
+
+func TestRender(t *testing.T) {
+	paragraph := func(richText ...notionapi.RichText) *notionapi.BlockNode {
+		return node(&notionapi.ParagraphBlock{
+			BasicBlock: basic(notionapi.BlockTypeParagraph),
+			Paragraph:  notionapi.Paragraph{RichText: richText},
+		})
+	}
+	bulleted := func(content string, children ...*notionapi.BlockNode) *notionapi.BlockNode {
+		return node(&notionapi.BulletedListItemBlock{
+			BasicBlock:       basic(notionapi.BlockTypeBulletedListItem),
+			BulletedListItem: notionapi.ListItem{RichText: []notionapi.RichText{text(content, nil)}},
+		}, children...)
+	}
+	callout := node(&notionapi.CalloutBlock{
+		BasicBlock: basic(notionapi.BlockTypeCallout),
+		Callout:    notionapi.Callout{RichText: []notionapi.RichText{text("Note", nil)}},
+	})
+
+	tests := []struct {
+		name  string
+		nodes []*notionapi.BlockNode
+		opts  *html.Options
+		want  string
+	}{
+		{
+			name: "escapes text and renders annotations",
+			nodes: []*notionapi.BlockNode{paragraph(
+				text("<b>", nil),
+				text("bold", &notionapi.Annotations{Bold: true, Color: notionapi.ColorRed}),
+				notionapi.RichText{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "link", Link: &notionapi.Link{Url: "javascript:alert(1)"}}},
+			)},
+			want: `<p>&lt;b&gt;<span class="color-red"><strong>bold</strong></span><a href="#">link</a></p>` + "\n",
+		},
+		{
+			name:  "groups list items",
+			nodes: []*notionapi.BlockNode{bulleted("one", bulleted("nested")), bulleted("two")},
+			want:  "<ul>\n<li>one\n<ul>\n<li>nested</li>\n</ul>\n</li>\n<li>two</li>\n</ul>\n",
+		},
+		{
+			name:  "custom templates",
+			nodes: []*notionapi.BlockNode{callout},
+			opts: &html.Options{
+				Templates: map[notionapi.BlockType]*template.Template{
+					notionapi.BlockTypeCallout: template.Must(template.New("callout").Funcs(html.Funcs()).Parse(
+						`<div class="alert">{{.Content}}</div>`)),
+				},
+			},
+			want: `<div class="alert">Note</div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := html.Render(&buf, tt.nodes, tt.opts); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Render() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
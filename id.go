@@ -0,0 +1,140 @@
+package notionapi
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var idRe = regexp.MustCompile(`(?i)([0-9a-f]{8})-?([0-9a-f]{4})-?([0-9a-f]{4})-?([0-9a-f]{4})-?([0-9a-f]{12})$`)
+
+// ParseID returns the canonical form of a Notion ID, a lowercase UUID with
+// dashes, from a dashed or undashed ID, or from the URL of a page or database
+// such as https://www.notion.so/workspace/Title-0123456789abcdef0123456789abcdef.
+// For the URL of a page opened in peek mode, the ID of that page is returned.
+func ParseID(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if id, ok := canonicalID(s); ok {
+		return id, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		if u, err = url.Parse("https://" + s); err != nil {
+			return "", fmt.Errorf("invalid Notion ID %q", s)
+		}
+	}
+	if id, ok := canonicalID(u.Query().Get("p")); ok {
+		return id, nil
+	}
+	segment := u.Path[strings.LastIndex(u.Path, "/")+1:]
+	if m := idRe.FindString(segment); m != "" && (len(m) == len(segment) || segment[len(segment)-len(m)-1] == '-') {
+		id, _ := canonicalID(m)
+		return id, nil
+	}
+	return "", fmt.Errorf("invalid Notion ID %q", s)
+}
+
+// URLKind is the kind of object a Notion URL points to.
+type URLKind string
+
+// NotionURL holds the IDs of the URL of a page, a database or a block, as
+// returned by ParseURL.
+type NotionURL struct {
+	Kind URLKind
+	// PageID is the page the URL points to, or the page of the block it
+	// points to.
+	PageID PageID
+	// DatabaseID is the database the URL points to, or the database of the
+	// page opened in peek mode.
+	DatabaseID DatabaseID
+	// BlockID is the block the URL points to.
+	BlockID BlockID
+	// ViewID is the view of the database, canonical like the other IDs.
+	ViewID string
+}
+
+// ParseURL parses the URL of a page, a database or a block, on notion.so or a
+// notion.site domain, and classifies the object it points to:
+//
+//   - the URL of a database view, with a v query parameter, points to a
+//     database, unless a page of the database is opened in peek mode with a
+//     p query parameter;
+//   - the URL with the ID of a block as fragment points to the block;
+//   - other URLs point to a page. The URLs of full page databases opened
+//     without a view cannot be told apart from the URLs of pages.
+func ParseURL(s string) (*NotionURL, error) {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err == nil && u.Host == "" {
+		u, err = url.Parse("https://" + strings.TrimSpace(s))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid Notion URL %q", s)
+	}
+	host := strings.ToLower(u.Hostname())
+	if host != "notion.so" && host != "notion.site" && !strings.HasSuffix(host, ".notion.so") && !strings.HasSuffix(host, ".notion.site") {
+		return nil, fmt.Errorf("invalid Notion URL %q: not a notion.so or notion.site URL", s)
+	}
+
+	segment := u.Path[strings.LastIndex(u.Path, "/")+1:]
+	m := idRe.FindString(segment)
+	if m == "" || (len(m) != len(segment) && segment[len(segment)-len(m)-1] != '-') {
+		return nil, fmt.Errorf("invalid Notion URL %q: no ID in its path", s)
+	}
+	id, _ := canonicalID(m)
+	view, hasView := canonicalID(u.Query().Get("v"))
+	peek, hasPeek := canonicalID(u.Query().Get("p"))
+	block, hasBlock := canonicalID(strings.TrimPrefix(u.Fragment, "block-"))
+
+	switch {
+	case hasPeek:
+		parsed := &NotionURL{Kind: URLKindPage, PageID: PageID(peek), DatabaseID: DatabaseID(id), ViewID: view}
+		if hasBlock {
+			parsed.Kind, parsed.BlockID = URLKindBlock, BlockID(block)
+		}
+		return parsed, nil
+	case hasView:
+		return &NotionURL{Kind: URLKindDatabase, DatabaseID: DatabaseID(id), ViewID: view}, nil
+	case hasBlock:
+		return &NotionURL{Kind: URLKindBlock, PageID: PageID(id), BlockID: BlockID(block)}, nil
+	}
+	return &NotionURL{Kind: URLKindPage, PageID: PageID(id)}, nil
+}
+
+// ParseBlockID is ParseID for the IDs of blocks.
+func ParseBlockID(s string) (BlockID, error) {
+	id, err := ParseID(s)
+	return BlockID(id), err
+}
+
+// ParsePageID is ParseID for the IDs of pages.
+func ParsePageID(s string) (PageID, error) {
+	id, err := ParseID(s)
+	return PageID(id), err
+}
+
+// ParseDatabaseID is ParseID for the IDs of databases.
+func ParseDatabaseID(s string) (DatabaseID, error) {
+	id, err := ParseID(s)
+	return DatabaseID(id), err
+}
+
+// canonicalID returns the canonical form of s if it is a dashed or undashed
+// ID.
+func canonicalID(s string) (string, bool) {
+	m := idRe.FindStringSubmatch(s)
+	if m == nil || len(m[0]) != len(s) {
+		return "", false
+	}
+	return strings.ToLower(strings.Join(m[1:], "-")), true
+}
+
+// pathID returns the canonical form of id to be used in the path of
+// requests, or id itself if it is not an ID ParseID accepts.
+func pathID(id string) string {
+	if canonical, err := ParseID(id); err == nil {
+		return canonical
+	}
+	return id
+}
@@ -0,0 +1,99 @@
+package notionapi_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestParseID(t *testing.T) {
+	const want = "0123abcd-4567-89ef-0123-456789abcdef"
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{name: "dashed", s: want, want: want},
+		{name: "undashed", s: "0123abcd456789ef0123456789abcdef", want: want},
+		{name: "uppercase", s: " 0123ABCD456789EF0123456789ABCDEF ", want: want},
+		{name: "url with title", s: "https://www.notion.so/workspace/My-Page-0123abcd456789ef0123456789abcdef?pvs=4", want: want},
+		{name: "url without scheme", s: "notion.so/0123abcd456789ef0123456789abcdef", want: want},
+		{name: "public url", s: "https://workspace.notion.site/Page-0123abcd456789ef0123456789abcdef#aaaaaaaabbbbccccddddeeeeeeeeeeee", want: want},
+		{
+			name: "url of a page in peek mode",
+			s:    "https://www.notion.so/workspace/fedcba9876543210fedcba9876543210?v=00000000000000000000000000000000&p=0123abcd456789ef0123456789abcdef",
+			want: want,
+		},
+		{name: "too short", s: "0123abcd456789ef0123456789abcde", wantErr: true},
+		{name: "not hexadecimal", s: "0123abcd456789ef0123456789abcdeg", wantErr: true},
+		{name: "url without id", s: "https://www.notion.so/workspace", wantErr: true},
+		{name: "empty", s: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := notionapi.ParseID(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseURL(t *testing.T) {
+	const (
+		a = "0123abcd-4567-89ef-0123-456789abcdef"
+		b = "fedcba98-7654-3210-fedc-ba9876543210"
+		c = "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
+	)
+	tests := []struct {
+		name    string
+		s       string
+		want    *notionapi.NotionURL
+		wantErr bool
+	}{
+		{
+			name: "page",
+			s:    "https://www.notion.so/workspace/My-Page-0123abcd456789ef0123456789abcdef?pvs=4",
+			want: &notionapi.NotionURL{Kind: notionapi.URLKindPage, PageID: a},
+		},
+		{
+			name: "block",
+			s:    "https://workspace.notion.site/Page-0123abcd456789ef0123456789abcdef#aaaaaaaabbbbccccddddeeeeeeeeeeee",
+			want: &notionapi.NotionURL{Kind: notionapi.URLKindBlock, PageID: a, BlockID: c},
+		},
+		{
+			name: "block with prefix",
+			s:    "notion.so/0123abcd456789ef0123456789abcdef#block-aaaaaaaabbbbccccddddeeeeeeeeeeee",
+			want: &notionapi.NotionURL{Kind: notionapi.URLKindBlock, PageID: a, BlockID: c},
+		},
+		{
+			name: "database view",
+			s:    "https://www.notion.so/workspace/fedcba9876543210fedcba9876543210?v=aaaaaaaabbbbccccddddeeeeeeeeeeee",
+			want: &notionapi.NotionURL{Kind: notionapi.URLKindDatabase, DatabaseID: b, ViewID: c},
+		},
+		{
+			name: "page in peek mode",
+			s:    "https://www.notion.so/workspace/fedcba9876543210fedcba9876543210?v=aaaaaaaabbbbccccddddeeeeeeeeeeee&p=0123abcd456789ef0123456789abcdef",
+			want: &notionapi.NotionURL{Kind: notionapi.URLKindPage, PageID: a, DatabaseID: b, ViewID: c},
+		},
+		{name: "other host", s: "https://example.com/0123abcd456789ef0123456789abcdef", wantErr: true},
+		{name: "no id", s: "https://www.notion.so/workspace", wantErr: true},
+		{name: "bare id", s: "0123abcd456789ef0123456789abcdef", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := notionapi.ParseURL(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseURL() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
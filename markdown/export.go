@@ -0,0 +1,248 @@
+package markdown
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// ExportOptions configures ExportPage.
+type ExportOptions struct {
+	// Frontmatter writes the properties of the page as YAML frontmatter.
+	Frontmatter bool
+	// AssetsDir is the directory the files of the page are downloaded to,
+	// relative to the directory of the Markdown file. Defaults to "assets".
+	AssetsDir string
+}
+
+// ExportPage writes the page with the ID specified to a Markdown file in dir,
+// named after its title, and returns the path of the file. The files of its
+// image, file, pdf, video and audio blocks are downloaded along with it, and
+// referenced by their relative path.
+//
+// Properties whose value is computed by Notion, such as formulas, are not
+// written to the frontmatter.
+func ExportPage(ctx context.Context, client *notionapi.Client, pageID notionapi.PageID, dir string, opts *ExportOptions) (string, error) {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+	assetsDir := opts.AssetsDir
+	if assetsDir == "" {
+		assetsDir = "assets"
+	}
+
+	page, err := client.Page.Get(ctx, pageID)
+	if err != nil {
+		return "", err
+	}
+	tree, err := client.Block.GetTree(ctx, notionapi.BlockID(pageID), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	if opts.Frontmatter {
+		writeFrontmatter(&b, page.Properties)
+	}
+	title, _ := page.Properties.Title()
+	if title != "" {
+		fmt.Fprintf(&b, "# %s\n\n", title)
+	}
+	err = Render(&b, tree.Children, &RenderOptions{
+		Asset: func(block notionapi.DownloadableFileBlock) (string, error) {
+			name := fmt.Sprintf("%s-%s", block.GetID(), urlFileName(block.GetURL()))
+			if err := downloadFile(ctx, client, block, filepath.Join(dir, assetsDir, name)); err != nil {
+				return "", err
+			}
+			return path.Join(filepath.ToSlash(assetsDir), url.PathEscape(name)), nil
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	name := sanitizeFileName(title)
+	if name == "" {
+		name = pageID.String()
+	}
+	filePath := filepath.Join(dir, name+".md")
+	if err = ioutil.WriteFile(filePath, b.Bytes(), 0644); err != nil {
+		return "", err
+	}
+	return filePath, nil
+}
+
+func downloadFile(ctx context.Context, client *notionapi.Client, file notionapi.DownloadableFile, filePath string) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	body, err := client.Download(ctx, file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errClose := body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+	}()
+
+	out, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, body); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// urlFileName returns the last element of the path of rawURL.
+func urlFileName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "file"
+	}
+	return sanitizeFileName(path.Base(u.Path))
+}
+
+func sanitizeFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "." || name == ".." {
+		return "file"
+	}
+	return name
+}
+
+var plainYAMLKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_ -]*$`)
+
+// writeFrontmatter writes the properties as YAML frontmatter, sorted by
+// name.
+func writeFrontmatter(b *bytes.Buffer, properties notionapi.Properties) {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	b.WriteString("---\n")
+	for _, name := range names {
+		value, ok := yamlValue(properties, name)
+		if !ok {
+			continue
+		}
+		key := name
+		if !plainYAMLKeyRe.MatchString(key) || strings.HasSuffix(key, " ") {
+			key = strconv.Quote(key)
+		}
+		fmt.Fprintf(b, "%s: %s\n", key, value)
+	}
+	b.WriteString("---\n\n")
+}
+
+// yamlValue returns the value of a property as YAML, and false for the
+// properties that are not written.
+func yamlValue(properties notionapi.Properties, name string) (string, bool) {
+	property := properties[name]
+	if property == nil {
+		return "", false
+	}
+	var (
+		value interface{}
+		err   error
+	)
+	switch property.GetType() {
+	case notionapi.PropertyTypeTitle, notionapi.PropertyTypeRichText:
+		value, err = properties.Text(name)
+	case notionapi.PropertyTypeNumber:
+		value, err = properties.Number(name)
+	case notionapi.PropertyTypeSelect:
+		value, err = properties.Select(name)
+	case notionapi.PropertyTypeStatus:
+		value, err = properties.Status(name)
+	case notionapi.PropertyTypeMultiSelect:
+		value, err = properties.MultiSelect(name)
+	case notionapi.PropertyTypeDate:
+		value, err = properties.Date(name)
+	case notionapi.PropertyTypeCheckbox:
+		value, err = properties.Checkbox(name)
+	case notionapi.PropertyTypeURL:
+		value, err = properties.URL(name)
+	case notionapi.PropertyTypeEmail:
+		value, err = properties.Email(name)
+	case notionapi.PropertyTypePhoneNumber:
+		value, err = properties.PhoneNumber(name)
+	case notionapi.PropertyTypeRelation:
+		var ids []notionapi.PageID
+		ids, err = properties.Relations(name)
+		values := make([]string, len(ids))
+		for i, id := range ids {
+			values[i] = id.String()
+		}
+		value = values
+	case notionapi.PropertyTypePeople:
+		var users []notionapi.User
+		users, err = properties.People(name)
+		values := make([]string, len(users))
+		for i, user := range users {
+			// the IDs of the users without name, which ImportFile reads too
+			values[i] = user.Name
+			if values[i] == "" {
+				values[i] = user.ID.String()
+			}
+		}
+		value = values
+	default:
+		return "", false
+	}
+	if err != nil {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return "null", true
+		}
+		return strconv.Quote(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case time.Time:
+		if v.IsZero() {
+			return "null", true
+		}
+		return v.Format(time.RFC3339), true
+	case []string:
+		quoted := make([]string, len(v))
+		for i, s := range v {
+			quoted[i] = strconv.Quote(s)
+		}
+		return "[" + strings.Join(quoted, ", ") + "]", true
+	}
+	return "", false
+}
@@ -0,0 +1,86 @@
+package markdown_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+	"github.com/robinlbt/notionapi/markdown"
+)
+
+type roundTripFunc func(req *http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func TestExportPage(t *testing.T) {
+	const page = "11111111-1111-1111-1111-111111111111"
+	responses := map[string]string{
+		"/v1/pages/" + page: `{"object":"page","id":"` + page + `","properties":{
+			"Name":{"id":"title","type":"title","title":[{"type":"text","text":{"content":"Q3 Report"},"plain_text":"Q3 Report"}]},
+			"Budget":{"id":"a","type":"number","number":1200},
+			"Tags":{"id":"b","type":"multi_select","multi_select":[{"name":"finance"},{"name":"q3"}]},
+			"Total":{"id":"c","type":"formula","formula":{"type":"number","number":2400}}}}`,
+		"/v1/blocks/" + page: `{"object":"block","id":"` + page + `","type":"child_page","has_children":true,"child_page":{"title":"Q3 Report"}}`,
+		"/v1/blocks/" + page + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"paragraph","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"Revenue is up."},"plain_text":"Revenue is up."}]}},
+			{"object":"block","id":"image","type":"image","image":{"type":"external","external":{"url":"https://files.example.com/chart.png"}}}]}`,
+		"/chart.png": "PNG",
+	}
+	c := &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		body, ok := responses[req.URL.Path]
+		if !ok {
+			t.Fatalf("unexpected request %s", req.URL)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Header:     make(http.Header),
+		}
+	})}
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	dir, err := ioutil.TempDir("", "notionapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	got, err := markdown.ExportPage(context.Background(), client, page, dir, &markdown.ExportOptions{Frontmatter: true})
+	if err != nil {
+		t.Fatalf("ExportPage() error = %v", err)
+	}
+	if want := filepath.Join(dir, "Q3 Report.md"); got != want {
+		t.Errorf("ExportPage() = %s, want %s", got, want)
+	}
+
+	data, err := ioutil.ReadFile(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `---
+Budget: 1200
+Name: "Q3 Report"
+Tags: ["finance", "q3"]
+---
+
+# Q3 Report
+
+Revenue is up.
+
+![](assets/image-chart.png)
+`
+	if string(data) != want {
+		t.Errorf("ExportPage() wrote:\n%s\nwant:\n%s", data, want)
+	}
+	asset, err := ioutil.ReadFile(filepath.Join(dir, "assets", "image-chart.png"))
+	if err != nil || string(asset) != "PNG" {
+		t.Errorf("ExportPage() asset = %q, %v", asset, err)
+	}
+}
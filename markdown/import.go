@@ -0,0 +1,322 @@
+package markdown
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// ImportOptions configures ImportFile.
+type ImportOptions struct {
+	// Image returns the blocks of images. Defaults to UploadImages, relative
+	// to the directory of the file.
+	Image ImageFunc
+}
+
+// ImportFile creates a page in parent, a page or a database, from the
+// Markdown file at path, and returns it.
+//
+// The YAML frontmatter of the file, as written by ExportPage, sets the
+// properties of the page with the same names; it is ignored in pages of pages
+// but for its title key. People are given by their names, email addresses or
+// IDs, looked up with Client.PeopleFromText. The title of the page is the title property of the
+// frontmatter, or else the first level 1 heading starting the document, or
+// else the name of the file.
+func ImportFile(ctx context.Context, client *notionapi.Client, parent notionapi.Parent, path string, opts *ImportOptions) (*notionapi.Page, error) {
+	if opts == nil {
+		opts = &ImportOptions{}
+	}
+	image := opts.Image
+	if image == nil {
+		image = UploadImages(ctx, client, filepath.Dir(path))
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	frontmatter, source, err := splitFrontmatter(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	blocks, err := Parse([]byte(source), &Options{Image: image})
+	if err != nil {
+		return nil, err
+	}
+	if err = notionapi.ValidateBlocks(blocks); err != nil {
+		return nil, err
+	}
+
+	var schema notionapi.PropertyConfigs
+	switch parent.Type {
+	case notionapi.ParentTypeDatabaseID:
+		database, err := client.Database.Get(ctx, parent.DatabaseID)
+		if err != nil {
+			return nil, err
+		}
+		schema = database.Properties
+	case notionapi.ParentTypeDataSourceID:
+		dataSource, err := client.DataSource.Get(ctx, parent.DataSourceID)
+		if err != nil {
+			return nil, err
+		}
+		schema = dataSource.Properties
+	}
+	builder := notionapi.NewPageBuilder(parent)
+	title, err := setProperties(ctx, client, builder, schema, frontmatter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(blocks) > 0 {
+		if heading, ok := blocks[0].(*notionapi.Heading1Block); ok {
+			if text := plainText(heading.Heading1.RichText); title == "" || text == title {
+				title = text
+				blocks = blocks[1:]
+			}
+		}
+	}
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	// the page is created with the blocks one request accepts, and the
+	// others are appended after
+	first := blocks
+	if len(first) > notionapi.MaxAppendBlockChildren {
+		first = first[:notionapi.MaxAppendBlockChildren]
+	}
+	request, err := builder.Title(title).Children(first...).Build()
+	if err != nil {
+		return nil, err
+	}
+	page, err := client.Page.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if rest := blocks[len(first):]; len(rest) > 0 {
+		_, err = client.Block.AppendChildren(ctx, notionapi.BlockID(page.ID), &notionapi.AppendBlockChildrenRequest{Children: rest})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// setProperties sets the properties of builder to the values of frontmatter,
+// and returns the title found in it.
+func setProperties(ctx context.Context, client *notionapi.Client, builder *notionapi.PageBuilder, schema notionapi.PropertyConfigs, frontmatter map[string]interface{}) (string, error) {
+	var title string
+	for name, value := range frontmatter {
+		config, ok := schema[name]
+		if !ok {
+			if name == "title" {
+				title, _ = value.(string)
+			} else if schema != nil {
+				return "", fmt.Errorf("frontmatter: %q is not a property of the database", name)
+			}
+			// the pages of pages only have a title
+			continue
+		}
+		if value == nil {
+			continue
+		}
+
+		var (
+			s, isString = value.(string)
+			list, _     = value.([]interface{})
+		)
+		switch config.GetType() {
+		case notionapi.PropertyConfigTypeTitle:
+			title = fmt.Sprint(value)
+		case notionapi.PropertyConfigTypeRichText:
+			builder.Text(name, fmt.Sprint(value))
+		case notionapi.PropertyConfigTypeNumber:
+			number, ok := value.(float64)
+			if !ok {
+				return "", fmt.Errorf("frontmatter: %q is not a number", name)
+			}
+			builder.Number(name, number)
+		case notionapi.PropertyConfigTypeSelect:
+			builder.Select(name, fmt.Sprint(value))
+		case notionapi.PropertyConfigStatus:
+			builder.Status(name, fmt.Sprint(value))
+		case notionapi.PropertyConfigTypeMultiSelect:
+			builder.MultiSelect(name, yamlStrings(value)...)
+		case notionapi.PropertyConfigTypeDate:
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				t, err = time.Parse("2006-01-02", s)
+			}
+			if !isString || err != nil {
+				return "", fmt.Errorf("frontmatter: %q is not a date", name)
+			}
+			builder.Date(name, t)
+		case notionapi.PropertyConfigTypeCheckbox:
+			checked, ok := value.(bool)
+			if !ok {
+				return "", fmt.Errorf("frontmatter: %q is not a boolean", name)
+			}
+			builder.Checkbox(name, checked)
+		case notionapi.PropertyConfigTypeURL:
+			builder.URL(name, fmt.Sprint(value))
+		case notionapi.PropertyConfigTypeEmail:
+			builder.Property(name, &notionapi.EmailProperty{Type: notionapi.PropertyTypeEmail, Email: fmt.Sprint(value)})
+		case notionapi.PropertyConfigTypePhoneNumber:
+			builder.Property(name, &notionapi.PhoneNumberProperty{Type: notionapi.PropertyTypePhoneNumber, PhoneNumber: fmt.Sprint(value)})
+		case notionapi.PropertyConfigTypeRelation:
+			if list == nil && isString {
+				list = []interface{}{s}
+			}
+			ids := make([]notionapi.PageID, len(list))
+			for i, item := range list {
+				id, err := notionapi.ParsePageID(fmt.Sprint(item))
+				if err != nil {
+					return "", fmt.Errorf("frontmatter: %q: %w", name, err)
+				}
+				ids[i] = id
+			}
+			builder.Relations(name, ids...)
+		case notionapi.PropertyConfigTypePeople:
+			people, err := client.PeopleFromText(ctx, yamlStrings(value)...)
+			if err != nil {
+				return "", fmt.Errorf("frontmatter: %q: %w", name, err)
+			}
+			builder.Property(name, people)
+		default:
+			return "", fmt.Errorf("frontmatter: properties of type %s such as %q cannot be imported", config.GetType(), name)
+		}
+	}
+	return title, nil
+}
+
+// yamlStrings returns a scalar or a list of the frontmatter as strings.
+func yamlStrings(value interface{}) []string {
+	list, ok := value.([]interface{})
+	if !ok {
+		return []string{fmt.Sprint(value)}
+	}
+	values := make([]string, len(list))
+	for i, item := range list {
+		values[i] = fmt.Sprint(item)
+	}
+	return values
+}
+
+// splitFrontmatter returns the frontmatter starting source, if any, and the
+// rest of source. The frontmatter is read as a flat YAML mapping of scalars
+// and flow sequences of scalars, which is what ExportPage writes.
+func splitFrontmatter(source string) (map[string]interface{}, string, error) {
+	lines := splitLines(source)
+	if len(lines) == 0 || strings.TrimRight(lines[0], " \t") != "---" {
+		return nil, source, nil
+	}
+	frontmatter := map[string]interface{}{}
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		if line == "---" || line == "..." {
+			return frontmatter, strings.Join(lines[i+1:], "\n"), nil
+		}
+		if line == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		key, rest, err := yamlKey(line)
+		if err != nil {
+			return nil, "", fmt.Errorf("frontmatter line %d: %w", i+1, err)
+		}
+		value, err := yamlScalarOrList(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, "", fmt.Errorf("frontmatter line %d: %w", i+1, err)
+		}
+		frontmatter[key] = value
+	}
+	return nil, "", fmt.Errorf("frontmatter is not closed")
+}
+
+// yamlKey splits a line of a mapping into its key and the rest of the line.
+func yamlKey(line string) (string, string, error) {
+	if strings.HasPrefix(line, `"`) || strings.HasPrefix(line, "'") {
+		end := closingQuote(line, 0)
+		if end < 0 || !strings.HasPrefix(line[end+1:], ":") {
+			return "", "", fmt.Errorf("invalid key in %q", line)
+		}
+		key, err := yamlScalar(line[:end+1])
+		return fmt.Sprint(key), line[end+2:], err
+	}
+	i := strings.Index(line, ":")
+	if i <= 0 {
+		return "", "", fmt.Errorf("no key in %q", line)
+	}
+	return strings.TrimSpace(line[:i]), line[i+1:], nil
+}
+
+func yamlScalarOrList(s string) (interface{}, error) {
+	if !strings.HasPrefix(s, "[") {
+		return yamlScalar(s)
+	}
+	if !strings.HasSuffix(s, "]") {
+		return nil, fmt.Errorf("unterminated list %q", s)
+	}
+	list := []interface{}{}
+	s = strings.TrimSpace(s[1 : len(s)-1])
+	for s != "" {
+		end := len(s)
+		if s[0] == '"' || s[0] == '\'' {
+			if end = closingQuote(s, 0) + 1; end == 0 {
+				return nil, fmt.Errorf("unterminated string in %q", s)
+			}
+		} else if comma := strings.Index(s, ","); comma >= 0 {
+			end = comma
+		}
+		item, err := yamlScalar(strings.TrimSpace(s[:end]))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, item)
+		s = strings.TrimSpace(s[end:])
+		s = strings.TrimSpace(strings.TrimPrefix(s, ","))
+	}
+	return list, nil
+}
+
+func yamlScalar(s string) (interface{}, error) {
+	switch {
+	case s == "" || s == "~" || s == "null":
+		return nil, nil
+	case s == "true" || s == "false":
+		return s == "true", nil
+	case s[0] == '"':
+		return strconv.Unquote(s)
+	case s[0] == '\'':
+		if len(s) < 2 || s[len(s)-1] != '\'' {
+			return nil, fmt.Errorf("unterminated string %q", s)
+		}
+		return strings.Replace(s[1:len(s)-1], "''", "'", -1), nil
+	}
+	if number, err := strconv.ParseFloat(s, 64); err == nil {
+		return number, nil
+	}
+	return s, nil
+}
+
+// closingQuote returns the index of the quote closing the string starting at
+// s[start], or -1.
+func closingQuote(s string, start int) int {
+	quote := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case quote == '\'' && s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,100 @@
+package markdown_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+	"github.com/robinlbt/notionapi/markdown"
+)
+
+func TestImportFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "notionapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "report.md")
+	source := `---
+Budget: 1200
+Tags: ["finance", 'q3']
+Due: 2021-05-24
+Owners: ["Ada"]
+---
+
+# Q3 Report
+
+Revenue is **up**.
+`
+	if err = ioutil.WriteFile(path, []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		parent notionapi.Parent
+		opts   []notionapi.ClientOption
+	}{
+		{parent: notionapi.Parent{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "db"}},
+		{
+			parent: notionapi.Parent{Type: notionapi.ParentTypeDataSourceID, DataSourceID: "data_source"},
+			opts:   []notionapi.ClientOption{notionapi.WithVersion(notionapi.NotionVersionDataSources)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.parent.Type), func(t *testing.T) {
+			var created map[string]interface{}
+			c := &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+				body := `{"object":"database","id":"db","properties":{
+					"Name":{"id":"title","type":"title","title":{}},
+					"Budget":{"id":"a","type":"number","number":{"format":"number"}},
+					"Tags":{"id":"b","type":"multi_select","multi_select":{"options":[]}},
+					"Due":{"id":"c","type":"date","date":{}},
+					"Owners":{"id":"d","type":"people","people":{}}}}`
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/databases/db":
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/data_sources/data_source":
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/users":
+					body = `{"object":"list","has_more":false,"results":[{"object":"user","id":"user_1","type":"person","name":"Ada"}]}`
+				case req.Method == http.MethodPost && req.URL.Path == "/v1/pages":
+					data, _ := ioutil.ReadAll(req.Body)
+					if err := json.Unmarshal(data, &created); err != nil {
+						t.Fatal(err)
+					}
+					body = `{"object":"page","id":"page"}`
+				default:
+					t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(body)),
+					Header:     make(http.Header),
+				}
+			})}
+			client := notionapi.NewClient("some_token", append(tt.opts, notionapi.WithHTTPClient(c))...)
+
+			page, err := markdown.ImportFile(context.Background(), client, tt.parent, path, nil)
+			if err != nil {
+				t.Fatalf("ImportFile() error = %v", err)
+			}
+			if page.ID != "page" {
+				t.Errorf("ImportFile() = %+v", page)
+			}
+
+			properties, _ := json.Marshal(created["properties"])
+			want := `{"Budget":{"number":1200,"type":"number"},"Due":{"date":{"end":null,"start":"2021-05-24T00:00:00Z"},"type":"date"},"Owners":{"people":[{"id":"user_1","object":"user"}],"type":"people"},"Tags":{"multi_select":[{"name":"finance"},{"name":"q3"}],"type":"multi_select"},"title":{"title":[{"plain_text":"Q3 Report","text":{"content":"Q3 Report"},"type":"text"}],"type":"title"}}`
+			if string(properties) != want {
+				t.Errorf("ImportFile() properties = %s, want %s", properties, want)
+			}
+			children, _ := created["children"].([]interface{})
+			if len(children) != 1 {
+				t.Errorf("ImportFile() children = %v, want the paragraph only", children)
+			}
+		})
+	}
+}
@@ -0,0 +1,354 @@
+package markdown
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/robinlbt/notionapi"
+	"github.com/robinlbt/notionapi/richtext"
+)
+
+// image is an image found in text, which becomes a block of its own.
+type image struct {
+	src string
+	alt string
+}
+
+type inlineStyle struct {
+	annotations notionapi.Annotations
+	link        string
+}
+
+type inlineParser struct {
+	richText []notionapi.RichText
+	images   []image
+}
+
+// parseInline returns the rich text of Markdown text, and the images found
+// in it.
+func parseInline(text string) ([]notionapi.RichText, []image) {
+	p := &inlineParser{richText: []notionapi.RichText{}}
+	p.parse(text, inlineStyle{})
+	return richtext.Split(p.richText), p.images
+}
+
+// textRichText returns content as unstyled rich text.
+func textRichText(content string) []notionapi.RichText {
+	if content == "" {
+		return []notionapi.RichText{}
+	}
+	return richtext.Split([]notionapi.RichText{{
+		Type: notionapi.ObjectTypeText,
+		Text: &notionapi.Text{Content: content},
+	}})
+}
+
+func (p *inlineParser) parse(s string, style inlineStyle) {
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			p.add(text.String(), style)
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]):
+			text.WriteByte(s[i+1])
+			i += 2
+			continue
+		case c == '`':
+			run := runLength(s, i)
+			if end := findCodeSpanEnd(s, i+run, run); end >= 0 {
+				flush()
+				codeStyle := style
+				codeStyle.annotations.Code = true
+				p.add(codeSpanContent(s[i+run:end]), codeStyle)
+				i = end + run
+				continue
+			}
+			text.WriteString(s[i : i+run])
+			i += run
+			continue
+		case c == '!' && i+1 < len(s) && s[i+1] == '[':
+			if label, dest, n, ok := parseLink(s[i+1:]); ok {
+				flush()
+				alt, _ := parseInline(label)
+				p.images = append(p.images, image{src: dest, alt: plainText(alt)})
+				i += 1 + n
+				continue
+			}
+		case c == '[':
+			if label, dest, n, ok := parseLink(s[i:]); ok {
+				flush()
+				linkStyle := style
+				linkStyle.link = dest
+				p.parse(label, linkStyle)
+				i += n
+				continue
+			}
+		case c == '<':
+			if end := strings.IndexByte(s[i:], '>'); end > 0 && isAutolink(s[i+1:i+end]) {
+				flush()
+				linkStyle := style
+				linkStyle.link = s[i+1 : i+end]
+				p.add(s[i+1:i+end], linkStyle)
+				i += end + 1
+				continue
+			}
+		case c == '*' || c == '_' || c == '~':
+			run := runLength(s, i)
+			if (c != '~' || run == 2) && run <= 3 && canOpenEmphasis(s, i, run) {
+				if end := findEmphasisEnd(s, i+run, run); end >= 0 {
+					flush()
+					emphasisStyle := style
+					switch {
+					case c == '~':
+						emphasisStyle.annotations.Strikethrough = true
+					case run == 1:
+						emphasisStyle.annotations.Italic = true
+					case run == 2:
+						emphasisStyle.annotations.Bold = true
+					default:
+						emphasisStyle.annotations.Bold = true
+						emphasisStyle.annotations.Italic = true
+					}
+					p.parse(s[i+run:end], emphasisStyle)
+					i = end + run
+					continue
+				}
+			}
+			text.WriteString(s[i : i+run])
+			i += run
+			continue
+		}
+		text.WriteByte(c)
+		i++
+	}
+	flush()
+}
+
+// add appends content with style, merged with the previous rich text if it
+// has the same style.
+func (p *inlineParser) add(content string, style inlineStyle) {
+	var annotations *notionapi.Annotations
+	if style.annotations != (notionapi.Annotations{}) {
+		a := style.annotations
+		annotations = &a
+	}
+	var link *notionapi.Link
+	if style.link != "" {
+		link = &notionapi.Link{Url: style.link}
+	}
+
+	if n := len(p.richText); n > 0 {
+		last := &p.richText[n-1]
+		if sameAnnotations(last.Annotations, annotations) && sameLink(last.Text.Link, link) {
+			last.Text.Content += content
+			return
+		}
+	}
+	p.richText = append(p.richText, notionapi.RichText{
+		Type:        notionapi.ObjectTypeText,
+		Text:        &notionapi.Text{Content: content, Link: link},
+		Annotations: annotations,
+	})
+}
+
+func sameAnnotations(a, b *notionapi.Annotations) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameLink(a, b *notionapi.Link) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Url == b.Url
+}
+
+func plainText(richText []notionapi.RichText) string {
+	var b strings.Builder
+	for _, rt := range richText {
+		if rt.PlainText == "" && rt.Text != nil {
+			b.WriteString(rt.Text.Content)
+			continue
+		}
+		b.WriteString(rt.PlainText)
+	}
+	return b.String()
+}
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+// runLength returns the number of times s[i] is repeated from i.
+func runLength(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+	return n
+}
+
+// findCodeSpanEnd returns the index of the backtick run of length run closing
+// a code span, or -1.
+func findCodeSpanEnd(s string, from, run int) int {
+	for i := from; i < len(s); {
+		if s[i] != '`' {
+			i++
+			continue
+		}
+		n := runLength(s, i)
+		if n == run {
+			return i
+		}
+		i += n
+	}
+	return -1
+}
+
+func codeSpanContent(content string) string {
+	content = strings.Replace(content, "\n", " ", -1)
+	if len(content) >= 2 && content[0] == ' ' && content[len(content)-1] == ' ' && strings.TrimSpace(content) != "" {
+		content = content[1 : len(content)-1]
+	}
+	return content
+}
+
+func canOpenEmphasis(s string, i, run int) bool {
+	if i+run >= len(s) {
+		return false
+	}
+	next, _ := utf8.DecodeRuneInString(s[i+run:])
+	if unicode.IsSpace(next) {
+		return false
+	}
+	if s[i] == '_' && i > 0 {
+		prev, _ := utf8.DecodeLastRuneInString(s[:i])
+		if unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+			return false
+		}
+	}
+	return true
+}
+
+// findEmphasisEnd returns the index of the delimiter run of length run, made
+// of the delimiter opening at from-run, closing it, or -1. Code spans and
+// delimiter runs of other lengths are skipped.
+func findEmphasisEnd(s string, from, run int) int {
+	delimiter := s[from-1]
+	for i := from; i < len(s); {
+		switch s[i] {
+		case '\\':
+			i += 2
+			continue
+		case '`':
+			n := runLength(s, i)
+			if end := findCodeSpanEnd(s, i+n, n); end >= 0 {
+				i = end + n
+				continue
+			}
+			i += n
+			continue
+		case delimiter:
+			n := runLength(s, i)
+			prev, _ := utf8.DecodeLastRuneInString(s[:i])
+			if n == run && i > from && !unicode.IsSpace(prev) && canCloseEmphasis(s, i+n) {
+				return i
+			}
+			i += n
+			continue
+		}
+		i++
+	}
+	return -1
+}
+
+func canCloseEmphasis(s string, end int) bool {
+	if s[end-1] != '_' || end == len(s) {
+		return true
+	}
+	next, _ := utf8.DecodeRuneInString(s[end:])
+	return !unicode.IsLetter(next) && !unicode.IsDigit(next)
+}
+
+// parseLink parses a link starting at s[0], which is '['. It returns the
+// label and destination of the link and its length.
+func parseLink(s string) (label, dest string, n int, ok bool) {
+	depth := 0
+	closing := -1
+	for i := 0; i < len(s) && closing < 0; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closing = i
+			}
+		}
+	}
+	if closing < 0 || closing+1 >= len(s) || s[closing+1] != '(' {
+		return "", "", 0, false
+	}
+
+	i := closing + 2
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	if i < len(s) && s[i] == '<' {
+		end := strings.IndexByte(s[i:], '>')
+		if end < 0 {
+			return "", "", 0, false
+		}
+		dest = s[i+1 : i+end]
+		i += end + 1
+	} else {
+		start, parens := i, 0
+		for ; i < len(s); i++ {
+			if s[i] == ' ' || (s[i] == ')' && parens == 0) {
+				break
+			}
+			switch s[i] {
+			case '(':
+				parens++
+			case ')':
+				parens--
+			}
+		}
+		dest = s[start:i]
+	}
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+		end := strings.IndexByte(s[i+1:], s[i])
+		if end < 0 {
+			return "", "", 0, false
+		}
+		i += end + 2
+		for i < len(s) && s[i] == ' ' {
+			i++
+		}
+	}
+	if i >= len(s) || s[i] != ')' {
+		return "", "", 0, false
+	}
+	return s[1:closing], dest, i + 1, true
+}
+
+func isAutolink(s string) bool {
+	if strings.ContainsAny(s, " <>") {
+		return false
+	}
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "mailto:")
+}
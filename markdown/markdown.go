@@ -0,0 +1,534 @@
+// Package markdown converts Markdown documents to Notion blocks, and block
+// trees back to Markdown.
+//
+// Parse supports the CommonMark constructs that have a Notion counterpart:
+// ATX and setext headings, paragraphs, bulleted, numbered and task lists,
+// fenced and indented code blocks, block quotes, thematic breaks, GitHub
+// flavored tables and images, along with bold, italic, strikethrough, inline
+// code and links in text. Raw HTML is kept as text.
+package markdown
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// ImageFunc returns the block of an image of the document, given its source
+// and alternative text.
+type ImageFunc func(src, alt string) (notionapi.Block, error)
+
+// Options configures Parse.
+type Options struct {
+	// Image returns the blocks of images. Defaults to ExternalImage.
+	Image ImageFunc
+}
+
+// Parse converts a Markdown document to blocks, ready to be appended with
+// BlockService.AppendChildren.
+//
+// Images are separate blocks in Notion: an image within a paragraph is
+// appended after it.
+func Parse(source []byte, opts *Options) (notionapi.Blocks, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	p := &parser{image: opts.Image}
+	if p.image == nil {
+		p.image = ExternalImage
+	}
+	return p.parseBlocks(splitLines(string(source)))
+}
+
+// ExternalImage returns an image block referencing src as an external URL,
+// with alt as caption.
+func ExternalImage(src, alt string) (notionapi.Block, error) {
+	return &notionapi.ImageBlock{
+		BasicBlock: notionapi.BasicBlock{
+			Object: notionapi.ObjectTypeBlock,
+			Type:   notionapi.BlockTypeImage,
+		},
+		Image: notionapi.Image{
+			Caption:  textRichText(alt),
+			Type:     notionapi.FileTypeExternal,
+			External: &notionapi.FileObject{URL: src},
+		},
+	}, nil
+}
+
+// UploadImages returns an ImageFunc uploading the images whose source is a
+// local path, relative to dir, with FileUploadService.UploadFile. Images
+// with an http or https source are referenced as external URLs.
+func UploadImages(ctx context.Context, client *notionapi.Client, dir string) ImageFunc {
+	return func(src, alt string) (notionapi.Block, error) {
+		if strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") {
+			return ExternalImage(src, alt)
+		}
+		filePath := filepath.FromSlash(src)
+		if !filepath.IsAbs(filePath) {
+			filePath = filepath.Join(dir, filePath)
+		}
+		result, err := client.FileUpload.UploadFile(ctx, filePath, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &notionapi.ImageBlock{
+			BasicBlock: notionapi.BasicBlock{
+				Object: notionapi.ObjectTypeBlock,
+				Type:   notionapi.BlockTypeImage,
+			},
+			Image: notionapi.Image{
+				Caption:    textRichText(alt),
+				Type:       notionapi.FileTypeFileUpload,
+				FileUpload: &notionapi.FileUpload{ID: result.FileUpload.ID},
+			},
+		}, nil
+	}
+}
+
+var (
+	atxHeadingRe     = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))??(?:[ \t]+#+)?[ \t]*$`)
+	thematicBreakRe  = regexp.MustCompile(`^ {0,3}(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	fenceRe          = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^ \t`]*)")
+	listItemRe       = regexp.MustCompile(`^( {0,3})([-+*]|\d{1,9}[.)])( +|$)`)
+	taskRe           = regexp.MustCompile(`^\[([ xX])\](?:[ \t]+|$)`)
+	setextH1Re       = regexp.MustCompile(`^ {0,3}=+[ \t]*$`)
+	setextH2Re       = regexp.MustCompile(`^ {0,3}-+[ \t]*$`)
+	tableDelimiterRe = regexp.MustCompile(`^[ \t]*\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+)
+
+type parser struct {
+	image ImageFunc
+}
+
+// splitLines splits source into lines, with tabs at the start of lines
+// expanded to 4 spaces.
+func splitLines(source string) []string {
+	source = strings.Replace(source, "\r\n", "\n", -1)
+	lines := strings.Split(source, "\n")
+	for i, line := range lines {
+		indent := 0
+		for indent < len(line) && (line[indent] == ' ' || line[indent] == '\t') {
+			indent++
+		}
+		lines[i] = strings.Replace(line[:indent], "\t", "    ", -1) + line[indent:]
+	}
+	return lines
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// startsBlock reports whether line interrupts a paragraph.
+func startsBlock(line string) bool {
+	if atxHeadingRe.MatchString(line) || thematicBreakRe.MatchString(line) || fenceRe.MatchString(line) {
+		return true
+	}
+	if strings.HasPrefix(strings.TrimLeft(line, " "), ">") && indentation(line) < 4 {
+		return true
+	}
+	if m := listItemRe.FindStringSubmatch(line); m != nil && !isBlank(line[len(m[0]):]) {
+		return true
+	}
+	return false
+}
+
+// startsTable reports whether lines[i] is the header row of a table.
+func startsTable(lines []string, i int) bool {
+	return i+1 < len(lines) && strings.Contains(lines[i], "|") && indentation(lines[i]) < 4 &&
+		tableDelimiterRe.MatchString(lines[i+1]) && strings.Contains(lines[i+1], "-")
+}
+
+func (p *parser) parseBlocks(lines []string) (notionapi.Blocks, error) {
+	blocks := notionapi.Blocks{}
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		var (
+			parsed notionapi.Blocks
+			n      int
+			err    error
+		)
+		switch {
+		case isBlank(line):
+			i++
+			continue
+		case fenceRe.MatchString(line):
+			parsed, n = p.parseFencedCode(lines[i:])
+		case indentation(line) >= 4:
+			parsed, n = p.parseIndentedCode(lines[i:])
+		case atxHeadingRe.MatchString(line):
+			m := atxHeadingRe.FindStringSubmatch(line)
+			parsed, err = p.heading(len(m[1]), m[2])
+			n = 1
+		case thematicBreakRe.MatchString(line):
+			parsed, n = notionapi.Blocks{newDivider()}, 1
+		case strings.HasPrefix(strings.TrimLeft(line, " "), ">"):
+			parsed, n, err = p.parseQuote(lines[i:])
+		case listItemRe.MatchString(line):
+			parsed, n, err = p.parseListItem(lines[i:])
+		case startsTable(lines, i):
+			parsed, n, err = p.parseTable(lines[i:])
+		default:
+			parsed, n, err = p.parseParagraph(lines[i:])
+		}
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, parsed...)
+		i += n
+	}
+	return blocks, nil
+}
+
+func (p *parser) parseFencedCode(lines []string) (notionapi.Blocks, int) {
+	m := fenceRe.FindStringSubmatch(lines[0])
+	indent, fence, language := len(m[1]), m[2], m[3]
+
+	var content []string
+	n := 1
+	for ; n < len(lines); n++ {
+		trimmed := strings.TrimSpace(lines[n])
+		if indentation(lines[n]) < 4 && strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			n++
+			break
+		}
+		line := lines[n]
+		for j := 0; j < indent && strings.HasPrefix(line, " "); j++ {
+			line = line[1:]
+		}
+		content = append(content, line)
+	}
+	return notionapi.Blocks{newCode(strings.Join(content, "\n"), language)}, n
+}
+
+func (p *parser) parseIndentedCode(lines []string) (notionapi.Blocks, int) {
+	var content []string
+	n := 0
+	for ; n < len(lines); n++ {
+		if isBlank(lines[n]) {
+			content = append(content, "")
+			continue
+		}
+		if indentation(lines[n]) < 4 {
+			break
+		}
+		content = append(content, lines[n][4:])
+	}
+	for len(content) > 0 && content[len(content)-1] == "" {
+		content = content[:len(content)-1]
+	}
+	return notionapi.Blocks{newCode(strings.Join(content, "\n"), "")}, n
+}
+
+func (p *parser) heading(level int, text string) (notionapi.Blocks, error) {
+	richText, images := parseInline(text)
+	heading := notionapi.Heading{RichText: richText}
+
+	var block notionapi.Block
+	switch level {
+	case 1:
+		block = &notionapi.Heading1Block{BasicBlock: basicBlock(notionapi.BlockTypeHeading1), Heading1: heading}
+	case 2:
+		block = &notionapi.Heading2Block{BasicBlock: basicBlock(notionapi.BlockTypeHeading2), Heading2: heading}
+	default:
+		block = &notionapi.Heading3Block{BasicBlock: basicBlock(notionapi.BlockTypeHeading3), Heading3: heading}
+	}
+	return p.withImages(notionapi.Blocks{block}, images)
+}
+
+func (p *parser) parseQuote(lines []string) (notionapi.Blocks, int, error) {
+	var content []string
+	n := 0
+	for ; n < len(lines); n++ {
+		line := lines[n]
+		trimmed := strings.TrimLeft(line, " ")
+		switch {
+		case strings.HasPrefix(trimmed, ">") && indentation(line) < 4:
+			trimmed = strings.TrimPrefix(trimmed[1:], " ")
+			content = append(content, trimmed)
+			continue
+		case !isBlank(line) && n > 0 && !isBlank(content[len(content)-1]) && !startsBlock(line):
+			// lazy continuation of a paragraph of the quote
+			content = append(content, line)
+			continue
+		}
+		break
+	}
+
+	children, err := p.parseBlocks(content)
+	if err != nil {
+		return nil, 0, err
+	}
+	richText, children := splitFirstParagraph(children)
+	quote := &notionapi.QuoteBlock{
+		BasicBlock: basicBlock(notionapi.BlockTypeQuote),
+		Quote:      notionapi.Quote{RichText: richText, Children: children},
+	}
+	return notionapi.Blocks{quote}, n, nil
+}
+
+func (p *parser) parseListItem(lines []string) (notionapi.Blocks, int, error) {
+	m := listItemRe.FindStringSubmatch(lines[0])
+	marker := m[2]
+	contentIndent := len(m[0])
+	if len(m[3]) > 4 {
+		// the content is an indented code block, only one space is part of
+		// the marker
+		contentIndent = len(m[1]) + len(marker) + 1
+	} else if m[3] == "" {
+		contentIndent = len(m[1]) + len(marker) + 1
+	}
+
+	content := []string{""}
+	if contentIndent < len(lines[0]) {
+		content[0] = lines[0][contentIndent:]
+	}
+	n := 1
+	for ; n < len(lines); n++ {
+		line := lines[n]
+		if isBlank(line) {
+			next := n + 1
+			for next < len(lines) && isBlank(lines[next]) {
+				next++
+			}
+			if next == len(lines) || indentation(lines[next]) < contentIndent {
+				break
+			}
+			content = append(content, "")
+			continue
+		}
+		if indentation(line) >= contentIndent {
+			content = append(content, line[contentIndent:])
+			continue
+		}
+		if !isBlank(content[len(content)-1]) && !startsBlock(line) && !listItemRe.MatchString(line) {
+			// lazy continuation of the paragraph of the item
+			content = append(content, strings.TrimLeft(line, " "))
+			continue
+		}
+		break
+	}
+
+	var (
+		checked bool
+		isTask  bool
+	)
+	if marker == "-" || marker == "+" || marker == "*" {
+		if tm := taskRe.FindStringSubmatch(content[0]); tm != nil {
+			isTask, checked = true, tm[1] != " "
+			content[0] = content[0][len(tm[0]):]
+		}
+	}
+
+	children, err := p.parseBlocks(content)
+	if err != nil {
+		return nil, 0, err
+	}
+	richText, children := splitFirstParagraph(children)
+
+	var block notionapi.Block
+	switch {
+	case isTask:
+		block = &notionapi.ToDoBlock{
+			BasicBlock: basicBlock(notionapi.BlockTypeToDo),
+			ToDo:       notionapi.ToDo{RichText: richText, Children: children, Checked: checked},
+		}
+	case marker == "-" || marker == "+" || marker == "*":
+		block = &notionapi.BulletedListItemBlock{
+			BasicBlock:       basicBlock(notionapi.BlockTypeBulletedListItem),
+			BulletedListItem: notionapi.ListItem{RichText: richText, Children: children},
+		}
+	default:
+		block = &notionapi.NumberedListItemBlock{
+			BasicBlock:       basicBlock(notionapi.BlockTypeNumberedListItem),
+			NumberedListItem: notionapi.ListItem{RichText: richText, Children: children},
+		}
+	}
+	return notionapi.Blocks{block}, n, nil
+}
+
+func (p *parser) parseTable(lines []string) (notionapi.Blocks, int, error) {
+	header := splitTableRow(lines[0])
+	width := len(header)
+
+	var images []image
+	tb := notionapi.NewTableBuilder().ColumnHeader(true)
+	addRow := func(cells []string) {
+		row := make([][]notionapi.RichText, width)
+		for i := range row {
+			if i < len(cells) {
+				var cellImages []image
+				row[i], cellImages = parseInline(cells[i])
+				images = append(images, cellImages...)
+			}
+		}
+		tb.AddRow(row...)
+	}
+	addRow(header)
+
+	n := 2
+	for ; n < len(lines); n++ {
+		if isBlank(lines[n]) || startsBlock(lines[n]) {
+			break
+		}
+		addRow(splitTableRow(lines[n]))
+	}
+
+	table, err := tb.Build()
+	if err != nil {
+		return nil, 0, err
+	}
+	blocks, err := p.withImages(notionapi.Blocks{table}, images)
+	return blocks, n, err
+}
+
+// splitTableRow returns the cells of a row of a table.
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var (
+		cells []string
+		cell  strings.Builder
+	)
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func (p *parser) parseParagraph(lines []string) (notionapi.Blocks, int, error) {
+	var content []string
+	n := 0
+	for ; n < len(lines); n++ {
+		line := lines[n]
+		if n > 0 {
+			if isBlank(line) || startsBlock(line) || startsTable(lines, n) {
+				if setextH2Re.MatchString(line) && !isBlank(line) {
+					blocks, err := p.heading(2, joinParagraphLines(content))
+					return blocks, n + 1, err
+				}
+				break
+			}
+			if setextH1Re.MatchString(line) {
+				blocks, err := p.heading(1, joinParagraphLines(content))
+				return blocks, n + 1, err
+			}
+		}
+		content = append(content, line)
+	}
+
+	text := joinParagraphLines(content)
+	richText, images := parseInline(text)
+	var blocks notionapi.Blocks
+	if !isEmptyRichText(richText) {
+		blocks = append(blocks, &notionapi.ParagraphBlock{
+			BasicBlock: basicBlock(notionapi.BlockTypeParagraph),
+			Paragraph:  notionapi.Paragraph{RichText: richText},
+		})
+	}
+	blocks, err := p.withImages(blocks, images)
+	return blocks, n, err
+}
+
+// joinParagraphLines joins the lines of a paragraph, with soft line breaks
+// turned to spaces and hard line breaks kept.
+func joinParagraphLines(lines []string) string {
+	var b strings.Builder
+	for i, line := range lines {
+		line = strings.TrimLeft(line, " ")
+		if i == len(lines)-1 {
+			b.WriteString(strings.TrimRight(line, " "))
+			break
+		}
+		switch {
+		case strings.HasSuffix(line, "  "):
+			b.WriteString(strings.TrimRight(line, " "))
+			b.WriteString("\n")
+		case strings.HasSuffix(line, `\`) && !strings.HasSuffix(line, `\\`):
+			b.WriteString(line[:len(line)-1])
+			b.WriteString("\n")
+		default:
+			b.WriteString(line)
+			b.WriteString(" ")
+		}
+	}
+	return b.String()
+}
+
+// withImages appends the blocks of images to blocks.
+func (p *parser) withImages(blocks notionapi.Blocks, images []image) (notionapi.Blocks, error) {
+	for _, img := range images {
+		block, err := p.image(img.src, img.alt)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// splitFirstParagraph returns the rich text of the first block if it is a
+// paragraph, for the container of blocks to hold it, and the other blocks.
+func splitFirstParagraph(blocks notionapi.Blocks) ([]notionapi.RichText, notionapi.Blocks) {
+	if len(blocks) > 0 {
+		if paragraph, ok := blocks[0].(*notionapi.ParagraphBlock); ok {
+			return paragraph.Paragraph.RichText, blocks[1:]
+		}
+	}
+	return []notionapi.RichText{}, blocks
+}
+
+func isEmptyRichText(richText []notionapi.RichText) bool {
+	for _, rt := range richText {
+		if rt.Text != nil && strings.TrimSpace(rt.Text.Content) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func basicBlock(blockType notionapi.BlockType) notionapi.BasicBlock {
+	return notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: blockType}
+}
+
+func newDivider() notionapi.Block {
+	return &notionapi.DividerBlock{BasicBlock: basicBlock(notionapi.BlockTypeDivider)}
+}
+
+func newCode(content, language string) notionapi.Block {
+	return &notionapi.CodeBlock{
+		BasicBlock: basicBlock(notionapi.BlockTypeCode),
+		Code: notionapi.Code{
+			RichText: textRichText(content),
+			Language: codeLanguage(language),
+		},
+	}
+}
+
+// codeLanguage returns the Notion language of a code fence info string, or
+// plain text if Notion does not support the language.
+func codeLanguage(language string) string {
+	l, _ := notionapi.NormalizeCodeLanguage(language)
+	return l.String()
+}
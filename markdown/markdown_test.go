@@ -0,0 +1,109 @@
+package markdown_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+	"github.com/robinlbt/notionapi/markdown"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "headings",
+			source: "# One\n## Two ##\n#### Four\nSetext\n---",
+			want: `[{"object":"block","type":"heading_1","heading_1":{"rich_text":[{"type":"text","text":{"content":"One"}}]}},` +
+				`{"object":"block","type":"heading_2","heading_2":{"rich_text":[{"type":"text","text":{"content":"Two"}}]}},` +
+				`{"object":"block","type":"heading_3","heading_3":{"rich_text":[{"type":"text","text":{"content":"Four"}}]}},` +
+				`{"object":"block","type":"heading_2","heading_2":{"rich_text":[{"type":"text","text":{"content":"Setext"}}]}}]`,
+		},
+		{
+			name:   "annotations and links",
+			source: "**b** _i_ ~~s~~ `c` [l](https://example.com)",
+			want: `[{"object":"block","type":"paragraph","paragraph":{"rich_text":[` +
+				`{"type":"text","text":{"content":"b"},"annotations":{"bold":true,"italic":false,"strikethrough":false,"underline":false,"code":false}},` +
+				`{"type":"text","text":{"content":" "}},` +
+				`{"type":"text","text":{"content":"i"},"annotations":{"bold":false,"italic":true,"strikethrough":false,"underline":false,"code":false}},` +
+				`{"type":"text","text":{"content":" "}},` +
+				`{"type":"text","text":{"content":"s"},"annotations":{"bold":false,"italic":false,"strikethrough":true,"underline":false,"code":false}},` +
+				`{"type":"text","text":{"content":" "}},` +
+				`{"type":"text","text":{"content":"c"},"annotations":{"bold":false,"italic":false,"strikethrough":false,"underline":false,"code":true}},` +
+				`{"type":"text","text":{"content":" "}},` +
+				`{"type":"text","text":{"content":"l","link":{"url":"https://example.com"}}}]}}]`,
+		},
+		{
+			name:   "nested and task lists",
+			source: "- [ ] todo\n  1. nested\n* item",
+			want: `[{"object":"block","type":"to_do","to_do":{"rich_text":[{"type":"text","text":{"content":"todo"}}],"children":[` +
+				`{"object":"block","type":"numbered_list_item","numbered_list_item":{"rich_text":[{"type":"text","text":{"content":"nested"}}]}}],"checked":false}},` +
+				`{"object":"block","type":"bulleted_list_item","bulleted_list_item":{"rich_text":[{"type":"text","text":{"content":"item"}}]}}]`,
+		},
+		{
+			name:   "code fences and quotes",
+			source: "```js\nlet a\n```\n> quoted\n\n---",
+			want: `[{"object":"block","type":"code","code":{"rich_text":[{"type":"text","text":{"content":"let a"}}],"language":"javascript"}},` +
+				`{"object":"block","type":"quote","quote":{"rich_text":[{"type":"text","text":{"content":"quoted"}}]}},` +
+				`{"object":"block","type":"divider","divider":{}}]`,
+		},
+		{
+			name:   "tables",
+			source: "| a | b |\n| - | - |\n| 1 |",
+			want: `[{"object":"block","type":"table","table":{"table_width":2,"has_column_header":true,"has_row_header":false,"children":[` +
+				`{"object":"block","type":"table_row","table_row":{"cells":[[{"type":"text","text":{"content":"a"}}],[{"type":"text","text":{"content":"b"}}]]}},` +
+				`{"object":"block","type":"table_row","table_row":{"cells":[[{"type":"text","text":{"content":"1"}}],[]]}}]}}]`,
+		},
+		{
+			name:   "images",
+			source: "See ![a cat](https://example.com/cat.png)",
+			want: `[{"object":"block","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"See "}}]}},` +
+				`{"object":"block","type":"image","image":{"caption":[{"type":"text","text":{"content":"a cat"}}],"type":"external","external":{"url":"https://example.com/cat.png"}}}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks, err := markdown.Parse([]byte(tt.source), nil)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got, err := json.Marshal(blocks)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Parse() got = %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseImageOption(t *testing.T) {
+	var gotSrc string
+	opts := &markdown.Options{
+		Image: func(src, alt string) (notionapi.Block, error) {
+			gotSrc = src
+			return &notionapi.ImageBlock{
+				BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeImage},
+				Image: notionapi.Image{
+					Type:       notionapi.FileTypeFileUpload,
+					FileUpload: &notionapi.FileUpload{ID: "upload_id"},
+				},
+			}, nil
+		},
+	}
+	blocks, err := markdown.Parse([]byte("![](images/cat.png)"), opts)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if gotSrc != "images/cat.png" {
+		t.Errorf("Image() src = %q", gotSrc)
+	}
+	if len(blocks) != 1 || blocks[0].(*notionapi.ImageBlock).Image.FileUpload.ID != "upload_id" {
+		t.Errorf("Parse() got = %v", blocks)
+	}
+}
@@ -0,0 +1,398 @@
+package markdown
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// AssetFunc returns the destination of the file of a block in the rendered
+// Markdown, for instance the path it was downloaded to.
+type AssetFunc func(block notionapi.DownloadableFileBlock) (string, error)
+
+// RenderOptions configures Render.
+type RenderOptions struct {
+	// Asset returns the destinations of the files of image, file, pdf, video
+	// and audio blocks. Defaults to their URL, which expires after an hour
+	// for files hosted by Notion.
+	Asset AssetFunc
+}
+
+// Render writes nodes as GitHub flavored Markdown to w. The nodes are usually
+// the children of a page, as retrieved by BlockService.GetTree.
+//
+// Toggles are rendered as HTML details elements, and underlined text as u
+// elements, which Markdown has no syntax for. Blocks that cannot be
+// represented, such as breadcrumbs, are skipped.
+func Render(w io.Writer, nodes []*notionapi.BlockNode, opts *RenderOptions) error {
+	if opts == nil {
+		opts = &RenderOptions{}
+	}
+	r := &renderer{asset: opts.Asset}
+	if r.asset == nil {
+		r.asset = func(block notionapi.DownloadableFileBlock) (string, error) {
+			return block.GetURL(), nil
+		}
+	}
+	text, err := r.blocks(nodes)
+	if err != nil {
+		return err
+	}
+	if text != "" {
+		text += "\n"
+	}
+	_, err = io.WriteString(w, text)
+	return err
+}
+
+type renderer struct {
+	asset AssetFunc
+}
+
+// blocks renders a sequence of sibling blocks. Consecutive items of a list
+// are rendered as a tight list.
+func (r *renderer) blocks(nodes []*notionapi.BlockNode) (string, error) {
+	var (
+		b        strings.Builder
+		prevType notionapi.BlockType
+		number   int
+	)
+	for _, node := range nodes {
+		blockType := node.Block.GetType()
+		if blockType == notionapi.BlockTypeNumberedListItem && prevType == blockType {
+			number++
+		} else {
+			number = 1
+		}
+
+		text, err := r.block(node, number)
+		if err != nil {
+			return "", err
+		}
+		if text == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			if isListItem(blockType) && blockType == prevType {
+				b.WriteString("\n")
+			} else {
+				b.WriteString("\n\n")
+			}
+		}
+		b.WriteString(text)
+		prevType = blockType
+	}
+	return b.String(), nil
+}
+
+func isListItem(blockType notionapi.BlockType) bool {
+	switch blockType {
+	case notionapi.BlockTypeBulletedListItem, notionapi.BlockTypeNumberedListItem, notionapi.BlockTypeToDo:
+		return true
+	}
+	return false
+}
+
+// block renders a block and its children. number is the position of
+// numbered list items in their list.
+func (r *renderer) block(node *notionapi.BlockNode, number int) (string, error) {
+	children, err := r.blocks(node.Children)
+	if err != nil {
+		return "", err
+	}
+
+	switch block := node.Block.(type) {
+	case *notionapi.ParagraphBlock:
+		return joinBlocks(escapeLineStart(richText(block.Paragraph.RichText)), children), nil
+	case *notionapi.Heading1Block:
+		return heading(1, block.Heading1, children), nil
+	case *notionapi.Heading2Block:
+		return heading(2, block.Heading2, children), nil
+	case *notionapi.Heading3Block:
+		return heading(3, block.Heading3, children), nil
+	case *notionapi.BulletedListItemBlock:
+		return listItem("- ", richText(block.BulletedListItem.RichText), children), nil
+	case *notionapi.NumberedListItemBlock:
+		return listItem(fmt.Sprintf("%d. ", number), richText(block.NumberedListItem.RichText), children), nil
+	case *notionapi.ToDoBlock:
+		marker := "- [ ] "
+		if block.ToDo.Checked {
+			marker = "- [x] "
+		}
+		return listItem(marker, richText(block.ToDo.RichText), children), nil
+	case *notionapi.QuoteBlock:
+		return quote(joinBlocks(richText(block.Quote.RichText), children)), nil
+	case *notionapi.CalloutBlock:
+		text := richText(block.Callout.RichText)
+		if icon := block.Callout.Icon; icon != nil && icon.Emoji != nil {
+			text = string(*icon.Emoji) + " " + text
+		}
+		return quote(joinBlocks(text, children)), nil
+	case *notionapi.ToggleBlock:
+		return toggle(richText(block.Toggle.RichText), children), nil
+	case *notionapi.CodeBlock:
+		return codeBlock(block.Code), nil
+	case *notionapi.EquationBlock:
+		return "$$\n" + block.Equation.Expression + "\n$$", nil
+	case *notionapi.DividerBlock:
+		return "---", nil
+	case *notionapi.TableBlock:
+		return table(block.Table, node.Children), nil
+	case *notionapi.ImageBlock:
+		dest, err := r.asset(block)
+		if err != nil {
+			return "", err
+		}
+		return "![" + escapeText(plainText(block.Image.Caption)) + "](" + destination(dest) + ")", nil
+	case *notionapi.FileBlock:
+		name := block.File.Name
+		if caption := plainText(block.File.Caption); caption != "" {
+			name = caption
+		}
+		return r.fileLink(block, name)
+	case *notionapi.PdfBlock:
+		return r.fileLink(block, plainText(block.Pdf.Caption))
+	case *notionapi.VideoBlock:
+		return r.fileLink(block, plainText(block.Video.Caption))
+	case *notionapi.AudioBlock:
+		return r.fileLink(block, plainText(block.Audio.Caption))
+	case *notionapi.BookmarkBlock:
+		return link(plainText(block.Bookmark.Caption), block.Bookmark.URL), nil
+	case *notionapi.EmbedBlock:
+		return link(plainText(block.Embed.Caption), block.Embed.URL), nil
+	case *notionapi.LinkPreviewBlock:
+		return link("", block.LinkPreview.URL), nil
+	case *notionapi.ChildPageBlock:
+		return link(block.ChildPage.Title, pageURL(block.GetID().String())), nil
+	case *notionapi.ChildDatabaseBlock:
+		return link(block.ChildDatabase.Title, pageURL(block.GetID().String())), nil
+	case *notionapi.LinkToPageBlock:
+		id := block.LinkToPage.PageID.String()
+		if id == "" {
+			id = block.LinkToPage.DatabaseID.String()
+		}
+		return link("", pageURL(id)), nil
+	case *notionapi.ColumnListBlock, *notionapi.ColumnBlock, *notionapi.SyncedBlock, *notionapi.TemplateBlock:
+		return children, nil
+	}
+	return "", nil
+}
+
+func (r *renderer) fileLink(block notionapi.DownloadableFileBlock, name string) (string, error) {
+	dest, err := r.asset(block)
+	if err != nil {
+		return "", err
+	}
+	return link(name, dest), nil
+}
+
+func heading(level int, h notionapi.Heading, children string) string {
+	text := strings.Repeat("#", level) + " " + richText(h.RichText)
+	if h.IsToggleable && children != "" {
+		return toggle(text, children)
+	}
+	return joinBlocks(text, children)
+}
+
+func listItem(marker, text, children string) string {
+	return indent(joinLines(text, children), marker, strings.Repeat(" ", len(marker)))
+}
+
+func quote(text string) string {
+	return indent(text, "> ", "> ")
+}
+
+func toggle(summary, children string) string {
+	return joinBlocks("<details>\n<summary>"+summary+"</summary>", joinBlocks(children, "</details>"))
+}
+
+func codeBlock(code notionapi.Code) string {
+	content := plainText(code.RichText)
+	fence := "```"
+	for strings.Contains(content, fence) {
+		fence += "`"
+	}
+	language := code.Language
+	if language == notionapi.CodeLanguagePlainText.String() {
+		language = ""
+	}
+	return fence + strings.Replace(language, " ", "-", -1) + "\n" + content + "\n" + fence
+}
+
+func table(t notionapi.Table, rows []*notionapi.BlockNode) string {
+	var lines []string
+	writeRow := func(cells [][]notionapi.RichText) {
+		row := make([]string, t.TableWidth)
+		for i := range row {
+			if i < len(cells) {
+				row[i] = strings.Replace(richText(cells[i]), "\\\n", "<br>", -1)
+			}
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+	}
+	delimiter := "|" + strings.Repeat(" --- |", t.TableWidth)
+
+	if !t.HasColumnHeader {
+		writeRow(nil)
+		lines = append(lines, delimiter)
+	}
+	for i, node := range rows {
+		if row, ok := node.Block.(*notionapi.TableRowBlock); ok {
+			writeRow(row.TableRow.Cells)
+		}
+		if i == 0 && t.HasColumnHeader {
+			lines = append(lines, delimiter)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func link(text, dest string) string {
+	if text == "" {
+		text = dest
+	}
+	return "[" + escapeText(text) + "](" + destination(dest) + ")"
+}
+
+// destination returns dest as the destination of a link, enclosed in angle
+// brackets if it contains spaces.
+func destination(dest string) string {
+	if strings.ContainsAny(dest, " ()") {
+		return "<" + dest + ">"
+	}
+	return dest
+}
+
+func pageURL(id string) string {
+	return "https://www.notion.so/" + strings.Replace(id, "-", "", -1)
+}
+
+// joinBlocks joins the Markdown of blocks, separated by a blank line.
+func joinBlocks(blocks ...string) string {
+	return join("\n\n", blocks)
+}
+
+// joinLines joins the Markdown of blocks, separated by a line break.
+func joinLines(blocks ...string) string {
+	return join("\n", blocks)
+}
+
+func join(sep string, blocks []string) string {
+	var nonEmpty []string
+	for _, block := range blocks {
+		if block != "" {
+			nonEmpty = append(nonEmpty, block)
+		}
+	}
+	return strings.Join(nonEmpty, sep)
+}
+
+// indent prefixes the first line of text with first and the others, if not
+// blank, with rest.
+func indent(text, first, rest string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		switch {
+		case i == 0:
+			lines[i] = first + line
+		case line != "" || strings.TrimSpace(rest) != "":
+			lines[i] = rest + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// richText renders rich text as Markdown. Line breaks become hard breaks.
+func richText(richText []notionapi.RichText) string {
+	var b strings.Builder
+	for _, rt := range richText {
+		b.WriteString(richTextItem(rt))
+	}
+	return strings.Replace(b.String(), "\n", "\\\n", -1)
+}
+
+func richTextItem(rt notionapi.RichText) string {
+	content := rt.PlainText
+	if rt.Text != nil && content == "" {
+		content = rt.Text.Content
+	}
+	if rt.Equation != nil {
+		return "$" + rt.Equation.Expression + "$"
+	}
+
+	var annotations notionapi.Annotations
+	if rt.Annotations != nil {
+		annotations = *rt.Annotations
+	}
+
+	// whitespace is kept out of emphasis, which cannot start or end with it
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" {
+		return content
+	}
+	start := strings.Index(content, trimmed)
+	leading, trailing := content[:start], content[start+len(trimmed):]
+
+	text := escapeText(trimmed)
+	if annotations.Code {
+		text = codeSpan(trimmed)
+	}
+	if annotations.Strikethrough {
+		text = "~~" + text + "~~"
+	}
+	if annotations.Italic {
+		text = "*" + text + "*"
+	}
+	if annotations.Bold {
+		text = "**" + text + "**"
+	}
+	if annotations.Underline {
+		text = "<u>" + text + "</u>"
+	}
+	href := rt.Href
+	if rt.Text != nil && rt.Text.Link != nil {
+		href = rt.Text.Link.Url
+	}
+	if href != "" {
+		text = "[" + text + "](" + destination(href) + ")"
+	}
+	return leading + text + trailing
+}
+
+func codeSpan(content string) string {
+	fence := "`"
+	for strings.Contains(content, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(content, "`") || strings.HasSuffix(content, "`") {
+		return fence + " " + content + " " + fence
+	}
+	return fence + content + fence
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `~`, `\~`,
+	`[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`, `|`, `\|`,
+)
+
+var listMarkerRe = regexp.MustCompile(`^(\d{1,9})([.)]) `)
+
+// escapeLineStart escapes the start of the text of a paragraph that would be
+// read as another block.
+func escapeLineStart(text string) string {
+	if m := listMarkerRe.FindStringSubmatch(text); m != nil {
+		return m[1] + `\` + text[len(m[1]):]
+	}
+	if strings.HasPrefix(text, "#") || strings.HasPrefix(text, "- ") || strings.HasPrefix(text, "+ ") {
+		return `\` + text
+	}
+	return text
+}
+
+// escapeText escapes the characters of text that have a meaning in Markdown.
+func escapeText(text string) string {
+	return markdownEscaper.Replace(text)
+}
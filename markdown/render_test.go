@@ -0,0 +1,128 @@
+package markdown_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+	"github.com/robinlbt/notionapi/markdown"
+)
+
+func text(content string, annotations *notionapi.Annotations) notionapi.RichText {
+	return notionapi.RichText{
+		Type:        notionapi.ObjectTypeText,
+		Text:        &notionapi.Text{Content: content},
+		Annotations: annotations,
+		PlainText:   content,
+	}
+}
+
+func node(block notionapi.Block, children ...*notionapi.BlockNode) *notionapi.BlockNode {
+	return &notionapi.BlockNode{Block: block, Children: children}
+}
+
+func basic(blockType notionapi.BlockType) notionapi.BasicBlock {
+	return notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: blockType}
+}
+
+func TestRender(t *testing.T) {
+	bulleted := func(content string, children ...*notionapi.BlockNode) *notionapi.BlockNode {
+		return node(&notionapi.BulletedListItemBlock{
+			BasicBlock:       basic(notionapi.BlockTypeBulletedListItem),
+			BulletedListItem: notionapi.ListItem{RichText: []notionapi.RichText{text(content, nil)}},
+		}, children...)
+	}
+	numbered := func(content string) *notionapi.BlockNode {
+		return node(&notionapi.NumberedListItemBlock{
+			BasicBlock:       basic(notionapi.BlockTypeNumberedListItem),
+			NumberedListItem: notionapi.ListItem{RichText: []notionapi.RichText{text(content, nil)}},
+		})
+	}
+	paragraph := func(richText ...notionapi.RichText) *notionapi.BlockNode {
+		return node(&notionapi.ParagraphBlock{
+			BasicBlock: basic(notionapi.BlockTypeParagraph),
+			Paragraph:  notionapi.Paragraph{RichText: richText},
+		})
+	}
+	row := func(cells ...string) *notionapi.BlockNode {
+		richText := make([][]notionapi.RichText, len(cells))
+		for i, cell := range cells {
+			richText[i] = []notionapi.RichText{text(cell, nil)}
+		}
+		return node(&notionapi.TableRowBlock{BasicBlock: basic(notionapi.BlockTypeTableRowBlock), TableRow: notionapi.TableRow{Cells: richText}})
+	}
+
+	tests := []struct {
+		name  string
+		nodes []*notionapi.BlockNode
+		opts  *markdown.RenderOptions
+		want  string
+	}{
+		{
+			name: "annotations",
+			nodes: []*notionapi.BlockNode{paragraph(
+				text("bold ", &notionapi.Annotations{Bold: true}),
+				text("code", &notionapi.Annotations{Code: true}),
+				text(" a_b", nil),
+			)},
+			want: "**bold** `code` a\\_b\n",
+		},
+		{
+			name: "nested lists",
+			nodes: []*notionapi.BlockNode{
+				bulleted("one", bulleted("nested")),
+				bulleted("two"),
+				numbered("first"),
+				numbered("second"),
+			},
+			want: "- one\n  - nested\n- two\n\n1. first\n2. second\n",
+		},
+		{
+			name: "code, toggles and tables",
+			nodes: []*notionapi.BlockNode{
+				node(&notionapi.CodeBlock{
+					BasicBlock: basic(notionapi.BlockTypeCode),
+					Code:       notionapi.Code{RichText: []notionapi.RichText{text("fmt.Println()", nil)}, Language: "go"},
+				}),
+				node(&notionapi.ToggleBlock{
+					BasicBlock: basic(notionapi.BlockTypeToggle),
+					Toggle:     notionapi.Toggle{RichText: []notionapi.RichText{text("More", nil)}},
+				}, paragraph(text("hidden", nil))),
+				node(&notionapi.TableBlock{
+					BasicBlock: basic(notionapi.BlockTypeTableBlock),
+					Table:      notionapi.Table{TableWidth: 2, HasColumnHeader: true},
+				}, row("a", "b"), row("1", "2")),
+			},
+			want: "```go\nfmt.Println()\n```\n\n<details>\n<summary>More</summary>\n\nhidden\n\n</details>\n\n| a | b |\n| --- | --- |\n| 1 | 2 |\n",
+		},
+		{
+			name: "assets",
+			nodes: []*notionapi.BlockNode{node(&notionapi.ImageBlock{
+				BasicBlock: basic(notionapi.BlockTypeImage),
+				Image: notionapi.Image{
+					Type:    notionapi.FileTypeFile,
+					File:    &notionapi.FileObject{URL: "https://files.example.com/cat.png"},
+					Caption: []notionapi.RichText{text("cat", nil)},
+				},
+			})},
+			opts: &markdown.RenderOptions{
+				Asset: func(block notionapi.DownloadableFileBlock) (string, error) {
+					return "assets/cat.png", nil
+				},
+			},
+			want: "![cat](assets/cat.png)\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := markdown.Render(&buf, tt.nodes, tt.opts); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Render() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package notionapi
+
+// FileSource is the file of a media block: a file hosted by Notion, a file
+// hosted elsewhere or a file uploaded with FileUploadService. Only the last
+// two can be used to create blocks.
+type FileSource struct {
+	Type       FileType
+	File       *FileObject
+	External   *FileObject
+	FileUpload *FileUpload
+}
+
+// ExternalFile returns the source of a file hosted at url.
+func ExternalFile(url string) FileSource {
+	return FileSource{Type: FileTypeExternal, External: &FileObject{URL: url}}
+}
+
+// UploadedFile returns the source of a file uploaded with FileUploadService,
+// which is attached to the block it is used for.
+func UploadedFile(id FileUploadID) FileSource {
+	return FileSource{Type: FileTypeFileUpload, FileUpload: &FileUpload{ID: id}}
+}
+
+// NewAudioBlock returns an audio block playing the file of source.
+func NewAudioBlock(source FileSource, caption ...RichText) *AudioBlock {
+	return &AudioBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypeAudio,
+		},
+		Audio: Audio{
+			Caption:    caption,
+			Type:       source.Type,
+			File:       source.File,
+			External:   source.External,
+			FileUpload: source.FileUpload,
+		},
+	}
+}
+
+// NewVideoBlock returns a video block playing the file of source. External
+// URLs of videos hosted by services such as YouTube are embedded.
+func NewVideoBlock(source FileSource, caption ...RichText) *VideoBlock {
+	return &VideoBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypeVideo,
+		},
+		Video: Video{
+			Caption:    caption,
+			Type:       source.Type,
+			File:       source.File,
+			External:   source.External,
+			FileUpload: source.FileUpload,
+		},
+	}
+}
+
+// NewPdfBlock returns a PDF block displaying the file of source.
+func NewPdfBlock(source FileSource, caption ...RichText) *PdfBlock {
+	return &PdfBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypePdf,
+		},
+		Pdf: Pdf{
+			Caption:    caption,
+			Type:       source.Type,
+			File:       source.File,
+			External:   source.External,
+			FileUpload: source.FileUpload,
+		},
+	}
+}
+
+// NewEmbedBlock returns a block embedding the page at url, such as a tweet or
+// a map.
+func NewEmbedBlock(url string, caption ...RichText) *EmbedBlock {
+	return &EmbedBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypeEmbed,
+		},
+		Embed: Embed{URL: url, Caption: caption},
+	}
+}
+
+// NewBookmarkBlock returns a bookmark of the page at url.
+func NewBookmarkBlock(url string, caption ...RichText) *BookmarkBlock {
+	return &BookmarkBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypeBookmark,
+		},
+		Bookmark: Bookmark{URL: url, Caption: caption},
+	}
+}
@@ -0,0 +1,97 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MembershipReport is the membership of a workspace, as returned by
+// Client.MembershipReport. The users are in the order they are listed.
+type MembershipReport struct {
+	// People are the persons who are members of the workspace.
+	People []User `json:"people"`
+	// Bots are the bots of the integrations of the workspace.
+	Bots []User `json:"bots"`
+	// Guests are the users of MembershipReportOptions.Seen who are not
+	// members. Those that the API does not return are left partial.
+	Guests []User `json:"guests,omitempty"`
+	// External are the People whose email addresses are not in the
+	// MembershipReportOptions.Domains. Empty when no domain is given.
+	External []User `json:"external,omitempty"`
+}
+
+// MembershipReportOptions are the options of Client.MembershipReport.
+type MembershipReportOptions struct {
+	// Seen are users met outside of the list of the users of the workspace,
+	// such as in people properties or the created_by of pages. The API lists
+	// the members only, so the users seen who are not listed are guests.
+	Seen []User
+	// Domains are the email domains of the organization, such as
+	// "example.com". The people with addresses in other domains, who are
+	// usually guests invited as members, are reported as External.
+	Domains []string
+}
+
+// MembershipReport lists the users of the workspace, with Client.UserCache,
+// partitioned into people and bots. Guests are not listed by the API: they
+// are detected among the users of opts.Seen, when given.
+func (c *Client) MembershipReport(ctx context.Context, opts *MembershipReportOptions) (*MembershipReport, error) {
+	if opts == nil {
+		opts = &MembershipReportOptions{}
+	}
+	users, err := c.UserCache().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MembershipReport{People: []User{}, Bots: []User{}}
+	members := map[UserID]bool{}
+	for _, user := range users {
+		members[UserID(pathID(user.ID.String()))] = true
+		switch {
+		case user.Type == UserTypeBot || user.Bot != nil:
+			report.Bots = append(report.Bots, user)
+		default:
+			report.People = append(report.People, user)
+			if len(opts.Domains) > 0 && !inDomains(user, opts.Domains) {
+				report.External = append(report.External, user)
+			}
+		}
+	}
+
+	for _, user := range opts.Seen {
+		key := UserID(pathID(user.ID.String()))
+		if user.ID == "" || members[key] {
+			continue
+		}
+		members[key] = true
+		if user.IsPartial() {
+			full, err := c.User.Get(ctx, user.ID)
+			switch {
+			case err == nil:
+				user = *full
+			case !isUserNotFound(err):
+				return nil, fmt.Errorf("user %s: %w", user.ID, err)
+			}
+		}
+		report.Guests = append(report.Guests, user)
+	}
+	return report, nil
+}
+
+// inDomains reports whether the email address of user is in one of domains.
+// Users whose addresses are not known are assumed to be.
+func inDomains(user User, domains []string) bool {
+	if user.Person == nil || user.Person.Email == "" {
+		return true
+	}
+	at := strings.LastIndex(user.Person.Email, "@")
+	domain := user.Person.Email[at+1:]
+	for _, d := range domains {
+		if strings.EqualFold(domain, strings.TrimPrefix(d, "@")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package notionapi_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientMembershipReport(t *testing.T) {
+	ids := func(users []notionapi.User) []notionapi.UserID {
+		var ids []notionapi.UserID
+		for _, user := range users {
+			ids = append(ids, user.ID)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name         string
+		opts         *notionapi.MembershipReportOptions
+		wantPeople   []notionapi.UserID
+		wantBots     []notionapi.UserID
+		wantGuests   []notionapi.UserID
+		wantExternal []notionapi.UserID
+		wantNames    []string
+	}{
+		{
+			name:       "without options",
+			wantPeople: []notionapi.UserID{"user_1", "user_2"},
+			wantBots:   []notionapi.UserID{"bot_1"},
+		},
+		{
+			name: "guests and domains",
+			opts: &notionapi.MembershipReportOptions{
+				Seen: []notionapi.User{
+					{Object: notionapi.ObjectTypeUser, ID: "user_1"},
+					{Object: notionapi.ObjectTypeUser, ID: "guest_1"},
+					{Object: notionapi.ObjectTypeUser, ID: "guest_2"},
+					{Object: notionapi.ObjectTypeUser, ID: "guest_1"},
+				},
+				Domains: []string{"example.com"},
+			},
+			wantPeople:   []notionapi.UserID{"user_1", "user_2"},
+			wantBots:     []notionapi.UserID{"bot_1"},
+			wantGuests:   []notionapi.UserID{"guest_1", "guest_2"},
+			wantExternal: []notionapi.UserID{"user_2"},
+			wantNames:    []string{"Grace", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(func(req *http.Request) *http.Response {
+				switch req.URL.Path {
+				case "/v1/users":
+					return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+						{"object":"user","id":"user_1","type":"person","name":"Ada","person":{"email":"ada@example.com"}},
+						{"object":"user","id":"bot_1","type":"bot","name":"Sync","bot":{}},
+						{"object":"user","id":"user_2","type":"person","name":"Linus","person":{"email":"linus@other.org"}}]}`)
+				case "/v1/users/guest_1":
+					return newJSONResponse(http.StatusOK, `{"object":"user","id":"guest_1","type":"person","name":"Grace"}`)
+				}
+				return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"Could not find user"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			got, err := client.MembershipReport(context.Background(), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ids(got.People), tt.wantPeople) || !reflect.DeepEqual(ids(got.Bots), tt.wantBots) {
+				t.Errorf("MembershipReport() people = %v bots = %v, want %v %v", ids(got.People), ids(got.Bots), tt.wantPeople, tt.wantBots)
+			}
+			if !reflect.DeepEqual(ids(got.Guests), tt.wantGuests) || !reflect.DeepEqual(ids(got.External), tt.wantExternal) {
+				t.Errorf("MembershipReport() guests = %v external = %v, want %v %v", ids(got.Guests), ids(got.External), tt.wantGuests, tt.wantExternal)
+			}
+			for i, name := range tt.wantNames {
+				if got.Guests[i].Name != name {
+					t.Errorf("guest %d name = %q, want %q", i, got.Guests[i].Name, name)
+				}
+			}
+		})
+	}
+}
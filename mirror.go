@@ -0,0 +1,152 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileManifestName is the name of the manifest written by MirrorPageFiles.
+const FileManifestName = "manifest.json"
+
+// FileManifest lists the files of a page mirrored by MirrorPageFiles.
+type FileManifest struct {
+	PageID PageID         `json:"page_id"`
+	Files  []MirroredFile `json:"files"`
+}
+
+// MirroredFile maps a file of a page to the local file it was written to.
+// Exactly one of BlockID and Property is set.
+type MirroredFile struct {
+	// BlockID is the ID of the file, image, pdf, video or audio block.
+	BlockID BlockID `json:"block_id,omitempty"`
+	// Property is the name of the files property of the page.
+	Property string `json:"property,omitempty"`
+	URL      string `json:"url"`
+	// Path of the local file, relative to the mirror directory.
+	Path string `json:"path"`
+}
+
+// MirrorPageFiles downloads every file referenced by a page to dir: the files
+// of its file, image, pdf, video and audio blocks, at any depth, and the files
+// of its files properties. Child pages and databases are not descended into.
+//
+// The files are named after the block or property they come from, and a
+// manifest mapping them to their local paths is written to FileManifestName.
+func (c *Client) MirrorPageFiles(ctx context.Context, pageID PageID, dir string) (*FileManifest, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	manifest := &FileManifest{PageID: pageID, Files: []MirroredFile{}}
+
+	page, err := c.Page.Get(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	// The properties are mirrored by name, for the manifest to be the same
+	// from one run to the next.
+	names := make([]string, 0, len(page.Properties))
+	for name := range page.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		files, ok := page.Properties[name].(*FilesProperty)
+		if !ok {
+			continue
+		}
+		for i, f := range files.Files {
+			fileName := fmt.Sprintf("%s-%d-%s", sanitizeFileName(name), i, sanitizeFileName(f.Name))
+			pageFile := PageFile{PageID: pageID, Property: name, File: f}
+			if err = c.mirrorFile(ctx, pageFile, filepath.Join(dir, fileName)); err != nil {
+				return nil, err
+			}
+			manifest.Files = append(manifest.Files, MirroredFile{Property: name, URL: f.GetURL(), Path: fileName})
+		}
+	}
+
+	tree, err := c.Block.GetTree(ctx, BlockID(pageID), nil)
+	if err != nil {
+		return nil, err
+	}
+	err = Walk(tree, func(block Block, depth int) error {
+		file, ok := block.(DownloadableFileBlock)
+		if !ok {
+			return nil
+		}
+		fileName := fmt.Sprintf("%s-%s", file.GetID(), fileNameFromURL(file.GetURL()))
+		if err := c.mirrorFile(ctx, file, filepath.Join(dir, fileName)); err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, MirroredFile{BlockID: file.GetID(), URL: file.GetURL(), Path: fileName})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, FileManifestName), data, 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (c *Client) mirrorFile(ctx context.Context, file DownloadableFile, filePath string) error {
+	body, err := c.Download(ctx, file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errClose := body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+	}()
+
+	out, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, body); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// fileNameFromURL returns the last element of the path of rawURL.
+func fileNameFromURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || path.Base(u.Path) == "/" || path.Base(u.Path) == "." {
+		return "file"
+	}
+	return sanitizeFileName(path.Base(u.Path))
+}
+
+// sanitizeFileName replaces the characters that are not portable in file names.
+func sanitizeFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == ".." {
+		return "file"
+	}
+	return name
+}
@@ -0,0 +1,63 @@
+package notionapi_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientMirrorPageFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "notionapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	responses := map[string]string{
+		"https://api.notion.com/v1/pages/some_page": `{"object":"page","id":"some_page","properties":{
+			"Attachments":{"id":"abc","type":"files","files":[{"name":"notes.txt","type":"external","external":{"url":"https://example.com/notes.txt"}}]},
+			"Briefs":{"id":"def","type":"files","files":[{"name":"brief.txt","type":"external","external":{"url":"https://example.com/brief.txt"}}]}}}`,
+		"https://api.notion.com/v1/blocks/some_page": `{"object":"block","id":"some_page","type":"child_page","has_children":true,"child_page":{"title":"Page"}}`,
+		"https://api.notion.com/v1/blocks/some_page/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"toggle_id","type":"toggle","has_children":true,"toggle":{"rich_text":[]}},
+			{"object":"block","id":"child_page_id","type":"child_page","has_children":true,"child_page":{"title":"Child"}}]}`,
+		"https://api.notion.com/v1/blocks/toggle_id/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"image_id","type":"image","image":{"type":"file","file":{"url":"https://files.example.com/dir/cat.png?sig=1"}}}]}`,
+		"https://example.com/notes.txt":               "notes",
+		"https://example.com/brief.txt":               "brief",
+		"https://files.example.com/dir/cat.png?sig=1": "cat",
+	}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		body, ok := responses[req.URL.String()]
+		if !ok {
+			t.Fatalf("unexpected request to %s", req.URL)
+		}
+		return newJSONResponse(http.StatusOK, body)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	got, err := client.MirrorPageFiles(context.Background(), "some_page", dir)
+	if err != nil {
+		t.Fatalf("MirrorPageFiles() error = %v", err)
+	}
+
+	want := []notionapi.MirroredFile{
+		{Property: "Attachments", URL: "https://example.com/notes.txt", Path: "Attachments-0-notes.txt"},
+		{Property: "Briefs", URL: "https://example.com/brief.txt", Path: "Briefs-0-brief.txt"},
+		{BlockID: "image_id", URL: "https://files.example.com/dir/cat.png?sig=1", Path: "image_id-cat.png"},
+	}
+	if !reflect.DeepEqual(got.Files, want) {
+		t.Errorf("MirrorPageFiles() files = %+v, want %+v", got.Files, want)
+	}
+	for _, name := range []string{"Attachments-0-notes.txt", "image_id-cat.png", notionapi.FileManifestName} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("MirrorPageFiles() did not write %s: %v", name, err)
+		}
+	}
+}
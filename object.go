@@ -61,13 +61,88 @@ type TemplateMention struct {
 	TemplateMentionDate string              `json:"template_mention_date,omitempty"`
 }
 
+// LinkPreviewMention is a mention of a link shared with a link preview
+// integration. It is only returned by the API and cannot be created.
+type LinkPreviewMention struct {
+	URL string `json:"url"`
+}
+
+// Mention is the mention variant of rich text. Type tells which one of the
+// other fields is set.
 type Mention struct {
-	Type            MentionType      `json:"type,omitempty"`
-	Database        *DatabaseMention `json:"database,omitempty"`
-	Page            *PageMention     `json:"page,omitempty"`
-	User            *User            `json:"user,omitempty"`
-	Date            *DateObject      `json:"date,omitempty"`
-	TemplateMention *TemplateMention `json:"template_mention,omitempty"`
+	Type            MentionType         `json:"type,omitempty"`
+	Database        *DatabaseMention    `json:"database,omitempty"`
+	Page            *PageMention        `json:"page,omitempty"`
+	User            *User               `json:"user,omitempty"`
+	Date            *DateObject         `json:"date,omitempty"`
+	LinkPreview     *LinkPreviewMention `json:"link_preview,omitempty"`
+	TemplateMention *TemplateMention    `json:"template_mention,omitempty"`
+}
+
+// Value returns the field of the mention its type tells is set: a
+// *DatabaseMention, *PageMention, *User, *DateObject, *LinkPreviewMention or
+// *TemplateMention. It returns nil for unknown types.
+func (m *Mention) Value() interface{} {
+	switch m.Type {
+	case MentionTypeDatabase:
+		return m.Database
+	case MentionTypePage:
+		return m.Page
+	case MentionTypeUser:
+		return m.User
+	case MentionTypeDate:
+		return m.Date
+	case MentionTypeLinkPreview:
+		return m.LinkPreview
+	case MentionTypeTemplateMention:
+		return m.TemplateMention
+	}
+	return nil
+}
+
+// NewUserMention returns rich text mentioning the user with the ID specified.
+func NewUserMention(id UserID) RichText {
+	return newMention(&Mention{Type: MentionTypeUser, User: &User{Object: ObjectTypeUser, ID: id}})
+}
+
+// NewPageMention returns rich text mentioning the page with the ID specified.
+func NewPageMention(id PageID) RichText {
+	return newMention(&Mention{Type: MentionTypePage, Page: &PageMention{ID: ObjectID(id)}})
+}
+
+// NewDatabaseMention returns rich text mentioning the database with the ID
+// specified.
+func NewDatabaseMention(id DatabaseID) RichText {
+	return newMention(&Mention{Type: MentionTypeDatabase, Database: &DatabaseMention{ID: ObjectID(id)}})
+}
+
+// NewDateMention returns rich text mentioning a date, or a date range if end
+// is not nil.
+func NewDateMention(start Date, end *Date) RichText {
+	return newMention(&Mention{Type: MentionTypeDate, Date: &DateObject{Start: &start, End: end}})
+}
+
+// NewTemplateUserMention returns rich text mentioning the user duplicating
+// the template it is in.
+func NewTemplateUserMention() RichText {
+	return newMention(&Mention{Type: MentionTypeTemplateMention, TemplateMention: &TemplateMention{
+		Type:                TemplateMentionTypeUser,
+		TemplateMentionUser: TemplateMentionUserMe,
+	}})
+}
+
+// NewTemplateDateMention returns rich text mentioning the date the template it
+// is in is duplicated: value is TemplateMentionDateToday or
+// TemplateMentionDateNow.
+func NewTemplateDateMention(value string) RichText {
+	return newMention(&Mention{Type: MentionTypeTemplateMention, TemplateMention: &TemplateMention{
+		Type:                TemplateMentionTypeDate,
+		TemplateMentionDate: value,
+	}})
+}
+
+func newMention(mention *Mention) RichText {
+	return RichText{Type: ObjectTypeMention, Mention: mention}
 }
 
 type RichText struct {
@@ -155,6 +230,25 @@ type File struct {
 	External *FileObject `json:"external,omitempty"`
 }
 
+// GetURL returns the external or internal URL depending on the file type.
+func (f File) GetURL() string {
+	if f.File != nil {
+		return f.File.URL
+	}
+	if f.External != nil {
+		return f.External.URL
+	}
+	return ""
+}
+
+// GetExpiryTime returns the expiry time of the URL of files hosted by Notion.
+func (f File) GetExpiryTime() *time.Time {
+	if f.File != nil {
+		return f.File.ExpiryTime
+	}
+	return nil
+}
+
 type FileObject struct {
 	URL        string     `json:"url,omitempty"`
 	ExpiryTime *time.Time `json:"expiry_time,omitempty"`
@@ -180,12 +274,49 @@ func (i Icon) GetURL() string {
 	return ""
 }
 
+// EmojiIcon returns an icon showing emoji.
+func EmojiIcon(emoji string) *Icon {
+	e := Emoji(emoji)
+	return &Icon{Type: FileTypeEmoji, Emoji: &e}
+}
+
+// CustomEmojiIcon returns an icon showing the custom emoji of the workspace
+// with the ID specified.
+func CustomEmojiIcon(id string) *Icon {
+	return &Icon{Type: FileTypeCustomEmoji, CustomEmoji: &CustomEmoji{ID: id}}
+}
+
+// ExternalIcon returns an icon showing the image at url.
+func ExternalIcon(url string) *Icon {
+	return &Icon{Type: FileTypeExternal, External: &FileObject{URL: url}}
+}
+
+// UploadIcon returns an icon showing the image uploaded with
+// FileUploadService with the ID specified.
+func UploadIcon(id FileUploadID) *Icon {
+	return &Icon{Type: FileTypeFileUpload, FileUpload: &FileUpload{ID: id}}
+}
+
+// ExternalCover returns a cover showing the image at url. Covers can be set
+// with the Cover of PageCreateRequest and PageUpdateRequest.
+func ExternalCover(url string) *Image {
+	return &Image{Type: FileTypeExternal, External: &FileObject{URL: url}}
+}
+
+// UploadCover returns a cover showing the image uploaded with
+// FileUploadService with the ID specified.
+func UploadCover(id FileUploadID) *Image {
+	return &Image{Type: FileTypeFileUpload, FileUpload: &FileUpload{ID: id}}
+}
+
 type Emoji string
 
+// CustomEmoji is a custom emoji of a workspace. Only its ID is needed to use it
+// as an icon.
 type CustomEmoji struct {
 	ID   string `json:"id"`
-	Name string `json:"name"`
-	URL  string `json:"url"`
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
 }
 
 type PropertyID string
@@ -65,3 +65,71 @@ func TestColor_MarshalText(t *testing.T) {
 		}
 	})
 }
+
+func TestMention(t *testing.T) {
+	t.Run("decodes every mention type", func(t *testing.T) {
+		data := []byte(`[
+			{"type":"mention","mention":{"type":"user","user":{"object":"user","id":"user_id"}},"plain_text":"@Ada"},
+			{"type":"mention","mention":{"type":"page","page":{"id":"page_id"}},"plain_text":"Page"},
+			{"type":"mention","mention":{"type":"database","database":{"id":"database_id"}},"plain_text":"Database"},
+			{"type":"mention","mention":{"type":"date","date":{"start":"2021-05-24","end":null}},"plain_text":"2021-05-24"},
+			{"type":"mention","mention":{"type":"link_preview","link_preview":{"url":"https://github.com"}},"plain_text":"https://github.com"},
+			{"type":"mention","mention":{"type":"template_mention","template_mention":{"type":"template_mention_user","template_mention_user":"me"}},"plain_text":"@Me"}
+		]`)
+		var richText []notionapi.RichText
+		if err := json.Unmarshal(data, &richText); err != nil {
+			t.Fatal(err)
+		}
+		want := []interface{}{
+			&notionapi.User{}, &notionapi.PageMention{}, &notionapi.DatabaseMention{},
+			&notionapi.DateObject{}, &notionapi.LinkPreviewMention{}, &notionapi.TemplateMention{},
+		}
+		for i, rt := range richText {
+			got := rt.Mention.Value()
+			if reflect.TypeOf(got) != reflect.TypeOf(want[i]) || reflect.ValueOf(got).IsNil() {
+				t.Errorf("Value() of %s mention = %#v", rt.Mention.Type, got)
+			}
+		}
+	})
+
+	t.Run("encodes constructed mentions", func(t *testing.T) {
+		got, err := json.Marshal([]notionapi.RichText{
+			notionapi.NewPageMention("page_id"),
+			notionapi.NewTemplateDateMention(notionapi.TemplateMentionDateToday),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `[{"type":"mention","mention":{"type":"page","page":{"id":"page_id"}}},` +
+			`{"type":"mention","mention":{"type":"template_mention","template_mention":{"type":"template_mention_date","template_mention_date":"today"}}}]`
+		if string(got) != want {
+			t.Errorf("Marshal() got = %s, want %s", got, want)
+		}
+	})
+}
+
+func TestIconsAndCovers(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"emoji icon", notionapi.EmojiIcon("🔥"), `{"type":"emoji","emoji":"🔥"}`},
+		{"custom emoji icon", notionapi.CustomEmojiIcon("emoji_id"), `{"type":"custom_emoji","custom_emoji":{"id":"emoji_id"}}`},
+		{"external icon", notionapi.ExternalIcon("https://example.com/icon.png"), `{"type":"external","external":{"url":"https://example.com/icon.png"}}`},
+		{"upload icon", notionapi.UploadIcon("upload_id"), `{"type":"file_upload","file_upload":{"id":"upload_id"}}`},
+		{"external cover", notionapi.ExternalCover("https://example.com/cover.png"), `{"type":"external","external":{"url":"https://example.com/cover.png"}}`},
+		{"upload cover", notionapi.UploadCover("upload_id"), `{"type":"file_upload","file_upload":{"id":"upload_id"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
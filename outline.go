@@ -0,0 +1,64 @@
+package notionapi
+
+import "context"
+
+// OutlineItem is a heading of a page, with the headings of lower levels that
+// follow it before the next heading of its level or above.
+type OutlineItem struct {
+	// Level is 1, 2 or 3 for heading_1, heading_2 and heading_3 blocks.
+	Level    int
+	Text     string
+	BlockID  BlockID
+	Children []*OutlineItem
+}
+
+// Outline returns the headings of the trees nested by level, in the order
+// they appear, the headings in toggles and columns included. A heading
+// following a heading of a higher level, such as a heading_3 following a
+// heading_1, is nested in it.
+func Outline(trees ...*BlockNode) []*OutlineItem {
+	var roots, stack []*OutlineItem
+	for _, tree := range trees {
+		_ = Walk(tree, func(block Block, depth int) error {
+			level := headingLevel(block)
+			if level == 0 {
+				return nil
+			}
+			item := &OutlineItem{Level: level, Text: block.GetRichTextString(), BlockID: block.GetID()}
+			for len(stack) > 0 && stack[len(stack)-1].Level >= level {
+				stack = stack[:len(stack)-1]
+			}
+			if len(stack) == 0 {
+				roots = append(roots, item)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, item)
+			}
+			stack = append(stack, item)
+			return nil
+		})
+	}
+	return roots
+}
+
+func headingLevel(block Block) int {
+	switch block.GetType() {
+	case BlockTypeHeading1:
+		return 1
+	case BlockTypeHeading2:
+		return 2
+	case BlockTypeHeading3:
+		return 3
+	}
+	return 0
+}
+
+// Outline retrieves the content of the page with the ID specified and returns
+// its headings, as Outline does.
+func (pc *PageClient) Outline(ctx context.Context, id PageID) ([]*OutlineItem, error) {
+	tree, err := pc.apiClient.Block.GetTree(ctx, BlockID(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return Outline(tree.Children...), nil
+}
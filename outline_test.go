@@ -0,0 +1,64 @@
+package notionapi_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestOutline(t *testing.T) {
+	heading := func(level int, text string) *notionapi.BlockNode {
+		rt := []notionapi.RichText{{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: text}, PlainText: text}}
+		basic := notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, ID: notionapi.BlockID(strings.ToLower(text))}
+		var block notionapi.Block
+		switch level {
+		case 1:
+			basic.Type = notionapi.BlockTypeHeading1
+			block = &notionapi.Heading1Block{BasicBlock: basic, Heading1: notionapi.Heading{RichText: rt}}
+		case 2:
+			basic.Type = notionapi.BlockTypeHeading2
+			block = &notionapi.Heading2Block{BasicBlock: basic, Heading2: notionapi.Heading{RichText: rt}}
+		default:
+			basic.Type = notionapi.BlockTypeHeading3
+			block = &notionapi.Heading3Block{BasicBlock: basic, Heading3: notionapi.Heading{RichText: rt}}
+		}
+		return &notionapi.BlockNode{Block: block}
+	}
+	toggle := &notionapi.BlockNode{
+		Block:    &notionapi.ToggleBlock{BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeToggle}},
+		Children: []*notionapi.BlockNode{heading(3, "Nested")},
+	}
+
+	items := notionapi.Outline(
+		heading(2, "Intro"),
+		heading(1, "Usage"),
+		heading(3, "Install"),
+		heading(2, "Configure"),
+		toggle,
+		heading(1, "API"),
+	)
+
+	var got []string
+	var format func(items []*notionapi.OutlineItem, indent string)
+	format = func(items []*notionapi.OutlineItem, indent string) {
+		for _, item := range items {
+			got = append(got, fmt.Sprintf("%s%d %s %s", indent, item.Level, item.Text, item.BlockID))
+			format(item.Children, indent+"  ")
+		}
+	}
+	format(items, "")
+	want := []string{
+		"2 Intro intro",
+		"1 Usage usage",
+		"  3 Install install",
+		"  2 Configure configure",
+		"    3 Nested nested",
+		"1 API api",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Outline() = %q, want %q", got, want)
+	}
+}
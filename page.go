@@ -18,7 +18,18 @@ func (pID PageID) String() string {
 type PageService interface {
 	Create(context.Context, *PageCreateRequest) (*Page, error)
 	Get(context.Context, PageID) (*Page, error)
+	GetWithProperties(ctx context.Context, id PageID, properties []PropertyID) (*Page, error)
 	Update(context.Context, PageID, *PageUpdateRequest) (*Page, error)
+	Archive(context.Context, PageID) (*Page, error)
+	Restore(context.Context, PageID) (*Page, error)
+	Move(context.Context, PageID, Parent) (*Page, error)
+	GetProperty(context.Context, PageID, PropertyID, *Pagination) (*PagePropertyResponse, error)
+	GetPropertyValue(context.Context, PageID, PropertyID) (Property, error)
+	PlainText(context.Context, PageID) (string, error)
+	ListChildPages(context.Context, PageID) ([]ChildPageRef, error)
+	ListChildDatabases(context.Context, PageID) ([]ChildDatabaseRef, error)
+	FindInPage(ctx context.Context, id PageID, query string) ([]PageMatch, error)
+	Outline(context.Context, PageID) ([]*OutlineItem, error)
 }
 
 type PageClient struct {
@@ -41,6 +52,22 @@ type PageClient struct {
 //
 // See https://developers.notion.com/reference/post-page
 func (pc *PageClient) Create(ctx context.Context, requestBody *PageCreateRequest) (*Page, error) {
+	if requestBody != nil {
+		if err := checkCreatable(requestBody.Children); err != nil {
+			return nil, err
+		}
+		defer pc.apiClient.invalidateParentQueries(requestBody.Parent)
+		if pc.apiClient.usesDataSources() && requestBody.Parent.Type == ParentTypeDatabaseID {
+			// pages are created in the data source of the database
+			dataSourceID, err := pc.apiClient.dataSourceID(ctx, requestBody.Parent.DatabaseID)
+			if err != nil {
+				return nil, err
+			}
+			request := *requestBody
+			request.Parent = Parent{Type: ParentTypeDataSourceID, DataSourceID: dataSourceID}
+			requestBody = &request
+		}
+	}
 	res, err := pc.apiClient.request(ctx, http.MethodPost, "pages", nil, requestBody, ContentTypeJSON)
 	if err != nil {
 		return nil, err
@@ -52,7 +79,7 @@ func (pc *PageClient) Create(ctx context.Context, requestBody *PageCreateRequest
 		}
 	}()
 
-	return handlePageResponse(res)
+	return pc.apiClient.handlePageResponse(ctx, res)
 }
 
 // PageCreateRequest represents the request body for PageClient.Create.
@@ -84,7 +111,15 @@ type PageCreateRequest struct {
 //
 // See https://developers.notion.com/reference/get-page
 func (pc *PageClient) Get(ctx context.Context, id PageID) (*Page, error) {
-	res, err := pc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("pages/%s", id.String()), nil, nil, ContentTypeJSON)
+	return pc.GetWithProperties(ctx, id, nil)
+}
+
+// GetWithProperties retrieves the page with the ID specified like Get, with
+// only the properties whose IDs are specified, which shrinks the responses for
+// the pages of databases with many properties. All the properties are returned
+// when properties is empty.
+func (pc *PageClient) GetWithProperties(ctx context.Context, id PageID, properties []PropertyID) (*Page, error) {
+	res, err := pc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("pages/%s", pathID(id.String()))+filterPropertiesQuery(properties), nil, nil, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +130,7 @@ func (pc *PageClient) Get(ctx context.Context, id PageID) (*Page, error) {
 		}
 	}()
 
-	return handlePageResponse(res)
+	return pc.apiClient.handlePageResponse(ctx, res)
 }
 
 // Updates the properties of a page in a database. The properties body param of
@@ -114,7 +149,13 @@ func (pc *PageClient) Get(ctx context.Context, id PageID) (*Page, error) {
 //
 // See https://developers.notion.com/reference/patch-page
 func (pc *PageClient) Update(ctx context.Context, id PageID, request *PageUpdateRequest) (*Page, error) {
-	res, err := pc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("pages/%s", id.String()), nil, request, ContentTypeJSON)
+	return pc.apiClient.patchPage(ctx, id, request)
+}
+
+// patchPage sends body, the fields of the page to update, to the page update
+// endpoint.
+func (c *Client) patchPage(ctx context.Context, id PageID, body interface{}) (*Page, error) {
+	res, err := c.request(ctx, http.MethodPatch, fmt.Sprintf("pages/%s", pathID(id.String())), nil, body, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +166,69 @@ func (pc *PageClient) Update(ctx context.Context, id PageID, request *PageUpdate
 		}
 	}()
 
-	return handlePageResponse(res)
+	page, err := c.handlePageResponse(ctx, res)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateParentQueries(page.Parent)
+	return page, nil
+}
+
+// Archive moves the page with the ID specified to the trash, along with its
+// content. It can be restored with Restore.
+//
+// See https://developers.notion.com/reference/archive-a-page
+func (pc *PageClient) Archive(ctx context.Context, id PageID) (*Page, error) {
+	return pc.Update(ctx, id, &PageUpdateRequest{Archived: true})
+}
+
+// Restore restores the page with the ID specified from the trash.
+//
+// See https://developers.notion.com/reference/archive-a-page
+func (pc *PageClient) Restore(ctx context.Context, id PageID) (*Page, error) {
+	return pc.Update(ctx, id, &PageUpdateRequest{Archived: false})
+}
+
+// pageMoveRequest is the body of the requests to move pages.
+type pageMoveRequest struct {
+	Parent Parent `json:"parent"`
+}
+
+// Move moves the page with the ID specified to parent, a page or a data
+// source. Other parents are rejected before any request is made. Moving pages
+// is not supported by every version of the API: an *UnsupportedEndpointError
+// is returned when the version of the client does not know the endpoint.
+//
+// See https://developers.notion.com/reference/move-page
+func (pc *PageClient) Move(ctx context.Context, id PageID, parent Parent) (*Page, error) {
+	switch {
+	case parent.Type == ParentTypePageID && parent.PageID != "":
+		parent = Parent{Type: parent.Type, PageID: PageID(pathID(parent.PageID.String()))}
+	case parent.Type == ParentTypeDataSourceID && parent.DataSourceID != "":
+		parent = Parent{Type: parent.Type, DataSourceID: DataSourceID(pathID(parent.DataSourceID.String()))}
+	default:
+		return nil, fmt.Errorf("pages can only be moved to a page or a data source, not to a parent of type %q", parent.Type)
+	}
+
+	// the database the page is moved from is not known
+	defer pc.apiClient.InvalidateQueryCache("")
+
+	path := fmt.Sprintf("pages/%s/move", pathID(id.String()))
+	res, err := pc.apiClient.request(ctx, http.MethodPost, path, nil, &pageMoveRequest{Parent: parent}, ContentTypeJSON)
+	if err != nil {
+		if IsErrorCode(err, ErrorCodeInvalidRequestURL) {
+			return nil, &UnsupportedEndpointError{Endpoint: path, Version: pc.apiClient.notionVersion, Err: err}
+		}
+		return nil, err
+	}
+
+	defer func() {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+	}()
+
+	return pc.apiClient.handlePageResponse(ctx, res)
 }
 
 // PageUpdateRequest represents the request body for PageClient.Update.
@@ -142,25 +245,48 @@ type PageUpdateRequest struct {
 	Icon *Icon `json:"icon,omitempty"`
 	// A cover image for the page. Only external file objects are supported.
 	Cover *Image `json:"cover,omitempty"`
+	// Whether the page is in the trash. Set to true to move the page to the
+	// trash, false to restore it. Supersedes Archived in newer versions of the
+	// API.
+	InTrash *bool `json:"in_trash,omitempty"`
+	// Whether the page is locked from editing in the Notion app. Pages can
+	// still be edited through the API when they are locked.
+	IsLocked *bool `json:"is_locked,omitempty"`
+}
+
+// MarshalJSON leaves out an Archived of false when InTrash or IsLocked is set,
+// for it not to restore the page or contradict InTrash.
+func (r PageUpdateRequest) MarshalJSON() ([]byte, error) {
+	type pageUpdateRequest PageUpdateRequest
+	var archived *bool
+	if r.Archived || (r.InTrash == nil && r.IsLocked == nil) {
+		archived = &r.Archived
+	}
+	return json.Marshal(struct {
+		pageUpdateRequest
+		Archived *bool `json:"archived,omitempty"`
+	}{pageUpdateRequest(r), archived})
 }
 
 // The Page object contains the page property values of a single Notion page.
 //
 // See https://developers.notion.com/reference/page
 type Page struct {
-	Object         ObjectType `json:"object"`
-	ID             ObjectID   `json:"id"`
-	CreatedTime    time.Time  `json:"created_time"`
-	LastEditedTime time.Time  `json:"last_edited_time"`
-	CreatedBy      User       `json:"created_by,omitempty"`
-	LastEditedBy   User       `json:"last_edited_by,omitempty"`
-	Archived       bool       `json:"archived"`
-	Properties     Properties `json:"properties"`
-	Parent         Parent     `json:"parent"`
-	URL            string     `json:"url"`
-	PublicURL      string     `json:"public_url"`
-	Icon           *Icon      `json:"icon,omitempty"`
-	Cover          *Image     `json:"cover,omitempty"`
+	Object         ObjectType  `json:"object"`
+	ID             ObjectID    `json:"id"`
+	CreatedTime    time.Time   `json:"created_time"`
+	LastEditedTime time.Time   `json:"last_edited_time"`
+	CreatedBy      PartialUser `json:"created_by,omitempty"`
+	LastEditedBy   PartialUser `json:"last_edited_by,omitempty"`
+	Archived       bool        `json:"archived"`
+	InTrash        bool        `json:"in_trash"`
+	IsLocked       bool        `json:"is_locked"`
+	Properties     Properties  `json:"properties"`
+	Parent         Parent      `json:"parent"`
+	URL            string      `json:"url"`
+	PublicURL      string      `json:"public_url"`
+	Icon           *Icon       `json:"icon,omitempty"`
+	Cover          *Image      `json:"cover,omitempty"`
 }
 
 func (p *Page) GetObject() ObjectType {
@@ -181,14 +307,18 @@ type Parent struct {
 	DatabaseID DatabaseID `json:"database_id,omitempty"`
 	BlockID    BlockID    `json:"block_id,omitempty"`
 	Workspace  bool       `json:"workspace,omitempty"`
+	// DataSourceID is set for the pages of databases in the versions of the
+	// API from 2025-09-03, along with DatabaseID.
+	DataSourceID DataSourceID `json:"data_source_id,omitempty"`
 }
 
-func handlePageResponse(res *http.Response) (*Page, error) {
+func (c *Client) handlePageResponse(ctx context.Context, res *http.Response) (*Page, error) {
 	var response Page
 	err := json.NewDecoder(res.Body).Decode(&response)
 	if err != nil {
 		return nil, err
 	}
 
+	c.hydratePages(ctx, &response)
 	return &response, nil
 }
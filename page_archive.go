@@ -0,0 +1,166 @@
+package notionapi
+
+import "context"
+
+// PageTreeOptions configures Client.ArchivePageTree and
+// Client.RestorePageTree.
+type PageTreeOptions struct {
+	// Progress is called after each page or database is archived or
+	// restored, whether it failed or not, with the number of pages and
+	// databases processed and the total.
+	Progress func(id BlockID, done, total int)
+	// IDs are the pages and databases to restore along with the page, as
+	// returned by ArchivePageTree. The API does not list the pages in the trash
+	// among the children of their parent, so the pages archived one by one can
+	// only be found by ID.
+	IDs []BlockID
+}
+
+// ArchivePageTree moves the page with the ID specified to the trash, along
+// with all of its descendant pages and databases. Archiving a page does not
+// always archive the pages created in it through the API, so they are
+// archived one by one, descendants before their ancestors, and the page
+// last.
+//
+// It returns the IDs of the pages and databases archived, the page last. The
+// operation carries on when some of them fail, and returns a *BatchError.
+func (c *Client) ArchivePageTree(ctx context.Context, id PageID, opts *PageTreeOptions) ([]BlockID, error) {
+	if opts == nil {
+		opts = &PageTreeOptions{}
+	}
+	tree, err := c.Block.GetTree(ctx, BlockID(id), &BlockTreeOptions{IncludeChildPages: true})
+	if err != nil {
+		return nil, err
+	}
+	var descendants []BlockID
+	for _, block := range pageTreeBlocks(tree) {
+		descendants = append(descendants, block.GetID())
+	}
+
+	var (
+		archived []BlockID
+		batchErr = &BatchError{Errors: map[string]error{}, Total: len(descendants) + 1}
+	)
+	report := func(id BlockID, err error) {
+		if err != nil {
+			batchErr.Errors[id.String()] = err
+		} else {
+			archived = append(archived, id)
+		}
+		if opts.Progress != nil {
+			opts.Progress(id, len(archived)+len(batchErr.Errors), batchErr.Total)
+		}
+	}
+	for i := len(descendants) - 1; i >= 0; i-- {
+		_, err := c.Block.Delete(ctx, descendants[i])
+		report(descendants[i], err)
+	}
+	_, err = c.Page.Archive(ctx, id)
+	report(BlockID(id), err)
+
+	if len(batchErr.Errors) > 0 {
+		return archived, batchErr
+	}
+	return archived, nil
+}
+
+// RestorePageTree restores the page with the ID specified from the trash,
+// then the pages and databases of its tree that are still archived, and the
+// pages and databases of opts.IDs.
+//
+// It returns the IDs of the pages and databases restored, the page first. The
+// operation carries on when some of them fail, and returns a *BatchError.
+func (c *Client) RestorePageTree(ctx context.Context, id PageID, opts *PageTreeOptions) ([]BlockID, error) {
+	if opts == nil {
+		opts = &PageTreeOptions{}
+	}
+	if _, err := c.Page.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+	restored := []BlockID{BlockID(id)}
+
+	tree, err := c.Block.GetTree(ctx, BlockID(id), &BlockTreeOptions{IncludeChildPages: true})
+	if err != nil {
+		return restored, err
+	}
+	seen := map[BlockID]bool{BlockID(id): true}
+	var targets []BlockID
+	for _, block := range pageTreeBlocks(tree) {
+		if block.GetArchived() {
+			seen[block.GetID()] = true
+			targets = append(targets, block.GetID())
+		}
+	}
+	for _, target := range opts.IDs {
+		if !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+
+	batchErr := &BatchError{Errors: map[string]error{}, Total: len(targets) + 1}
+	if opts.Progress != nil {
+		opts.Progress(BlockID(id), 1, batchErr.Total)
+	}
+	archived := false
+	for _, target := range targets {
+		if _, err := c.Block.Update(ctx, target, &BlockUpdateRequest{Archived: &archived}); err != nil {
+			batchErr.Errors[target.String()] = err
+		} else {
+			restored = append(restored, target)
+		}
+		if opts.Progress != nil {
+			opts.Progress(target, len(restored)+len(batchErr.Errors), batchErr.Total)
+		}
+	}
+
+	if len(batchErr.Errors) > 0 {
+		return restored, batchErr
+	}
+	return restored, nil
+}
+
+// pageTreeBlocks returns the child pages and child databases of the tree,
+// root excluded, parents before their children.
+func pageTreeBlocks(tree *BlockNode) []Block {
+	var blocks []Block
+	for _, child := range tree.Children {
+		_ = Walk(child, func(block Block, depth int) error {
+			if isChildPageOrDatabase(block) {
+				blocks = append(blocks, block)
+			}
+			return nil
+		})
+	}
+	return blocks
+}
+
+// ListTrashedPages returns the pages in the trash whose title contains query,
+// all of them if it is empty. The API has no endpoint listing the trash: the
+// pages are searched for, and only the trashed pages that search returns are
+// found. They can be restored with RestorePageTree.
+func (c *Client) ListTrashedPages(ctx context.Context, query string) ([]*Page, error) {
+	var (
+		pages   []*Page
+		request = &SearchRequest{
+			Query:    query,
+			Filter:   SearchFilter{Property: "object", Value: ObjectTypePage.String()},
+			PageSize: 100,
+		}
+	)
+	for {
+		res, err := c.Search.Do(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range res.Results {
+			if page, ok := object.(*Page); ok && (page.InTrash || page.Archived) {
+				pages = append(pages, page)
+			}
+		}
+		if !res.HasMore {
+			return pages, nil
+		}
+		request.StartCursor = res.NextCursor
+	}
+}
@@ -0,0 +1,102 @@
+package notionapi_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientArchivePageTree(t *testing.T) {
+	const (
+		root     = "11111111-1111-1111-1111-111111111111"
+		child    = "22222222-2222-2222-2222-222222222222"
+		database = "33333333-3333-3333-3333-333333333333"
+	)
+	responses := map[string]string{
+		"GET /v1/blocks/" + root: `{"object":"block","id":"` + root + `","type":"child_page","has_children":true,"child_page":{"title":"Root"}}`,
+		"GET /v1/blocks/" + root + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"` + child + `","type":"child_page","has_children":true,"child_page":{"title":"Child"}}]}`,
+		"GET /v1/blocks/" + child + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"` + database + `","type":"child_database","child_database":{"title":"Tasks"}}]}`,
+		"DELETE /v1/blocks/" + database: `{"object":"block","id":"` + database + `","type":"child_database","archived":true,"child_database":{"title":"Tasks"}}`,
+		"DELETE /v1/blocks/" + child:    `{"object":"block","id":"` + child + `","type":"child_page","archived":true,"child_page":{"title":"Child"}}`,
+		"PATCH /v1/pages/" + root:       `{"object":"page","id":"` + root + `","archived":true}`,
+	}
+
+	var requests []string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		key := req.Method + " " + req.URL.Path
+		body, ok := responses[key]
+		if !ok {
+			t.Fatalf("unexpected request %s", key)
+		}
+		if req.Method != http.MethodGet {
+			requests = append(requests, key)
+		}
+		if req.Method == http.MethodPatch {
+			data, _ := ioutil.ReadAll(req.Body)
+			if string(data) != `{"archived":true}` {
+				t.Errorf("unexpected body %s", data)
+			}
+		}
+		return newJSONResponse(http.StatusOK, body)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	var progress []int
+	got, err := client.ArchivePageTree(context.Background(), root, &notionapi.PageTreeOptions{
+		Progress: func(id notionapi.BlockID, done, total int) {
+			progress = append(progress, done, total)
+		},
+	})
+	if err != nil {
+		t.Fatalf("ArchivePageTree() error = %v", err)
+	}
+	if want := []notionapi.BlockID{database, child, root}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ArchivePageTree() = %v, want %v", got, want)
+	}
+	if want := []string{"DELETE /v1/blocks/" + database, "DELETE /v1/blocks/" + child, "PATCH /v1/pages/" + root}; !reflect.DeepEqual(requests, want) {
+		t.Errorf("ArchivePageTree() requests = %v, want %v", requests, want)
+	}
+	if want := []int{1, 3, 2, 3, 3, 3}; !reflect.DeepEqual(progress, want) {
+		t.Errorf("ArchivePageTree() progress = %v, want %v", progress, want)
+	}
+}
+
+func TestClientListTrashedPages(t *testing.T) {
+	responses := []string{
+		`{"object":"list","has_more":true,"next_cursor":"next","results":[
+			{"object":"page","id":"trashed","in_trash":true,"archived":true},
+			{"object":"page","id":"live"}]}`,
+		`{"object":"list","has_more":false,"results":[
+			{"object":"page","id":"archived","archived":true}]}`,
+	}
+	var bodies []string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		data, _ := ioutil.ReadAll(req.Body)
+		bodies = append(bodies, string(data))
+		body := responses[0]
+		responses = responses[1:]
+		return newJSONResponse(http.StatusOK, body)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	pages, err := client.ListTrashedPages(context.Background(), "")
+	if err != nil {
+		t.Fatalf("ListTrashedPages() error = %v", err)
+	}
+	var ids []notionapi.ObjectID
+	for _, page := range pages {
+		ids = append(ids, page.ID)
+	}
+	if want := []notionapi.ObjectID{"trashed", "archived"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListTrashedPages() = %v, want %v", ids, want)
+	}
+	if want := `{"filter":{"value":"page","property":"object"},"start_cursor":"next","page_size":100}`; len(bodies) != 2 || bodies[1] != want {
+		t.Errorf("ListTrashedPages() requests = %v, want the second to be %s", bodies, want)
+	}
+}
@@ -0,0 +1,109 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// CreatePagesOptions configures Client.CreatePages.
+type CreatePagesOptions struct {
+	// Concurrency is the number of pages created at the same time. Defaults
+	// to DefaultConcurrency.
+	Concurrency int
+	// MaxRetries is the number of times the creation of a page is retried
+	// when it conflicts with another request or is still rate limited after
+	// the retries of the client. Defaults to 3.
+	MaxRetries int
+	// Backoff is the time waited before the first retry, doubled for each
+	// retry after. Defaults to one second.
+	Backoff time.Duration
+}
+
+// CreatePageResult is the result of the creation of one page by
+// Client.CreatePages: either Page or Err is set.
+type CreatePageResult struct {
+	Page *Page
+	Err  error
+}
+
+// CreatePages creates the pages of requests, opts.Concurrency at a time, and
+// returns the result of each request at its index. The creations that fail
+// with a conflict_error, which Notion returns for concurrent writes to the
+// same database, or that are rate limited are retried.
+//
+// Every page is attempted even if some fail, unless ctx is done: the failures
+// are then reported with a *BatchError whose keys are the indexes of the
+// requests.
+func (c *Client) CreatePages(ctx context.Context, requests []PageCreateRequest, opts *CreatePagesOptions) ([]CreatePageResult, error) {
+	if opts == nil {
+		opts = &CreatePagesOptions{}
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 3
+	}
+	backoff := opts.Backoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+
+	results := make([]CreatePageResult, len(requests))
+	errs := forEachConcurrently(ctx, len(requests), opts.Concurrency, func(i int) error {
+		var err error
+		results[i].Page, err = retryPageRequest(ctx, maxRetries, backoff, func() (*Page, error) {
+			return c.Page.Create(ctx, &requests[i])
+		})
+		return err
+	})
+	for i, err := range errs {
+		results[i].Err = err
+	}
+	if err := newBatchError(errs, strconv.Itoa); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// BulkSetProperty sets the property with the name specified to value on the
+// pages with the IDs specified, DefaultConcurrency at a time. Only the
+// property is sent, the other fields of the pages are left untouched. The
+// updates that conflict or are rate limited are retried like with
+// CreatePages.
+//
+// Every page is attempted even if some fail, unless ctx is done: the failures
+// are then reported with a *BatchError.
+func (c *Client) BulkSetProperty(ctx context.Context, ids []PageID, name string, value Property) error {
+	body := map[string]interface{}{"properties": Properties{name: value}}
+	errs := forEachConcurrently(ctx, len(ids), DefaultConcurrency, func(i int) error {
+		_, err := retryPageRequest(ctx, 3, time.Second, func() (*Page, error) {
+			return c.patchPage(ctx, ids[i], body)
+		})
+		return err
+	})
+	return newBatchError(errs, func(i int) string { return ids[i].String() })
+}
+
+// retryPageRequest calls fn, and calls it again up to maxRetries times while
+// it fails with a conflict or is rate limited.
+func retryPageRequest(ctx context.Context, maxRetries int, backoff time.Duration, fn func() (*Page, error)) (*Page, error) {
+	for attempt := 0; ; attempt++ {
+		page, err := fn()
+		if err == nil || attempt == maxRetries || !isRetryable(err) {
+			return page, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff << uint(attempt)):
+		}
+	}
+}
+
+// isRetryable reports whether a request that failed with err can succeed when
+// sent again.
+func isRetryable(err error) bool {
+	var rateLimited *RateLimitedError
+	return errors.As(err, &rateLimited) || IsErrorCode(err, ErrorCodeConflict) || IsErrorCode(err, ErrorCodeRateLimited)
+}
@@ -0,0 +1,97 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientCreatePages(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts = map[string]int{}
+	)
+	c := newTestClient(func(req *http.Request) *http.Response {
+		var body struct {
+			Properties map[string]struct {
+				Title []struct {
+					PlainText string `json:"plain_text"`
+				} `json:"title"`
+			} `json:"properties"`
+		}
+		data, _ := ioutil.ReadAll(req.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Fatal(err)
+		}
+		title := body.Properties["title"].Title[0].PlainText
+
+		mu.Lock()
+		attempts[title]++
+		attempt := attempts[title]
+		mu.Unlock()
+		switch {
+		case title == "conflict" && attempt == 1:
+			return newJSONResponse(http.StatusConflict, `{"object":"error","status":409,"code":"conflict_error","message":"Conflict occurred while saving."}`)
+		case title == "invalid":
+			return newJSONResponse(http.StatusBadRequest, `{"object":"error","status":400,"code":"validation_error","message":"Invalid page."}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"page","id":"`+title+`"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	var requests []notionapi.PageCreateRequest
+	for _, title := range []string{"first", "conflict", "invalid"} {
+		request, err := notionapi.NewPageBuilder(notionapi.Parent{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "db"}).Title(title).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		requests = append(requests, *request)
+	}
+
+	results, err := client.CreatePages(context.Background(), requests, &notionapi.CreatePagesOptions{Backoff: time.Millisecond})
+	var batchErr *notionapi.BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 1 || batchErr.Errors["2"] == nil {
+		t.Fatalf("CreatePages() error = %v, want a *BatchError for request 2", err)
+	}
+	if results[0].Page.ID != "first" || results[1].Page.ID != "conflict" || results[2].Err == nil {
+		t.Errorf("CreatePages() = %+v", results)
+	}
+	if attempts["conflict"] != 2 || attempts["invalid"] != 1 {
+		t.Errorf("CreatePages() attempts = %v, want the conflict retried once and the invalid page not retried", attempts)
+	}
+}
+
+func TestClientBulkSetProperty(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		bodies = map[string]string{}
+	)
+	c := newTestClient(func(req *http.Request) *http.Response {
+		data, _ := ioutil.ReadAll(req.Body)
+		mu.Lock()
+		bodies[req.URL.Path] = string(data)
+		mu.Unlock()
+		if req.URL.Path == "/v1/pages/missing" {
+			return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"Could not find page."}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"page","id":"page"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	status := &notionapi.SelectProperty{Type: notionapi.PropertyTypeSelect, Select: notionapi.Option{Name: "Done"}}
+	err := client.BulkSetProperty(context.Background(), []notionapi.PageID{"first", "missing", "second"}, "Status", status)
+	var batchErr *notionapi.BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 1 || batchErr.Errors["missing"] == nil || batchErr.Total != 3 {
+		t.Fatalf("BulkSetProperty() error = %v, want a *BatchError for the missing page", err)
+	}
+	if want := `{"properties":{"Status":{"type":"select","select":{"name":"Done"}}}}`; len(bodies) != 3 || bodies["/v1/pages/first"] != want {
+		t.Errorf("BulkSetProperty() requests = %v, want %s", bodies, want)
+	}
+}
@@ -0,0 +1,148 @@
+package notionapi
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PageBuilder builds the PageCreateRequest of a page. Its methods can be
+// chained, and the first error they meet is returned by Build:
+//
+//	request, err := notionapi.NewPageBuilder(parent).
+//		Title("Q3 Report").
+//		Number("Budget", 1200).
+//		Select("Status", "Active").
+//		Icon("📊").
+//		Children(blocks...).
+//		Build()
+type PageBuilder struct {
+	request *PageCreateRequest
+	err     error
+}
+
+// NewPageBuilder returns a builder of a page created in parent, a page, a
+// database or a data source.
+func NewPageBuilder(parent Parent) *PageBuilder {
+	b := &PageBuilder{request: &PageCreateRequest{Parent: parent, Properties: Properties{}}}
+	if parent.Type == "" {
+		b.err = errors.New("the parent of the page has no type")
+	}
+	return b
+}
+
+// Property sets the value of the property with the name specified. The
+// pages of a page can only have a title.
+func (b *PageBuilder) Property(name string, property Property) *PageBuilder {
+	if b.err != nil {
+		return b
+	}
+	if !isDatabaseParent(b.request.Parent) && property.GetType() != PropertyTypeTitle {
+		b.err = fmt.Errorf("property %q: pages whose parent is not a database can only have a title", name)
+		return b
+	}
+	b.request.Properties[name] = property
+	return b
+}
+
+// isDatabaseParent reports whether the pages created in parent have the
+// properties of a schema: those of databases and data sources.
+func isDatabaseParent(parent Parent) bool {
+	return parent.Type == ParentTypeDatabaseID || parent.Type == ParentTypeDataSourceID
+}
+
+// Title sets the title of the page. The title property of databases is named
+// "title" in requests, whatever its name in the schema.
+func (b *PageBuilder) Title(title string) *PageBuilder {
+	return b.Property("title", &TitleProperty{Type: PropertyTypeTitle, Title: textRichText(title)})
+}
+
+// Text sets the value of a rich_text property.
+func (b *PageBuilder) Text(name, text string) *PageBuilder {
+	return b.Property(name, &RichTextProperty{Type: PropertyTypeRichText, RichText: textRichText(text)})
+}
+
+// Number sets the value of a number property.
+func (b *PageBuilder) Number(name string, number float64) *PageBuilder {
+	return b.Property(name, &NumberProperty{Type: PropertyTypeNumber, Number: number})
+}
+
+// Select sets the option of a select property, by name.
+func (b *PageBuilder) Select(name, option string) *PageBuilder {
+	return b.Property(name, &SelectProperty{Type: PropertyTypeSelect, Select: Option{Name: option}})
+}
+
+// MultiSelect sets the options of a multi_select property, by name.
+func (b *PageBuilder) MultiSelect(name string, options ...string) *PageBuilder {
+	multiSelect := make([]Option, len(options))
+	for i, option := range options {
+		multiSelect[i] = Option{Name: option}
+	}
+	return b.Property(name, &MultiSelectProperty{Type: PropertyTypeMultiSelect, MultiSelect: multiSelect})
+}
+
+// Status sets the option of a status property, by name.
+func (b *PageBuilder) Status(name, status string) *PageBuilder {
+	return b.Property(name, &StatusProperty{Type: PropertyTypeStatus, Status: Status{Name: status}})
+}
+
+// Date sets the start of a date property.
+func (b *PageBuilder) Date(name string, t time.Time) *PageBuilder {
+	start := Date(t)
+	return b.Property(name, &DateProperty{Type: PropertyTypeDate, Date: &DateObject{Start: &start}})
+}
+
+// Checkbox sets the value of a checkbox property.
+func (b *PageBuilder) Checkbox(name string, checked bool) *PageBuilder {
+	return b.Property(name, &CheckboxProperty{Type: PropertyTypeCheckbox, Checkbox: checked})
+}
+
+// URL sets the value of a url property.
+func (b *PageBuilder) URL(name, url string) *PageBuilder {
+	return b.Property(name, &URLProperty{Type: PropertyTypeURL, URL: url})
+}
+
+// Relations sets the pages of a relation property.
+func (b *PageBuilder) Relations(name string, ids ...PageID) *PageBuilder {
+	relation := make([]Relation, len(ids))
+	for i, id := range ids {
+		relation[i] = Relation{ID: id}
+	}
+	return b.Property(name, &RelationProperty{Type: PropertyTypeRelation, Relation: relation})
+}
+
+// Icon sets an emoji as the icon of the page.
+func (b *PageBuilder) Icon(emoji string) *PageBuilder {
+	b.request.Icon = EmojiIcon(emoji)
+	return b
+}
+
+// Cover sets the cover of the page, see ExternalCover and UploadCover.
+func (b *PageBuilder) Cover(cover *Image) *PageBuilder {
+	b.request.Cover = cover
+	return b
+}
+
+// Children appends blocks to the content of the page.
+func (b *PageBuilder) Children(blocks ...Block) *PageBuilder {
+	b.request.Children = append(b.request.Children, blocks...)
+	return b
+}
+
+// Build returns the request to create the page, or the first error met while
+// building it.
+func (b *PageBuilder) Build() (*PageCreateRequest, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.request, nil
+}
+
+// textRichText returns the rich text of plain text, split in chunks the API
+// accepts.
+func textRichText(text string) []RichText {
+	if text == "" {
+		return []RichText{}
+	}
+	return SplitRichText([]RichText{{Type: ObjectTypeText, Text: &Text{Content: text}, PlainText: text}})
+}
@@ -0,0 +1,187 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// readOnlyPropertyTypes lists the types of the properties computed by Notion,
+// which cannot be set when creating pages.
+var readOnlyPropertyTypes = map[PropertyType]bool{
+	PropertyTypeFormula:        true,
+	PropertyTypeRollup:         true,
+	PropertyTypeCreatedTime:    true,
+	PropertyTypeCreatedBy:      true,
+	PropertyTypeLastEditedTime: true,
+	PropertyTypeLastEditedBy:   true,
+	PropertyTypeUniqueID:       true,
+	PropertyTypeVerification:   true,
+	PropertyTypeButton:         true,
+}
+
+// DuplicatePageResult is the result of Client.DuplicatePage.
+type DuplicatePageResult struct {
+	// Page is the copy of the page.
+	Page *Page
+	CopyBlockTreeResult
+}
+
+// DuplicatePage copies the page with the ID specified to destParent: its
+// properties, icon, cover and content, with all of its nested blocks. The
+// public API has no way to duplicate pages, so the copy is created again like
+// with CopyBlockTree, and opts are used the same way.
+//
+// The properties computed by Notion, such as formulas and rollups, are not
+// copied. Unless destParent is a database, only the title is. The files of
+// files properties are referenced by URL, even with ReuploadFiles.
+func (c *Client) DuplicatePage(ctx context.Context, source PageID, destParent Parent, opts *CopyBlockTreeOptions) (*DuplicatePageResult, error) {
+	if opts == nil {
+		opts = &CopyBlockTreeOptions{}
+	}
+	page, err := c.Page.Get(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	// the values of the properties of pages are truncated to 25 references
+	if err = c.ResolveFullProperties(ctx, page); err != nil {
+		return nil, err
+	}
+	tree, err := c.Block.GetTree(ctx, BlockID(source), &BlockTreeOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	copier := newBlockCopier(c, opts)
+	properties, err := expandPropertyVariables(duplicableProperties(page.Properties, destParent), opts.Variables)
+	if err != nil {
+		return nil, err
+	}
+	request := &PageCreateRequest{Parent: destParent, Properties: properties}
+	if page.Icon != nil {
+		request.Icon = &Icon{}
+		if err = copier.copyFileObject(ctx, page.Icon, request.Icon); err != nil {
+			return nil, err
+		}
+	}
+	if page.Cover != nil {
+		request.Cover = &Image{}
+		if err = copier.copyFileObject(ctx, page.Cover, request.Cover); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := c.Page.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	copier.result.IDs[BlockID(page.ID)] = BlockID(created.ID)
+	copier.addID(page.ID.String(), created.ID.String())
+
+	if _, err = copier.copyNodes(ctx, BlockID(created.ID), "", tree.Children); err != nil {
+		return nil, err
+	}
+	return &DuplicatePageResult{Page: created, CopyBlockTreeResult: *copier.result}, nil
+}
+
+// CreatePageFromTemplate creates a page in destParent from the page with the
+// ID specified, used as a template: the page is duplicated with
+// DuplicatePage, and vars are substituted for the {{name}} placeholders of the
+// text of its properties and content. See CopyBlockTreeOptions.Variables.
+func (c *Client) CreatePageFromTemplate(ctx context.Context, template PageID, destParent Parent, vars map[string]string, opts *CopyBlockTreeOptions) (*DuplicatePageResult, error) {
+	withVars := CopyBlockTreeOptions{}
+	if opts != nil {
+		withVars = *opts
+	}
+	withVars.Variables = vars
+	return c.DuplicatePage(ctx, template, destParent, &withVars)
+}
+
+// duplicableProperties returns the properties that can be set on a copy of a
+// page created in parent.
+func duplicableProperties(properties Properties, parent Parent) Properties {
+	duplicable := Properties{}
+	for name, property := range properties {
+		if property == nil || readOnlyPropertyTypes[property.GetType()] {
+			continue
+		}
+		if !isDatabaseParent(parent) {
+			if property.GetType() == PropertyTypeTitle {
+				duplicable["title"] = property
+			}
+			continue
+		}
+		switch p := property.(type) {
+		case *FilesProperty:
+			property = externalFiles(p)
+		case FilesProperty:
+			property = externalFiles(&p)
+		case *RelationProperty:
+			relation := *p
+			relation.HasMore = false
+			property = &relation
+		}
+		duplicable[name] = property
+	}
+	return duplicable
+}
+
+// expandPropertyVariables returns properties with vars substituted for their
+// placeholders.
+func expandPropertyVariables(properties Properties, vars map[string]string) (Properties, error) {
+	if len(vars) == 0 {
+		return properties, nil
+	}
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]interface{}
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	expandVariables(raw, vars)
+	if data, err = json.Marshal(raw); err != nil {
+		return nil, err
+	}
+	var expanded Properties
+	if err = json.Unmarshal(data, &expanded); err != nil {
+		return nil, err
+	}
+	return expanded, nil
+}
+
+// externalFiles returns a copy of a files property where the files hosted by
+// Notion are referenced by URL.
+func externalFiles(property *FilesProperty) *FilesProperty {
+	files := &FilesProperty{ID: property.ID, Type: property.Type, Files: make([]File, len(property.Files))}
+	for i, file := range property.Files {
+		if file.Type == FileTypeFile && file.File != nil {
+			file = File{Name: file.Name, Type: FileTypeExternal, External: &FileObject{URL: file.File.URL}}
+		}
+		files.Files[i] = file
+	}
+	return files
+}
+
+// copyFileObject copies from, an icon or an image, to to, with a file that can
+// be used to create pages in place of the file hosted by Notion of from.
+func (bc *blockCopier) copyFileObject(ctx context.Context, from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	var content map[string]interface{}
+	if err = json.Unmarshal(data, &content); err != nil {
+		return err
+	}
+	if file, ok := content[string(FileTypeFile)].(map[string]interface{}); ok && content["type"] == string(FileTypeFile) {
+		url, _ := file["url"].(string)
+		if err = bc.copyFile(ctx, File{Type: FileTypeFile, File: &FileObject{URL: url}}, content); err != nil {
+			return err
+		}
+	}
+	if data, err = json.Marshal(content); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
@@ -0,0 +1,182 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientDuplicatePage(t *testing.T) {
+	const (
+		source = "11111111-1111-1111-1111-111111111111"
+		copied = "22222222-2222-2222-2222-222222222222"
+		table  = "33333333-3333-3333-3333-333333333333"
+	)
+	responses := map[string]string{
+		"/v1/pages/" + source: `{"object":"page","id":"` + source + `","parent":{"type":"database_id","database_id":"db"},
+			"icon":{"type":"emoji","emoji":"📊"},
+			"cover":{"type":"file","file":{"url":"https://files.example.com/cover.png","expiry_time":"2021-05-24T06:06:34.827Z"}},
+			"properties":{
+				"Name":{"id":"title","type":"title","title":[{"type":"text","text":{"content":"Q3 Report"},"plain_text":"Q3 Report"}]},
+				"Budget":{"id":"a","type":"number","number":1200},
+				"Total":{"id":"b","type":"formula","formula":{"type":"number","number":2400}},
+				"Created":{"id":"c","type":"created_time","created_time":"2021-05-24T05:06:34.827Z"}}}`,
+		"/v1/blocks/" + source: `{"object":"block","id":"` + source + `","type":"child_page","has_children":true,"child_page":{"title":"Q3 Report"}}`,
+		"/v1/blocks/" + source + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"` + table + `","type":"table","has_children":true,"table":{"table_width":1,"has_column_header":true}}]}`,
+		"/v1/blocks/" + table + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"row","type":"table_row","table_row":{"cells":[[{"type":"text","text":{"content":"cell"},"plain_text":"cell"}]]}}]}`,
+	}
+
+	var created, appended map[string]interface{}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/pages":
+			data, _ := ioutil.ReadAll(req.Body)
+			if err := json.Unmarshal(data, &created); err != nil {
+				t.Fatal(err)
+			}
+			return newJSONResponse(http.StatusOK, `{"object":"page","id":"`+copied+`"}`)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/blocks/"+copied+"/children":
+			data, _ := ioutil.ReadAll(req.Body)
+			if err := json.Unmarshal(data, &appended); err != nil {
+				t.Fatal(err)
+			}
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[{"object":"block","id":"44444444-4444-4444-4444-444444444444","type":"table","table":{}}]}`)
+		}
+		body, ok := responses[req.URL.Path]
+		if !ok {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		}
+		return newJSONResponse(http.StatusOK, body)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	parents := []notionapi.Parent{
+		{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "db"},
+		{Type: notionapi.ParentTypeDataSourceID, DataSourceID: "data_source"},
+	}
+	for _, parent := range parents {
+		t.Run(string(parent.Type), func(t *testing.T) {
+			created, appended = nil, nil
+			got, err := client.DuplicatePage(context.Background(), source, parent, nil)
+			if err != nil {
+				t.Fatalf("DuplicatePage() error = %v", err)
+			}
+			if got.Page.ID != copied || got.IDs[source] != copied {
+				t.Errorf("DuplicatePage() = %+v", got)
+			}
+
+			properties, _ := created["properties"].(map[string]interface{})
+			for _, name := range []string{"Name", "Budget"} {
+				if _, ok := properties[name]; !ok {
+					t.Errorf("DuplicatePage() did not copy property %s", name)
+				}
+			}
+			for _, name := range []string{"Total", "Created"} {
+				if _, ok := properties[name]; ok {
+					t.Errorf("DuplicatePage() copied read-only property %s", name)
+				}
+			}
+			cover, _ := json.Marshal(created["cover"])
+			if want := `{"external":{"url":"https://files.example.com/cover.png"},"type":"external"}`; string(cover) != want {
+				t.Errorf("DuplicatePage() cover = %s, want %s", cover, want)
+			}
+			children, _ := json.Marshal(appended["children"])
+			if !strings.Contains(string(children), `"table_row"`) {
+				t.Errorf("DuplicatePage() did not copy the rows of the table: %s", children)
+			}
+		})
+	}
+}
+
+func TestClientDuplicatePageFullProperties(t *testing.T) {
+	const (
+		source = "11111111-1111-1111-1111-111111111111"
+		copied = "22222222-2222-2222-2222-222222222222"
+	)
+	responses := map[string]string{
+		"/v1/pages/" + source: `{"object":"page","id":"` + source + `","parent":{"type":"database_id","database_id":"db"},
+			"properties":{"Tasks":{"id":"rel","type":"relation","relation":[{"id":"page_1"}],"has_more":true}}}`,
+		"/v1/pages/" + source + "/properties/rel": `{"object":"list","has_more":false,"type":"property_item",
+			"property_item":{"id":"rel","type":"relation","relation":{}},
+			"results":[
+				{"object":"property_item","id":"rel","type":"relation","relation":{"id":"page_1"}},
+				{"object":"property_item","id":"rel","type":"relation","relation":{"id":"page_2"}}]}`,
+		"/v1/blocks/" + source:               `{"object":"block","id":"` + source + `","type":"child_page","has_children":false,"child_page":{"title":"Tasks"}}`,
+		"/v1/blocks/" + source + "/children": `{"object":"list","has_more":false,"results":[]}`,
+	}
+
+	var created map[string]interface{}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodPost && req.URL.Path == "/v1/pages" {
+			data, _ := ioutil.ReadAll(req.Body)
+			if err := json.Unmarshal(data, &created); err != nil {
+				t.Fatal(err)
+			}
+			return newJSONResponse(http.StatusOK, `{"object":"page","id":"`+copied+`"}`)
+		}
+		body, ok := responses[req.URL.Path]
+		if !ok {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+		}
+		return newJSONResponse(http.StatusOK, body)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	if _, err := client.DuplicatePage(context.Background(), source, notionapi.Parent{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "db"}, nil); err != nil {
+		t.Fatalf("DuplicatePage() error = %v", err)
+	}
+	tasks, _ := json.Marshal(created["properties"].(map[string]interface{})["Tasks"])
+	if want := `{"id":"rel","relation":[{"id":"page_1"},{"id":"page_2"}],"type":"relation"}`; string(tasks) != want {
+		t.Errorf("DuplicatePage() Tasks = %s, want %s", tasks, want)
+	}
+}
+
+func TestClientCreatePageFromTemplate(t *testing.T) {
+	const (
+		template = "11111111-1111-1111-1111-111111111111"
+		created  = "22222222-2222-2222-2222-222222222222"
+	)
+	responses := map[string]string{
+		"/v1/pages/" + template: `{"object":"page","id":"` + template + `","parent":{"type":"page_id","page_id":"parent"},"properties":{
+			"title":{"id":"title","type":"title","title":[{"type":"text","text":{"content":"Meeting {{date}}"},"plain_text":"Meeting {{date}}"}]}}}`,
+		"/v1/blocks/" + template: `{"object":"block","id":"` + template + `","type":"child_page","has_children":true,"child_page":{"title":"Meeting {{date}}"}}`,
+		"/v1/blocks/" + template + "/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"paragraph","type":"paragraph","paragraph":{"rich_text":[
+				{"type":"text","text":{"content":"Led by {{ owner }}, {{unknown}}"},"plain_text":"Led by {{ owner }}, {{unknown}}"}]}}]}`,
+	}
+
+	var page, children string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		switch req.Method {
+		case http.MethodPost:
+			data, _ := ioutil.ReadAll(req.Body)
+			page = string(data)
+			return newJSONResponse(http.StatusOK, `{"object":"page","id":"`+created+`"}`)
+		case http.MethodPatch:
+			data, _ := ioutil.ReadAll(req.Body)
+			children = string(data)
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[{"object":"block","id":"33333333-3333-3333-3333-333333333333","type":"paragraph","paragraph":{}}]}`)
+		}
+		return newJSONResponse(http.StatusOK, responses[req.URL.Path])
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	vars := map[string]string{"date": "2021-05-24", "owner": "Ada"}
+	_, err := client.CreatePageFromTemplate(context.Background(), template, notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "parent"}, vars, nil)
+	if err != nil {
+		t.Fatalf("CreatePageFromTemplate() error = %v", err)
+	}
+	if !strings.Contains(page, `"content":"Meeting 2021-05-24"`) {
+		t.Errorf("CreatePageFromTemplate() page = %s", page)
+	}
+	if !strings.Contains(children, `"content":"Led by Ada, {{unknown}}"`) {
+		t.Errorf("CreatePageFromTemplate() children = %s", children)
+	}
+}
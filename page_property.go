@@ -0,0 +1,259 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+// PagePropertyResponse is the value of a page property, as returned by
+// PageService.GetProperty.
+//
+// The values of title, rich_text, relation, people and rollup properties are
+// paginated lists of items, listed in Results. The values of other properties
+// are returned whole, in Property.
+type PagePropertyResponse struct {
+	Object ObjectType
+	// Property is the value of properties that are not paginated.
+	Property Property
+	// Results are the items of a page of the value of paginated properties,
+	// each holding a single value: a TitleProperty with one rich text, a
+	// RelationProperty with one relation, and so on.
+	Results    []Property
+	NextCursor Cursor
+	HasMore    bool
+	// PropertyItem describes the property of paginated values.
+	PropertyItem *PropertyItem
+}
+
+// PropertyItem describes the property whose value is paginated.
+type PropertyItem struct {
+	ID      PropertyID   `json:"id"`
+	Type    PropertyType `json:"type"`
+	NextURL string       `json:"next_url,omitempty"`
+	// Rollup holds the value computed by the function of rollups, other than
+	// arrays.
+	Rollup *Rollup `json:"rollup,omitempty"`
+}
+
+// GetProperty retrieves the value of the property of the page with the IDs
+// specified. Unlike the values of the page object, which are limited to 25
+// references, the paginated values are complete.
+//
+// See https://developers.notion.com/reference/retrieve-a-page-property
+func (pc *PageClient) GetProperty(ctx context.Context, pageID PageID, propertyID PropertyID, pagination *Pagination) (*PagePropertyResponse, error) {
+	id := propertyID.String()
+	if unescaped, err := url.PathUnescape(id); err == nil {
+		id = unescaped
+	}
+	path := fmt.Sprintf("pages/%s/properties/%s", pathID(pageID.String()), url.PathEscape(id))
+	res, err := pc.apiClient.request(ctx, http.MethodGet, path, pagination.ToQuery(), nil, ContentTypeJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+	}()
+
+	var raw map[string]interface{}
+	if err = json.NewDecoder(res.Body).Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodePagePropertyResponse(raw)
+}
+
+// GetPropertyValue retrieves the value of the property of the page with the
+// IDs specified, following pagination, and returns it as in the properties of
+// pages: the complete value of a relation is a single RelationProperty.
+func (pc *PageClient) GetPropertyValue(ctx context.Context, pageID PageID, propertyID PropertyID) (Property, error) {
+	var (
+		items      []Property
+		pagination Pagination
+		item       *PropertyItem
+	)
+	for {
+		res, err := pc.GetProperty(ctx, pageID, propertyID, &pagination)
+		if err != nil {
+			return nil, err
+		}
+		if res.Property != nil {
+			return res.Property, nil
+		}
+		item = res.PropertyItem
+		items = append(items, res.Results...)
+		if !res.HasMore {
+			break
+		}
+		pagination.StartCursor = res.NextCursor
+	}
+	if item == nil {
+		return nil, fmt.Errorf("no property_item in the value of property %s", propertyID)
+	}
+	return mergePropertyItems(item, items)
+}
+
+func decodePagePropertyResponse(raw map[string]interface{}) (*PagePropertyResponse, error) {
+	response := &PagePropertyResponse{}
+	if object, _ := raw["object"].(string); object != ObjectTypeList.String() {
+		response.Object = ObjectType(object)
+		p, err := decodePropertyItem(raw)
+		if err != nil {
+			return nil, err
+		}
+		response.Property = p
+		return response, nil
+	}
+
+	response.Object = ObjectTypeList
+	response.HasMore, _ = raw["has_more"].(bool)
+	if cursor, ok := raw["next_cursor"].(string); ok {
+		response.NextCursor = Cursor(cursor)
+	}
+	data, err := json.Marshal(raw["property_item"])
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &response.PropertyItem); err != nil {
+		return nil, err
+	}
+	results, _ := raw["results"].([]interface{})
+	for _, result := range results {
+		rawItem, ok := result.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unsupported property item format %T", result)
+		}
+		p, err := decodePropertyItem(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		response.Results = append(response.Results, p)
+	}
+	return response, nil
+}
+
+// decodePropertyItem decodes a property item, whose single value of title,
+// rich_text, relation and people items is wrapped in an array to be decoded
+// as a page property.
+func decodePropertyItem(raw map[string]interface{}) (Property, error) {
+	propertyType, _ := raw["type"].(string)
+	if propertyType == "" {
+		return nil, fmt.Errorf("property item without type")
+	}
+	switch PropertyType(propertyType) {
+	case PropertyTypeTitle, PropertyTypeRichText, PropertyTypeRelation, PropertyTypePeople:
+		if value, ok := raw[propertyType].(map[string]interface{}); ok {
+			raw[propertyType] = []interface{}{value}
+		}
+	}
+	p, err := decodeProperty(raw)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// mergePropertyItems returns the property described by item whose value is
+// made of the values of items.
+func mergePropertyItems(item *PropertyItem, items []Property) (Property, error) {
+	switch item.Type {
+	case PropertyTypeTitle:
+		p := &TitleProperty{ID: item.ID, Type: item.Type, Title: []RichText{}}
+		for _, i := range items {
+			if title, ok := i.(*TitleProperty); ok {
+				p.Title = append(p.Title, title.Title...)
+			}
+		}
+		return p, nil
+	case PropertyTypeRichText:
+		p := &RichTextProperty{ID: item.ID, Type: item.Type, RichText: []RichText{}}
+		for _, i := range items {
+			if richText, ok := i.(*RichTextProperty); ok {
+				p.RichText = append(p.RichText, richText.RichText...)
+			}
+		}
+		return p, nil
+	case PropertyTypeRelation:
+		p := &RelationProperty{ID: ObjectID(item.ID), Type: item.Type, Relation: []Relation{}}
+		for _, i := range items {
+			if relation, ok := i.(*RelationProperty); ok {
+				p.Relation = append(p.Relation, relation.Relation...)
+			}
+		}
+		return p, nil
+	case PropertyTypePeople:
+		p := &PeopleProperty{ID: ObjectID(item.ID), Type: item.Type, People: []User{}}
+		for _, i := range items {
+			if people, ok := i.(*PeopleProperty); ok {
+				p.People = append(p.People, people.People...)
+			}
+		}
+		return p, nil
+	case PropertyTypeRollup:
+		p := &RollupProperty{ID: ObjectID(item.ID), Type: item.Type}
+		if item.Rollup != nil {
+			p.Rollup = *item.Rollup
+		}
+		if p.Rollup.Type == RollupTypeArray {
+			p.Rollup.Array = PropertyArray(items)
+		}
+		return p, nil
+	}
+	return nil, fmt.Errorf("unsupported paginated property type: %s", item.Type)
+}
+
+// MaxPropertyReferences is the number of references, such as related pages,
+// people and mentions, the values of the properties of page objects are
+// limited to.
+const MaxPropertyReferences = 25
+
+// ResolveFullProperties retrieves again, with PageService.GetPropertyValue,
+// the properties of page whose values may be truncated to
+// MaxPropertyReferences references, and replaces them with their complete
+// values: the relations that have more pages, the rollups that are
+// incomplete or whose arrays are full, and the titles, rich texts and people
+// with as many items as the limit.
+func (c *Client) ResolveFullProperties(ctx context.Context, page *Page) error {
+	for name, property := range page.Properties {
+		if property == nil || !mayBeTruncated(propertyPointer(property)) {
+			continue
+		}
+		full, err := c.Page.GetPropertyValue(ctx, PageID(page.ID), PropertyID(property.GetID()))
+		if err != nil {
+			return fmt.Errorf("property %q: %w", name, err)
+		}
+		page.Properties[name] = full
+	}
+	return nil
+}
+
+// mayBeTruncated reports whether the value of property, from a page object,
+// may be truncated to MaxPropertyReferences references.
+func mayBeTruncated(property Property) bool {
+	switch p := property.(type) {
+	case *RelationProperty:
+		return p.HasMore || len(p.Relation) >= MaxPropertyReferences
+	case *RollupProperty:
+		return p.Rollup.Type == RollupTypeIncomplete ||
+			p.Rollup.Type == RollupTypeArray && len(p.Rollup.Array) >= MaxPropertyReferences
+	case *PeopleProperty:
+		return len(p.People) >= MaxPropertyReferences
+	case *TitleProperty:
+		return len(p.Title) >= MaxPropertyReferences
+	case *RichTextProperty:
+		return len(p.RichText) >= MaxPropertyReferences
+	}
+	return false
+}
@@ -3,8 +3,11 @@ package notionapi_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -538,3 +541,360 @@ func TestPageUpdateRequest_MarshallJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestPageClientArchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive bool
+		want    string
+	}{
+		{name: "archives the page", archive: true, want: `{"archived":true}`},
+		{name: "restores the page", archive: false, want: `{"archived":false}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if req.Method != http.MethodPatch || req.URL.Path != "/v1/pages/some_id" {
+					t.Errorf("unexpected request %s %s", req.Method, req.URL)
+				}
+				body, _ := ioutil.ReadAll(req.Body)
+				sent = strings.TrimSpace(string(body))
+				return newJSONResponse(http.StatusOK, fmt.Sprintf(`{"object":"page","id":"some_id","archived":%v}`, tt.archive))
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			var (
+				page *notionapi.Page
+				err  error
+			)
+			if tt.archive {
+				page, err = client.Page.Archive(context.Background(), "some_id")
+			} else {
+				page, err = client.Page.Restore(context.Background(), "some_id")
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sent != tt.want {
+				t.Errorf("sent %s, want %s", sent, tt.want)
+			}
+			if page.Archived != tt.archive {
+				t.Errorf("Archived = %v, want %v", page.Archived, tt.archive)
+			}
+		})
+	}
+}
+
+func TestPageClientUpdateTrashAndLock(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		name    string
+		request *notionapi.PageUpdateRequest
+		want    string
+	}{
+		{name: "trashes the page", request: &notionapi.PageUpdateRequest{InTrash: &yes}, want: `{"in_trash":true}`},
+		{name: "restores the page", request: &notionapi.PageUpdateRequest{InTrash: &no}, want: `{"in_trash":false}`},
+		{name: "locks the page", request: &notionapi.PageUpdateRequest{IsLocked: &yes}, want: `{"is_locked":true}`},
+		{name: "archives and locks the page", request: &notionapi.PageUpdateRequest{Archived: true, IsLocked: &yes}, want: `{"is_locked":true,"archived":true}`},
+		{name: "updates the icon", request: &notionapi.PageUpdateRequest{Icon: notionapi.EmojiIcon("📊")}, want: `{"icon":{"type":"emoji","emoji":"📊"},"archived":false}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				body, _ := ioutil.ReadAll(req.Body)
+				sent = strings.TrimSpace(string(body))
+				return newJSONResponse(http.StatusOK, `{"object":"page","id":"some_id"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			if _, err := client.Page.Update(context.Background(), "some_id", tt.request); err != nil {
+				t.Fatal(err)
+			}
+			if sent != tt.want {
+				t.Errorf("sent %s, want %s", sent, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageClientGetPropertyValue(t *testing.T) {
+	responses := map[string]string{
+		"/v1/pages/some_id/properties/number": `{"object":"property_item","id":"number","type":"number","number":42}`,
+		"/v1/pages/some_id/properties/%3Bs%7CV": `{"object":"list","has_more":true,"next_cursor":"cursor","type":"property_item",
+			"property_item":{"id":";s|V","type":"relation","next_url":"https://api.notion.com/v1/pages/some_id/properties/%3Bs%7CV?start_cursor=cursor","relation":{}},
+			"results":[{"object":"property_item","id":";s|V","type":"relation","relation":{"id":"page_1"}}]}`,
+		"/v1/pages/some_id/properties/%3Bs%7CV?start_cursor=cursor": `{"object":"list","has_more":false,"type":"property_item",
+			"property_item":{"id":";s|V","type":"relation","relation":{}},
+			"results":[{"object":"property_item","id":";s|V","type":"relation","relation":{"id":"page_2"}}]}`,
+		"/v1/pages/some_id/properties/title": `{"object":"list","has_more":false,"type":"property_item",
+			"property_item":{"id":"title","type":"title","title":{}},
+			"results":[
+				{"object":"property_item","id":"title","type":"title","title":{"type":"text","text":{"content":"Hello "},"plain_text":"Hello "}},
+				{"object":"property_item","id":"title","type":"title","title":{"type":"text","text":{"content":"world"},"plain_text":"world"}}]}`,
+	}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		body, ok := responses[req.URL.RequestURI()]
+		if !ok {
+			t.Errorf("unexpected request to %s", req.URL.RequestURI())
+			return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found"}`)
+		}
+		return newJSONResponse(http.StatusOK, body)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tests := []struct {
+		name       string
+		propertyID notionapi.PropertyID
+		want       notionapi.Property
+	}{
+		{
+			name:       "returns properties that are not paginated",
+			propertyID: "number",
+			want:       &notionapi.NumberProperty{ID: "number", Type: notionapi.PropertyTypeNumber, Number: 42},
+		},
+		{
+			name:       "merges the pages of relations",
+			propertyID: ";s|V",
+			want: &notionapi.RelationProperty{ID: ";s|V", Type: notionapi.PropertyTypeRelation, Relation: []notionapi.Relation{
+				{ID: "page_1"}, {ID: "page_2"},
+			}},
+		},
+		{
+			name:       "merges the items of titles",
+			propertyID: "title",
+			want: &notionapi.TitleProperty{ID: "title", Type: notionapi.PropertyTypeTitle, Title: []notionapi.RichText{
+				{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "Hello "}, PlainText: "Hello "},
+				{Type: notionapi.ObjectTypeText, Text: &notionapi.Text{Content: "world"}, PlainText: "world"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.Page.GetPropertyValue(context.Background(), "some_id", tt.propertyID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPropertyValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientResolveFullProperties(t *testing.T) {
+	var requests []string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.URL.RequestURI())
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"type":"property_item",
+			"property_item":{"id":"rel","type":"relation","relation":{}},
+			"results":[
+				{"object":"property_item","id":"rel","type":"relation","relation":{"id":"page_1"}},
+				{"object":"property_item","id":"rel","type":"relation","relation":{"id":"page_2"}}]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	page := &notionapi.Page{ID: "some_id", Properties: notionapi.Properties{
+		"Tasks": &notionapi.RelationProperty{ID: "rel", Type: notionapi.PropertyTypeRelation,
+			Relation: []notionapi.Relation{{ID: "page_1"}}, HasMore: true},
+		"Parent": &notionapi.RelationProperty{ID: "parent", Type: notionapi.PropertyTypeRelation,
+			Relation: []notionapi.Relation{{ID: "page_3"}}},
+		"Estimate": &notionapi.NumberProperty{ID: "number", Type: notionapi.PropertyTypeNumber, Number: 3},
+	}}
+	if err := client.ResolveFullProperties(context.Background(), page); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"/v1/pages/some_id/properties/rel"}; !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+	ids, err := page.Properties.Relations("Tasks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []notionapi.PageID{"page_1", "page_2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Tasks = %v, want %v", ids, want)
+	}
+}
+
+func TestPageBuilder(t *testing.T) {
+	t.Run("builds a page of a database", func(t *testing.T) {
+		request, err := notionapi.NewPageBuilder(notionapi.Parent{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "db"}).
+			Title("Q3 Report").
+			Number("Budget", 1200).
+			Select("Status", "Active").
+			Icon("📊").
+			Children(&notionapi.ParagraphBlock{BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeParagraph}}).
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := json.Marshal(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `{"parent":{"type":"database_id","database_id":"db"},"properties":{"Budget":{"type":"number","number":1200},"Status":{"type":"select","select":{"name":"Active"}},"title":{"type":"title","title":[{"type":"text","text":{"content":"Q3 Report"},"plain_text":"Q3 Report"}]}},"children":[{"object":"block","type":"paragraph","paragraph":{"rich_text":null}}],"icon":{"type":"emoji","emoji":"📊"}}`
+		if string(data) != want {
+			t.Errorf("Build() = %s, want %s", data, want)
+		}
+	})
+
+	t.Run("splits long text in UTF-16 code units", func(t *testing.T) {
+		request, err := notionapi.NewPageBuilder(notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page"}).
+			Title(strings.Repeat("😀", 1001)).
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		title := request.Properties["title"].(*notionapi.TitleProperty).Title
+		if len(title) != 2 || title[0].PlainText != strings.Repeat("😀", 1000) || title[1].PlainText != "😀" {
+			t.Errorf("Build() title = %+v, want 1000 emoji and 1", title)
+		}
+	})
+
+	t.Run("builds a page of a data source", func(t *testing.T) {
+		request, err := notionapi.NewPageBuilder(notionapi.Parent{Type: notionapi.ParentTypeDataSourceID, DataSourceID: "data_source"}).
+			Title("Q3 Report").
+			Number("Budget", 1200).
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := request.Properties["Budget"]; !ok {
+			t.Errorf("Build() properties = %v, want Budget", request.Properties)
+		}
+	})
+
+	t.Run("rejects properties other than title under a page", func(t *testing.T) {
+		_, err := notionapi.NewPageBuilder(notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "page"}).
+			Title("Notes").
+			Number("Budget", 1200).
+			Build()
+		if err == nil {
+			t.Error("Build() error = nil, want an error")
+		}
+	})
+}
+
+func TestPageClientMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		parent  notionapi.Parent
+		status  int
+		body    string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "moves the page to a page",
+			parent: notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "22222222222222222222222222222222"},
+			status: http.StatusOK,
+			body:   `{"object":"page","id":"11111111-1111-1111-1111-111111111111"}`,
+			want:   `{"parent":{"type":"page_id","page_id":"22222222-2222-2222-2222-222222222222"}}`,
+		},
+		{
+			name:   "moves the page to a data source",
+			parent: notionapi.Parent{Type: notionapi.ParentTypeDataSourceID, DataSourceID: "33333333-3333-3333-3333-333333333333"},
+			status: http.StatusOK,
+			body:   `{"object":"page","id":"11111111-1111-1111-1111-111111111111"}`,
+			want:   `{"parent":{"type":"data_source_id","data_source_id":"33333333-3333-3333-3333-333333333333"}}`,
+		},
+		{
+			name:    "rejects the workspace",
+			parent:  notionapi.Parent{Type: notionapi.ParentTypeWorkspace, Workspace: true},
+			wantErr: `pages can only be moved to a page or a data source, not to a parent of type "workspace"`,
+		},
+		{
+			name:    "reports unsupported versions",
+			parent:  notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "22222222-2222-2222-2222-222222222222"},
+			status:  http.StatusBadRequest,
+			body:    `{"object":"error","status":400,"code":"invalid_request_url","message":"Invalid request URL."}`,
+			wantErr: "endpoint pages/11111111-1111-1111-1111-111111111111/move is not supported with Notion-Version 2022-06-28: Invalid request URL.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if req.Method != http.MethodPost || req.URL.Path != "/v1/pages/11111111-1111-1111-1111-111111111111/move" {
+					t.Fatalf("unexpected request %s %s", req.Method, req.URL)
+				}
+				data, _ := ioutil.ReadAll(req.Body)
+				got = string(data)
+				return newJSONResponse(tt.status, tt.body)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			_, err := client.Page.Move(context.Background(), "11111111111111111111111111111111", tt.parent)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Move() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Move() body = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterProperties(t *testing.T) {
+	var gotQuery string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		gotQuery = req.URL.RawQuery
+		if strings.HasSuffix(req.URL.Path, "/query") {
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[],"has_more":false}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"page","id":"page_id","properties":{}}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{
+			name: "page",
+			call: func() error {
+				_, err := client.Page.GetWithProperties(ctx, "page_id", []notionapi.PropertyID{"title", "a%3Db"})
+				return err
+			},
+			want: "filter_properties=title&filter_properties=a%253Db",
+		},
+		{
+			name: "page without properties",
+			call: func() error {
+				_, err := client.Page.Get(ctx, "page_id")
+				return err
+			},
+			want: "",
+		},
+		{
+			name: "database query",
+			call: func() error {
+				_, err := client.Database.Query(ctx, "database_id", &notionapi.DatabaseQueryRequest{
+					FilterProperties: []notionapi.PropertyID{"title"},
+				})
+				return err
+			},
+			want: "filter_properties=title",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+			if gotQuery != tt.want {
+				t.Errorf("got query %q, want %q", gotQuery, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package notionapi
+
+// cursorPager holds the pagination state of the iterators following
+// next_cursor through the pages of results of a list endpoint.
+type cursorPager struct {
+	cursor  Cursor
+	hasMore bool
+	started bool
+	err     error
+}
+
+// fetch retrieves the next page of results with list, which is given the
+// cursor to start from and returns the next_cursor and has_more of the
+// response. It returns false when the last page was already retrieved or an
+// error occurred. A response with more results but no cursor to get them is
+// the last one, rather than a restart from the first page.
+func (p *cursorPager) fetch(list func(cursor Cursor) (Cursor, bool, error)) bool {
+	if p.err != nil || (p.started && !p.hasMore) {
+		return false
+	}
+	next, hasMore, err := list(p.cursor)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.started = true
+	p.cursor = next
+	p.hasMore = hasMore && next != ""
+	return true
+}
@@ -0,0 +1,106 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PagePeople returns the users of the people property of page with the name
+// specified, with the partial users replaced with the full users from
+// Client.UserCache.
+func (c *Client) PagePeople(ctx context.Context, page *Page, name string) ([]User, error) {
+	people, err := page.Properties.People(name)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]User, len(people))
+	for i := range people {
+		user, err := c.UserCache().Resolve(ctx, &people[i])
+		if err != nil {
+			return nil, fmt.Errorf("user %s: %w", people[i].ID, err)
+		}
+		users[i] = *user
+	}
+	return users, nil
+}
+
+// PeopleFromEmails returns the value of a people property of the persons of
+// the workspace with the email addresses specified, looked up with
+// Client.FindUserByEmail. The addresses that match no person are all
+// reported, with an error wrapping ErrUserNotFound.
+func (c *Client) PeopleFromEmails(ctx context.Context, emails ...string) (*PeopleProperty, error) {
+	property := &PeopleProperty{Type: PropertyTypePeople, People: make([]User, 0, len(emails))}
+	var missing []string
+	for _, email := range emails {
+		user, err := c.FindUserByEmail(ctx, email)
+		if isUserNotFound(err) {
+			missing = append(missing, email)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		property.People = append(property.People, User{Object: ObjectTypeUser, ID: user.ID})
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, strings.Join(missing, ", "))
+	}
+	return property, nil
+}
+
+// PeopleFromIDs returns the value of a people property of the users with the
+// IDs specified, checking with Client.UserCache that they exist. The IDs of
+// no user are all reported, with an error wrapping ErrUserNotFound.
+func (c *Client) PeopleFromIDs(ctx context.Context, ids ...UserID) (*PeopleProperty, error) {
+	property := &PeopleProperty{Type: PropertyTypePeople, People: make([]User, 0, len(ids))}
+	var missing []string
+	for _, id := range ids {
+		user, err := c.UserCache().Get(ctx, id)
+		if isUserNotFound(err) {
+			missing = append(missing, id.String())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		property.People = append(property.People, User{Object: ObjectTypeUser, ID: user.ID})
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, strings.Join(missing, ", "))
+	}
+	return property, nil
+}
+
+// PeopleFromText returns the value of a people property of the users that
+// values reference as PropertyText writes them: their names, or their IDs for
+// the users without name. Email addresses are accepted too. The users are
+// looked up with Client.UserCache; the values that match no user, or several
+// people with the same name, are all reported, with an error wrapping
+// ErrUserNotFound.
+func (c *Client) PeopleFromText(ctx context.Context, values ...string) (*PeopleProperty, error) {
+	property := &PeopleProperty{Type: PropertyTypePeople, People: make([]User, 0, len(values))}
+	var missing []string
+	for _, value := range values {
+		user, err := c.UserCache().find(ctx, value)
+		if isUserNotFound(err) {
+			missing = append(missing, value)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		property.People = append(property.People, User{Object: ObjectTypeUser, ID: user.ID})
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, strings.Join(missing, ", "))
+	}
+	return property, nil
+}
+
+// isUserNotFound reports whether err is ErrUserNotFound, or the error of the
+// API for the IDs of no user.
+func isUserNotFound(err error) bool {
+	return errors.Is(err, ErrUserNotFound) || IsErrorCode(err, ErrorCodeObjectNotFound)
+}
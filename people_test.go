@@ -0,0 +1,122 @@
+package notionapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func newPeopleTestClient() *notionapi.Client {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		switch req.URL.Path {
+		case "/v1/users":
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+				{"object":"user","id":"user_1","type":"person","name":"Ada","person":{"email":"ada@example.com"}},
+				{"object":"user","id":"user_2","type":"person","name":"Grace","person":{"email":"grace@example.com"}}]}`)
+		case "/v1/users/guest":
+			return newJSONResponse(http.StatusOK, `{"object":"user","id":"guest","type":"person","name":"Guest"}`)
+		}
+		return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"Could not find user"}`)
+	})
+	return notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+}
+
+func TestClientPagePeople(t *testing.T) {
+	client := newPeopleTestClient()
+	page := &notionapi.Page{Properties: notionapi.Properties{
+		"Assignees": &notionapi.PeopleProperty{Type: notionapi.PropertyTypePeople, People: []notionapi.User{
+			{Object: notionapi.ObjectTypeUser, ID: "user_2"},
+			{Object: notionapi.ObjectTypeUser, ID: "guest"},
+		}},
+	}}
+
+	got, err := client.PagePeople(context.Background(), page, "Assignees")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, user := range got {
+		names = append(names, user.Name)
+	}
+	if want := []string{"Grace", "Guest"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("PagePeople() = %v, want %v", names, want)
+	}
+}
+
+func TestClientPeopleFrom(t *testing.T) {
+	tests := []struct {
+		name        string
+		people      func(*notionapi.Client) (*notionapi.PeopleProperty, error)
+		want        []notionapi.UserID
+		wantMissing string
+	}{
+		{
+			name: "emails",
+			people: func(client *notionapi.Client) (*notionapi.PeopleProperty, error) {
+				return client.PeopleFromEmails(context.Background(), "grace@example.com", "ADA@example.com")
+			},
+			want: []notionapi.UserID{"user_2", "user_1"},
+		},
+		{
+			name: "unknown emails",
+			people: func(client *notionapi.Client) (*notionapi.PeopleProperty, error) {
+				return client.PeopleFromEmails(context.Background(), "ada@example.com", "bob@example.com", "eve@example.com")
+			},
+			wantMissing: "bob@example.com, eve@example.com",
+		},
+		{
+			name: "IDs",
+			people: func(client *notionapi.Client) (*notionapi.PeopleProperty, error) {
+				return client.PeopleFromIDs(context.Background(), "user_1", "guest")
+			},
+			want: []notionapi.UserID{"user_1", "guest"},
+		},
+		{
+			name: "unknown IDs",
+			people: func(client *notionapi.Client) (*notionapi.PeopleProperty, error) {
+				return client.PeopleFromIDs(context.Background(), "user_1", "nobody")
+			},
+			wantMissing: "nobody",
+		},
+		{
+			name: "text",
+			people: func(client *notionapi.Client) (*notionapi.PeopleProperty, error) {
+				return client.PeopleFromText(context.Background(), "grace", "ada@example.com", "user_1")
+			},
+			want: []notionapi.UserID{"user_2", "user_1", "user_1"},
+		},
+		{
+			name: "unknown text",
+			people: func(client *notionapi.Client) (*notionapi.PeopleProperty, error) {
+				return client.PeopleFromText(context.Background(), "Ada", "Bob", "00000000-0000-0000-0000-000000000000")
+			},
+			wantMissing: "Bob, 00000000-0000-0000-0000-000000000000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.people(newPeopleTestClient())
+			if tt.wantMissing != "" {
+				if !errors.Is(err, notionapi.ErrUserNotFound) || !strings.HasSuffix(err.Error(), tt.wantMissing) {
+					t.Errorf("error = %v, want %v for %s", err, notionapi.ErrUserNotFound, tt.wantMissing)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []notionapi.UserID
+			for _, user := range got.People {
+				ids = append(ids, user.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("people = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package notionapi
+
+import (
+	"context"
+	"strings"
+)
+
+// PlainText returns the plain text of the rich text of the blocks of the
+// trees, such as the text of paragraphs, the captions of images or the
+// titles of child pages, one block per line. The cells of table rows are
+// separated by tabs. Blocks without text, such as dividers, are skipped.
+func PlainText(trees ...*BlockNode) string {
+	var lines []string
+	for _, tree := range trees {
+		_ = Walk(tree, func(block Block, depth int) error {
+			if text := blockPlainText(block); text != "" {
+				lines = append(lines, text)
+			}
+			return nil
+		})
+	}
+	return strings.Join(lines, "\n")
+}
+
+func blockPlainText(block Block) string {
+	switch b := block.(type) {
+	case *ParagraphBlock, *Heading1Block, *Heading2Block, *Heading3Block,
+		*CalloutBlock, *QuoteBlock, *BulletedListItemBlock, *NumberedListItemBlock,
+		*ToDoBlock, *ToggleBlock, *CodeBlock, *EquationBlock, *TemplateBlock,
+		*EmbedBlock, *ImageBlock, *AudioBlock, *VideoBlock, *FileBlock, *PdfBlock,
+		*BookmarkBlock, *LinkPreviewBlock:
+		return block.GetRichTextString()
+	case *TableRowBlock:
+		cells := make([]string, len(b.TableRow.Cells))
+		for i, cell := range b.TableRow.Cells {
+			cells[i] = concatenateRichText(cell)
+		}
+		return strings.Join(cells, "\t")
+	case *ChildPageBlock:
+		return b.ChildPage.Title
+	case *ChildDatabaseBlock:
+		return b.ChildDatabase.Title
+	}
+	return ""
+}
+
+// PlainText retrieves the content of the page with the ID specified and
+// returns its plain text, as PlainText does. The content of child pages is not
+// included.
+func (pc *PageClient) PlainText(ctx context.Context, id PageID) (string, error) {
+	tree, err := pc.apiClient.Block.GetTree(ctx, BlockID(id), nil)
+	if err != nil {
+		return "", err
+	}
+	return PlainText(tree.Children...), nil
+}
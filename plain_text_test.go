@@ -0,0 +1,115 @@
+package notionapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+// newMockedPageContentClient serves a page holding text in a heading, a
+// paragraph nested in a toggle, a table, an equation and a child page.
+func newMockedPageContentClient(t *testing.T) *http.Client {
+	responses := map[string]string{
+		"/v1/blocks/page": `{"object":"block","id":"page","type":"child_page","has_children":true,"child_page":{"title":"Page"}}`,
+		"/v1/blocks/page/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"heading","type":"heading_1","heading_1":{"rich_text":[{"type":"text","text":{"content":"Title"},"plain_text":"Title"}]}},
+			{"object":"block","id":"toggle","type":"toggle","has_children":true,"toggle":{"rich_text":[{"type":"text","text":{"content":"Toggle"},"plain_text":"Toggle"}]}},
+			{"object":"block","id":"divider","type":"divider","divider":{}},
+			{"object":"block","id":"table","type":"table","has_children":true,"table":{"table_width":2}},
+			{"object":"block","id":"equation","type":"equation","equation":{"expression":"e=mc^2"}},
+			{"object":"block","id":"child","type":"child_page","has_children":true,"child_page":{"title":"Child"}}]}`,
+		"/v1/blocks/toggle/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"paragraph","type":"paragraph","paragraph":{"rich_text":[
+				{"type":"text","text":{"content":"Hello "},"plain_text":"Hello "},
+				{"type":"mention","mention":{"type":"user","user":{"id":"user"}},"plain_text":"@Someone"}]}}]}`,
+		"/v1/blocks/table/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"row","type":"table_row","table_row":{"cells":[
+				[{"type":"text","text":{"content":"a"},"plain_text":"a"}],
+				[{"type":"text","text":{"content":"b"},"plain_text":"b"}]]}}]}`,
+	}
+	return newTestClient(func(req *http.Request) *http.Response {
+		body, ok := responses[req.URL.RequestURI()]
+		if !ok {
+			t.Errorf("unexpected request to %s", req.URL)
+			return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found"}`)
+		}
+		return newJSONResponse(http.StatusOK, body)
+	})
+}
+
+func TestPageClientPlainText(t *testing.T) {
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newMockedPageContentClient(t)))
+
+	got, err := client.Page.PlainText(context.Background(), "page")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Title\nToggle\nHello @Someone\na\tb\ne=mc^2\nChild"
+	if got != want {
+		t.Errorf("PlainText() = %q, want %q", got, want)
+	}
+}
+
+func TestPageClientFindInPage(t *testing.T) {
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newMockedPageContentClient(t)))
+
+	matches, err := client.Page.FindInPage(context.Background(), "page", "E")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range matches {
+		path := make([]string, len(m.Path))
+		for i, block := range m.Path {
+			path[i] = block.GetID().String()
+		}
+		got = append(got, fmt.Sprintf("%s %v %q %v", m.Block.GetID(), path, m.Text, m.Offsets))
+	}
+	want := []string{
+		`heading [] "Title" [4]`,
+		`toggle [] "Toggle" [5]`,
+		`paragraph [toggle] "Hello @Someone" [1 10 13]`,
+		`equation [] "e=mc^2" [0]`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindInPage() = %q, want %q", got, want)
+	}
+
+	t.Run("offsets of the text", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			if req.URL.Path == "/v1/blocks/page" {
+				return newJSONResponse(http.StatusOK, `{"object":"block","id":"page","type":"child_page","has_children":true,"child_page":{"title":"Page"}}`)
+			}
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+				{"object":"block","id":"paragraph","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"İstanbul, ıstanbul, ISTANBUL"},"plain_text":"İstanbul, ıstanbul, ISTANBUL"}]}}]}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		matches, err := client.Page.FindInPage(context.Background(), "page", "istanbul")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(matches) != 1 || !reflect.DeepEqual(matches[0].Offsets, []int{20}) {
+			t.Errorf("FindInPage() = %+v, want one match at offset 20", matches)
+		}
+	})
+
+	t.Run("blank queries", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			t.Errorf("unexpected request %s", req.URL)
+			return newJSONResponse(http.StatusOK, `{}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		for _, query := range []string{"", " \t"} {
+			matches, err := client.Page.FindInPage(context.Background(), "page", query)
+			if err != nil || matches != nil {
+				t.Errorf("FindInPage(%q) = %v, %v, want nil, nil", query, matches, err)
+			}
+		}
+	})
+}
@@ -83,6 +83,44 @@ type NumberProperty struct {
 	ID     PropertyID   `json:"id,omitempty"`
 	Type   PropertyType `json:"type,omitempty"`
 	Number float64      `json:"number"`
+
+	// null is set for the numbers without value, returned as null.
+	null bool
+}
+
+// IsEmpty reports whether the number has no value. Empty numbers have a
+// Number of 0, and are sent back as null.
+func (p NumberProperty) IsEmpty() bool {
+	return p.null
+}
+
+func (p *NumberProperty) UnmarshalJSON(data []byte) error {
+	type numberProperty NumberProperty
+	var raw struct {
+		numberProperty
+		Number *float64 `json:"number"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*p = NumberProperty(raw.numberProperty)
+	if raw.Number != nil {
+		p.Number = *raw.Number
+	}
+	p.null = raw.Number == nil
+	return nil
+}
+
+func (p NumberProperty) MarshalJSON() ([]byte, error) {
+	type numberProperty NumberProperty
+	var number *float64
+	if !p.null {
+		number = &p.Number
+	}
+	return json.Marshal(struct {
+		numberProperty
+		Number *float64 `json:"number"`
+	}{numberProperty(p), number})
 }
 
 func (p NumberProperty) GetID() string {
@@ -99,6 +137,19 @@ type SelectProperty struct {
 	Select Option       `json:"select"`
 }
 
+// MarshalJSON sends an empty option as null, which clears the property.
+func (p SelectProperty) MarshalJSON() ([]byte, error) {
+	type selectProperty SelectProperty
+	var option *Option
+	if p.Select != (Option{}) {
+		option = &p.Select
+	}
+	return json.Marshal(struct {
+		selectProperty
+		Select *Option `json:"select"`
+	}{selectProperty(p), option})
+}
+
 func (p SelectProperty) GetID() string {
 	return p.ID.String()
 }
@@ -174,6 +225,10 @@ type RelationProperty struct {
 	ID       ObjectID     `json:"id,omitempty"`
 	Type     PropertyType `json:"type,omitempty"`
 	Relation []Relation   `json:"relation"`
+	// HasMore is true when the relation has more than the 25 pages of the
+	// page objects, in which case it is retrieved whole with
+	// PageService.GetPropertyValue.
+	HasMore bool `json:"has_more,omitempty"`
 }
 
 type Relation struct {
@@ -201,6 +256,8 @@ type Rollup struct {
 	Number float64       `json:"number,omitempty"`
 	Date   *DateObject   `json:"date,omitempty"`
 	Array  PropertyArray `json:"array,omitempty"`
+	// Function is the function computing the rollup.
+	Function FunctionType `json:"function,omitempty"`
 }
 
 func (p RollupProperty) GetID() string {
@@ -259,6 +316,19 @@ type URLProperty struct {
 	URL  string       `json:"url"`
 }
 
+// MarshalJSON sends an empty URL as null, which clears the property.
+func (p URLProperty) MarshalJSON() ([]byte, error) {
+	type urlProperty URLProperty
+	var value *string
+	if p.URL != "" {
+		value = &p.URL
+	}
+	return json.Marshal(struct {
+		urlProperty
+		URL *string `json:"url"`
+	}{urlProperty(p), value})
+}
+
 func (p URLProperty) GetID() string {
 	return p.ID.String()
 }
@@ -273,6 +343,19 @@ type EmailProperty struct {
 	Email string       `json:"email"`
 }
 
+// MarshalJSON sends an empty email address as null, which clears the property.
+func (p EmailProperty) MarshalJSON() ([]byte, error) {
+	type emailProperty EmailProperty
+	var value *string
+	if p.Email != "" {
+		value = &p.Email
+	}
+	return json.Marshal(struct {
+		emailProperty
+		Email *string `json:"email"`
+	}{emailProperty(p), value})
+}
+
 func (p EmailProperty) GetID() string {
 	return p.ID.String()
 }
@@ -287,6 +370,19 @@ type PhoneNumberProperty struct {
 	PhoneNumber string       `json:"phone_number"`
 }
 
+// MarshalJSON sends an empty phone number as null, which clears the property.
+func (p PhoneNumberProperty) MarshalJSON() ([]byte, error) {
+	type phoneNumberProperty PhoneNumberProperty
+	var value *string
+	if p.PhoneNumber != "" {
+		value = &p.PhoneNumber
+	}
+	return json.Marshal(struct {
+		phoneNumberProperty
+		PhoneNumber *string `json:"phone_number"`
+	}{phoneNumberProperty(p), value})
+}
+
 func (p PhoneNumberProperty) GetID() string {
 	return p.ID.String()
 }
@@ -357,6 +453,19 @@ type StatusProperty struct {
 	Status Status       `json:"status"`
 }
 
+// MarshalJSON sends an empty status as null, which clears the property.
+func (p StatusProperty) MarshalJSON() ([]byte, error) {
+	type statusProperty StatusProperty
+	var status *Status
+	if p.Status != (Status{}) {
+		status = &p.Status
+	}
+	return json.Marshal(struct {
+		statusProperty
+		Status *Status `json:"status"`
+	}{statusProperty(p), status})
+}
+
 func (p StatusProperty) GetID() string {
 	return p.ID.String()
 }
@@ -14,6 +14,7 @@ type PropertyConfig interface {
 
 type TitlePropertyConfig struct {
 	ID    PropertyID         `json:"id,omitempty"`
+	Name  string             `json:"name,omitempty"`
 	Type  PropertyConfigType `json:"type"`
 	Title struct{}           `json:"title"`
 }
@@ -28,6 +29,7 @@ func (p TitlePropertyConfig) GetID() PropertyID {
 
 type RichTextPropertyConfig struct {
 	ID       PropertyID         `json:"id,omitempty"`
+	Name     string             `json:"name,omitempty"`
 	Type     PropertyConfigType `json:"type"`
 	RichText struct{}           `json:"rich_text"`
 }
@@ -42,6 +44,7 @@ func (p RichTextPropertyConfig) GetID() PropertyID {
 
 type NumberPropertyConfig struct {
 	ID     PropertyID         `json:"id,omitempty"`
+	Name   string             `json:"name,omitempty"`
 	Type   PropertyConfigType `json:"type"`
 	Number NumberFormat       `json:"number"`
 }
@@ -53,7 +56,7 @@ func (ft FormatType) String() string {
 }
 
 type NumberFormat struct {
-	Format FormatType `json:"format"`
+	Format FormatType `json:"format,omitempty"`
 }
 
 func (p NumberPropertyConfig) GetType() PropertyConfigType {
@@ -66,6 +69,7 @@ func (p NumberPropertyConfig) GetID() PropertyID {
 
 type SelectPropertyConfig struct {
 	ID     PropertyID         `json:"id,omitempty"`
+	Name   string             `json:"name,omitempty"`
 	Type   PropertyConfigType `json:"type"`
 	Select Select             `json:"select"`
 }
@@ -80,12 +84,13 @@ func (p SelectPropertyConfig) GetID() PropertyID {
 
 type MultiSelectPropertyConfig struct {
 	ID          PropertyID         `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
 	Type        PropertyConfigType `json:"type"`
 	MultiSelect Select             `json:"multi_select"`
 }
 
 type Select struct {
-	Options []Option `json:"options"`
+	Options []Option `json:"options,omitempty"`
 }
 
 func (p MultiSelectPropertyConfig) GetType() PropertyConfigType {
@@ -98,6 +103,7 @@ func (p MultiSelectPropertyConfig) GetID() PropertyID {
 
 type DatePropertyConfig struct {
 	ID   PropertyID         `json:"id,omitempty"`
+	Name string             `json:"name,omitempty"`
 	Type PropertyConfigType `json:"type"`
 	Date struct{}           `json:"date"`
 }
@@ -112,6 +118,7 @@ func (p DatePropertyConfig) GetID() PropertyID {
 
 type PeoplePropertyConfig struct {
 	ID     PropertyID         `json:"id,omitempty"`
+	Name   string             `json:"name,omitempty"`
 	Type   PropertyConfigType `json:"type"`
 	People struct{}           `json:"people"`
 }
@@ -126,6 +133,7 @@ func (p PeoplePropertyConfig) GetID() PropertyID {
 
 type FilesPropertyConfig struct {
 	ID    PropertyID         `json:"id,omitempty"`
+	Name  string             `json:"name,omitempty"`
 	Type  PropertyConfigType `json:"type"`
 	Files struct{}           `json:"files"`
 }
@@ -140,6 +148,7 @@ func (p FilesPropertyConfig) GetID() PropertyID {
 
 type CheckboxPropertyConfig struct {
 	ID       PropertyID         `json:"id,omitempty"`
+	Name     string             `json:"name,omitempty"`
 	Type     PropertyConfigType `json:"type"`
 	Checkbox struct{}           `json:"checkbox"`
 }
@@ -154,6 +163,7 @@ func (p CheckboxPropertyConfig) GetID() PropertyID {
 
 type URLPropertyConfig struct {
 	ID   PropertyID         `json:"id,omitempty"`
+	Name string             `json:"name,omitempty"`
 	Type PropertyConfigType `json:"type"`
 	URL  struct{}           `json:"url"`
 }
@@ -168,6 +178,7 @@ func (p URLPropertyConfig) GetID() PropertyID {
 
 type EmailPropertyConfig struct {
 	ID    PropertyID         `json:"id,omitempty"`
+	Name  string             `json:"name,omitempty"`
 	Type  PropertyConfigType `json:"type"`
 	Email struct{}           `json:"email"`
 }
@@ -182,6 +193,7 @@ func (p EmailPropertyConfig) GetID() PropertyID {
 
 type PhoneNumberPropertyConfig struct {
 	ID          PropertyID         `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
 	Type        PropertyConfigType `json:"type"`
 	PhoneNumber struct{}           `json:"phone_number"`
 }
@@ -196,6 +208,7 @@ func (p PhoneNumberPropertyConfig) GetID() PropertyID {
 
 type FormulaPropertyConfig struct {
 	ID      PropertyID         `json:"id,omitempty"`
+	Name    string             `json:"name,omitempty"`
 	Type    PropertyConfigType `json:"type"`
 	Formula FormulaConfig      `json:"formula"`
 }
@@ -213,6 +226,8 @@ func (p FormulaPropertyConfig) GetID() PropertyID {
 }
 
 type RelationPropertyConfig struct {
+	ID       PropertyID         `json:"id,omitempty"`
+	Name     string             `json:"name,omitempty"`
 	Type     PropertyConfigType `json:"type"`
 	Relation RelationConfig     `json:"relation"`
 }
@@ -225,7 +240,15 @@ func (rp RelationConfigType) String() string {
 
 type SingleProperty struct{}
 
-type DualProperty struct{}
+// DualProperty configures the relation synced with a relation in the
+// related database.
+type DualProperty struct {
+	// SyncedPropertyName is the name of the relation in the related
+	// database. Notion names it after the database when it is created without
+	// name.
+	SyncedPropertyName string     `json:"synced_property_name,omitempty"`
+	SyncedPropertyID   PropertyID `json:"synced_property_id,omitempty"`
+}
 
 type RelationConfig struct {
 	DatabaseID         DatabaseID         `json:"database_id"`
@@ -241,20 +264,21 @@ func (p RelationPropertyConfig) GetType() PropertyConfigType {
 }
 
 func (p RelationPropertyConfig) GetID() PropertyID {
-	return ""
+	return p.ID
 }
 
 type RollupPropertyConfig struct {
 	ID     PropertyID         `json:"id,omitempty"`
+	Name   string             `json:"name,omitempty"`
 	Type   PropertyConfigType `json:"type"`
 	Rollup RollupConfig       `json:"rollup"`
 }
 
 type RollupConfig struct {
-	RelationPropertyName string       `json:"relation_property_name"`
-	RelationPropertyID   PropertyID   `json:"relation_property_id"`
-	RollupPropertyName   string       `json:"rollup_property_name"`
-	RollupPropertyID     PropertyID   `json:"rollup_property_id"`
+	RelationPropertyName string       `json:"relation_property_name,omitempty"`
+	RelationPropertyID   PropertyID   `json:"relation_property_id,omitempty"`
+	RollupPropertyName   string       `json:"rollup_property_name,omitempty"`
+	RollupPropertyID     PropertyID   `json:"rollup_property_id,omitempty"`
 	Function             FunctionType `json:"function"`
 }
 
@@ -268,6 +292,7 @@ func (p RollupPropertyConfig) GetID() PropertyID {
 
 type CreatedTimePropertyConfig struct {
 	ID          PropertyID         `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
 	Type        PropertyConfigType `json:"type"`
 	CreatedTime struct{}           `json:"created_time"`
 }
@@ -281,7 +306,8 @@ func (p CreatedTimePropertyConfig) GetID() PropertyID {
 }
 
 type CreatedByPropertyConfig struct {
-	ID        PropertyID         `json:"id"`
+	ID        PropertyID         `json:"id,omitempty"`
+	Name      string             `json:"name,omitempty"`
 	Type      PropertyConfigType `json:"type"`
 	CreatedBy struct{}           `json:"created_by"`
 }
@@ -295,7 +321,8 @@ func (p CreatedByPropertyConfig) GetID() PropertyID {
 }
 
 type LastEditedTimePropertyConfig struct {
-	ID             PropertyID         `json:"id"`
+	ID             PropertyID         `json:"id,omitempty"`
+	Name           string             `json:"name,omitempty"`
 	Type           PropertyConfigType `json:"type"`
 	LastEditedTime struct{}           `json:"last_edited_time"`
 }
@@ -309,7 +336,8 @@ func (p LastEditedTimePropertyConfig) GetID() PropertyID {
 }
 
 type LastEditedByPropertyConfig struct {
-	ID           PropertyID         `json:"id"`
+	ID           PropertyID         `json:"id,omitempty"`
+	Name         string             `json:"name,omitempty"`
 	Type         PropertyConfigType `json:"type"`
 	LastEditedBy struct{}           `json:"last_edited_by"`
 }
@@ -323,7 +351,8 @@ func (p LastEditedByPropertyConfig) GetID() PropertyID {
 }
 
 type StatusPropertyConfig struct {
-	ID     PropertyID         `json:"id"`
+	ID     PropertyID         `json:"id,omitempty"`
+	Name   string             `json:"name,omitempty"`
 	Type   PropertyConfigType `json:"type"`
 	Status StatusConfig       `json:"status"`
 }
@@ -337,12 +366,12 @@ func (p StatusPropertyConfig) GetID() PropertyID {
 }
 
 type StatusConfig struct {
-	Options []Option      `json:"options"`
-	Groups  []GroupConfig `json:"groups"`
+	Options []Option      `json:"options,omitempty"`
+	Groups  []GroupConfig `json:"groups,omitempty"`
 }
 
 type GroupConfig struct {
-	ID        ObjectID   `json:"id"`
+	ID        ObjectID   `json:"id,omitempty"`
 	Name      string     `json:"name"`
 	Color     string     `json:"color"`
 	OptionIDs []ObjectID `json:"option_ids"`
@@ -350,12 +379,13 @@ type GroupConfig struct {
 
 type UniqueIDPropertyConfig struct {
 	ID       PropertyID         `json:"id,omitempty"`
+	Name     string             `json:"name,omitempty"`
 	Type     PropertyConfigType `json:"type"`
 	UniqueID UniqueIDConfig     `json:"unique_id"`
 }
 
 type UniqueIDConfig struct {
-	Prefix string `json:"prefix"`
+	Prefix string `json:"prefix,omitempty"`
 }
 
 func (p UniqueIDPropertyConfig) GetType() PropertyConfigType {
@@ -368,6 +398,7 @@ func (p UniqueIDPropertyConfig) GetID() PropertyID {
 
 type VerificationPropertyConfig struct {
 	ID           PropertyID         `json:"id,omitempty"`
+	Name         string             `json:"name,omitempty"`
 	Type         PropertyConfigType `json:"type,omitempty"`
 	Verification Verification       `json:"verification"`
 }
@@ -381,7 +412,8 @@ func (p VerificationPropertyConfig) GetID() PropertyID {
 }
 
 type ButtonPropertyConfig struct {
-	ID     PropertyID         `json:"id"`
+	ID     PropertyID         `json:"id,omitempty"`
+	Name   string             `json:"name,omitempty"`
 	Type   PropertyConfigType `json:"type"`
 	Button struct{}           `json:"button"`
 }
@@ -450,7 +482,7 @@ func parsePropertyConfigs(raw map[string]interface{}) (PropertyConfigs, error) {
 			case PropertyConfigCreatedTime:
 				p = &CreatedTimePropertyConfig{}
 			case PropertyConfigCreatedBy:
-				p = &CreatedTimePropertyConfig{}
+				p = &CreatedByPropertyConfig{}
 			case PropertyConfigLastEditedTime:
 				p = &LastEditedTimePropertyConfig{}
 			case PropertyConfigLastEditedBy:
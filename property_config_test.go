@@ -44,3 +44,127 @@ func TestNumberPropertyConfig_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestPropertyConfigs_UnmarshalJSON(t *testing.T) {
+	data := `{
+		"Name": {"id": "title", "name": "Name", "type": "title", "title": {}},
+		"Notes": {"id": "a", "type": "rich_text", "rich_text": {}},
+		"Price": {"id": "b", "type": "number", "number": {"format": "new_zealand_dollar"}},
+		"Kind": {"id": "c", "type": "select", "select": {"options": [{"id": "o", "name": "A", "color": "red"}]}},
+		"Tags": {"id": "d", "type": "multi_select", "multi_select": {"options": []}},
+		"Stage": {"id": "e", "type": "status", "status": {"options": [{"id": "o", "name": "Done", "color": "green"}], "groups": [{"id": "g", "name": "Complete", "color": "green", "option_ids": ["o"]}]}},
+		"Due": {"id": "f", "type": "date", "date": {}},
+		"Owner": {"id": "g", "type": "people", "people": {}},
+		"Attachments": {"id": "h", "type": "files", "files": {}},
+		"Done": {"id": "i", "type": "checkbox", "checkbox": {}},
+		"Link": {"id": "j", "type": "url", "url": {}},
+		"Mail": {"id": "k", "type": "email", "email": {}},
+		"Phone": {"id": "l", "type": "phone_number", "phone_number": {}},
+		"Total": {"id": "m", "type": "formula", "formula": {"expression": "1 + 1"}},
+		"Project": {"id": "n", "type": "relation", "relation": {"database_id": "db", "type": "dual_property", "dual_property": {}}},
+		"Count": {"id": "o", "type": "rollup", "rollup": {"relation_property_name": "Project", "rollup_property_name": "Name", "function": "count_all"}},
+		"Created": {"id": "p", "type": "created_time", "created_time": {}},
+		"Author": {"id": "q", "type": "created_by", "created_by": {}},
+		"Edited": {"id": "r", "type": "last_edited_time", "last_edited_time": {}},
+		"Editor": {"id": "s", "type": "last_edited_by", "last_edited_by": {}},
+		"ID": {"id": "t", "type": "unique_id", "unique_id": {"prefix": "TASK"}},
+		"Verified": {"id": "u", "type": "verification", "verification": {"state": "unverified"}},
+		"Action": {"id": "v", "type": "button", "button": {}}
+	}`
+	var got PropertyConfigs
+	if err := json.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]PropertyConfig{
+		"Name":        &TitlePropertyConfig{},
+		"Notes":       &RichTextPropertyConfig{},
+		"Price":       &NumberPropertyConfig{},
+		"Kind":        &SelectPropertyConfig{},
+		"Tags":        &MultiSelectPropertyConfig{},
+		"Stage":       &StatusPropertyConfig{},
+		"Due":         &DatePropertyConfig{},
+		"Owner":       &PeoplePropertyConfig{},
+		"Attachments": &FilesPropertyConfig{},
+		"Done":        &CheckboxPropertyConfig{},
+		"Link":        &URLPropertyConfig{},
+		"Mail":        &EmailPropertyConfig{},
+		"Phone":       &PhoneNumberPropertyConfig{},
+		"Total":       &FormulaPropertyConfig{},
+		"Project":     &RelationPropertyConfig{},
+		"Count":       &RollupPropertyConfig{},
+		"Created":     &CreatedTimePropertyConfig{},
+		"Author":      &CreatedByPropertyConfig{},
+		"Edited":      &LastEditedTimePropertyConfig{},
+		"Editor":      &LastEditedByPropertyConfig{},
+		"ID":          &UniqueIDPropertyConfig{},
+		"Verified":    &VerificationPropertyConfig{},
+		"Action":      &ButtonPropertyConfig{},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("UnmarshalJSON() got %d properties, want %d", len(got), len(want))
+	}
+	for name, config := range want {
+		if reflect.TypeOf(got[name]) != reflect.TypeOf(config) {
+			t.Errorf("UnmarshalJSON() %s got %T, want %T", name, got[name], config)
+		}
+		if got[name].GetID() == "" {
+			t.Errorf("UnmarshalJSON() %s has no ID", name)
+		}
+	}
+	if name := got["Name"].(*TitlePropertyConfig).Name; name != "Name" {
+		t.Errorf("UnmarshalJSON() got name %q, want Name", name)
+	}
+	if groups := got["Stage"].(*StatusPropertyConfig).Status.Groups; len(groups) != 1 || len(groups[0].OptionIDs) != 1 {
+		t.Errorf("UnmarshalJSON() got status groups %v", groups)
+	}
+}
+
+func TestPropertyConfigs_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		config PropertyConfig
+		want   string
+	}{
+		{
+			name:   "select without options",
+			config: SelectPropertyConfig{Type: PropertyConfigTypeSelect},
+			want:   `{"type":"select","select":{}}`,
+		},
+		{
+			name:   "status",
+			config: StatusPropertyConfig{Type: PropertyConfigStatus},
+			want:   `{"type":"status","status":{}}`,
+		},
+		{
+			name: "rollup by name",
+			config: RollupPropertyConfig{Type: PropertyConfigTypeRollup, Rollup: RollupConfig{
+				RelationPropertyName: "Project",
+				RollupPropertyName:   "Budget",
+				Function:             FunctionSum,
+			}},
+			want: `{"type":"rollup","rollup":{"relation_property_name":"Project","rollup_property_name":"Budget","function":"sum"}}`,
+		},
+		{
+			name:   "renamed checkbox",
+			config: CheckboxPropertyConfig{ID: "abc", Name: "Done", Type: PropertyConfigTypeCheckbox},
+			want:   `{"id":"abc","name":"Done","type":"checkbox","checkbox":{}}`,
+		},
+		{
+			name:   "unique id without prefix",
+			config: UniqueIDPropertyConfig{Type: PropertyConfigUniqueID},
+			want:   `{"type":"unique_id","unique_id":{}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
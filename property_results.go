@@ -0,0 +1,77 @@
+package notionapi
+
+// Value returns the result of the formula according to its type: a string,
+// a float64, a bool or a *DateObject, nil for the dates not set and the
+// types unknown.
+func (f Formula) Value() interface{} {
+	switch f.Type {
+	case FormulaTypeString:
+		return f.String
+	case FormulaTypeNumber:
+		return f.Number
+	case FormulaTypeBoolean:
+		return f.Boolean
+	case FormulaTypeDate:
+		if f.Date != nil {
+			return f.Date
+		}
+	}
+	return nil
+}
+
+// AsString returns the result of the formula if it is a string.
+func (f Formula) AsString() (string, bool) {
+	return f.String, f.Type == FormulaTypeString
+}
+
+// AsNumber returns the result of the formula if it is a number.
+func (f Formula) AsNumber() (float64, bool) {
+	return f.Number, f.Type == FormulaTypeNumber
+}
+
+// AsBool returns the result of the formula if it is a boolean.
+func (f Formula) AsBool() (bool, bool) {
+	return f.Boolean, f.Type == FormulaTypeBoolean
+}
+
+// AsDate returns the result of the formula if it is a date, nil for the dates
+// not set.
+func (f Formula) AsDate() (*DateObject, bool) {
+	return f.Date, f.Type == FormulaTypeDate
+}
+
+// Value returns the result of the rollup according to its type: a float64, a
+// *DateObject or a PropertyArray, nil for the dates not set and the rollups
+// that are incomplete or unsupported.
+func (r Rollup) Value() interface{} {
+	switch r.Type {
+	case RollupTypeNumber:
+		return r.Number
+	case RollupTypeDate:
+		if r.Date != nil {
+			return r.Date
+		}
+	case RollupTypeArray:
+		return r.Array
+	}
+	return nil
+}
+
+// AsNumber returns the result of the rollup if it is a number, as computed by
+// functions such as sum or count.
+func (r Rollup) AsNumber() (float64, bool) {
+	return r.Number, r.Type == RollupTypeNumber
+}
+
+// AsDate returns the result of the rollup if it is a date, as computed by
+// functions such as earliest_date, nil for the dates not set.
+func (r Rollup) AsDate() (*DateObject, bool) {
+	return r.Date, r.Type == RollupTypeDate
+}
+
+// AsArray returns the result of the rollup if it is an array, as computed by
+// the show_original and show_unique functions: the values of the property
+// rolled up of the pages related, without their IDs.
+func (r Rollup) AsArray() (PropertyArray, bool) {
+	return r.Array, r.Type == RollupTypeArray
+}
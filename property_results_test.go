@@ -0,0 +1,71 @@
+package notionapi_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestFormulaAndRollupValues(t *testing.T) {
+	var properties notionapi.Properties
+	err := json.Unmarshal([]byte(`{
+		"Label": {"id": "a", "type": "formula", "formula": {"type": "string", "string": "late"}},
+		"Score": {"id": "b", "type": "formula", "formula": {"type": "number", "number": 4.5}},
+		"Overdue": {"id": "c", "type": "formula", "formula": {"type": "boolean", "boolean": false}},
+		"Next": {"id": "d", "type": "formula", "formula": {"type": "date", "date": null}},
+		"Total": {"id": "e", "type": "rollup", "rollup": {"type": "number", "number": 12, "function": "sum"}},
+		"Names": {"id": "f", "type": "rollup", "rollup": {"type": "array", "function": "show_original", "array": [
+			{"type": "title", "title": [{"type": "text", "text": {"content": "Task"}, "plain_text": "Task"}]}
+		]}},
+		"Latest": {"id": "g", "type": "rollup", "rollup": {"type": "incomplete", "function": "latest_date"}}
+	}`), &properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	formula := func(name string) notionapi.Formula {
+		return properties[name].(*notionapi.FormulaProperty).Formula
+	}
+	rollup := func(name string) notionapi.Rollup {
+		return properties[name].(*notionapi.RollupProperty).Rollup
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{name: "string formula", value: formula("Label").Value(), want: "late"},
+		{name: "number formula", value: formula("Score").Value(), want: 4.5},
+		{name: "boolean formula", value: formula("Overdue").Value(), want: false},
+		{name: "date formula not set", value: formula("Next").Value(), want: nil},
+		{name: "number rollup", value: rollup("Total").Value(), want: 12.0},
+		{name: "rollup function", value: rollup("Total").Function, want: notionapi.FunctionSum},
+		{name: "incomplete rollup", value: rollup("Latest").Value(), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.value, tt.want) {
+				t.Errorf("got = %#v, want %#v", tt.value, tt.want)
+			}
+		})
+	}
+
+	if _, ok := formula("Label").AsNumber(); ok {
+		t.Error("AsNumber() of a string formula: got ok")
+	}
+	if s, ok := formula("Label").AsString(); !ok || s != "late" {
+		t.Errorf("AsString() got = %q, %v", s, ok)
+	}
+	if date, ok := formula("Next").AsDate(); !ok || date != nil {
+		t.Errorf("AsDate() got = %v, %v", date, ok)
+	}
+	array, ok := rollup("Names").AsArray()
+	if !ok || len(array) != 1 || notionapi.PropertyText(array[0]) != "Task" {
+		t.Errorf("AsArray() got = %v, %v", array, ok)
+	}
+	if _, ok := rollup("Latest").AsDate(); ok {
+		t.Error("AsDate() of an incomplete rollup: got ok")
+	}
+}
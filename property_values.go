@@ -0,0 +1,246 @@
+package notionapi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrPropertyNotFound is returned by the accessors of Properties for the
+// properties that are not set.
+var ErrPropertyNotFound = errors.New("property not found")
+
+// get returns the property with the name specified, as a pointer.
+func (p Properties) get(name string) (Property, error) {
+	property, ok := p[name]
+	if !ok || property == nil {
+		return nil, fmt.Errorf("%w: %q", ErrPropertyNotFound, name)
+	}
+	return propertyPointer(property), nil
+}
+
+// propertyPointer returns property as a pointer, for the properties set by
+// value to be handled as the properties decoded.
+func propertyPointer(property Property) Property {
+	if v := reflect.ValueOf(property); v.Kind() != reflect.Ptr {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		property = ptr.Interface().(Property)
+	}
+	return property
+}
+
+func propertyTypeError(name string, property Property, want PropertyType) error {
+	return &PropertyTypeError{Name: name, Type: property.GetType(), Want: want}
+}
+
+// Title returns the plain text of the title property, which every page of a
+// database has.
+func (p Properties) Title() (string, error) {
+	for name, property := range p {
+		if property != nil && property.GetType() == PropertyTypeTitle {
+			return p.Text(name)
+		}
+	}
+	return "", fmt.Errorf("%w: title", ErrPropertyNotFound)
+}
+
+// Text returns the plain text of a title or rich_text property.
+func (p Properties) Text(name string) (string, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return "", err
+	}
+	switch property := property.(type) {
+	case *TitleProperty:
+		return concatenateRichText(property.Title), nil
+	case *RichTextProperty:
+		return concatenateRichText(property.RichText), nil
+	}
+	return "", propertyTypeError(name, property, PropertyTypeRichText)
+}
+
+// Number returns the value of a number property, 0 if it is empty.
+func (p Properties) Number(name string) (float64, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return 0, err
+	}
+	if property, ok := property.(*NumberProperty); ok {
+		return property.Number, nil
+	}
+	return 0, propertyTypeError(name, property, PropertyTypeNumber)
+}
+
+// Select returns the name of the option of a select property, "" if it is
+// empty.
+func (p Properties) Select(name string) (string, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return "", err
+	}
+	if property, ok := property.(*SelectProperty); ok {
+		return property.Select.Name, nil
+	}
+	return "", propertyTypeError(name, property, PropertyTypeSelect)
+}
+
+// MultiSelect returns the names of the options of a multi_select property.
+func (p Properties) MultiSelect(name string) ([]string, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return nil, err
+	}
+	if property, ok := property.(*MultiSelectProperty); ok {
+		names := make([]string, len(property.MultiSelect))
+		for i, option := range property.MultiSelect {
+			names[i] = option.Name
+		}
+		return names, nil
+	}
+	return nil, propertyTypeError(name, property, PropertyTypeMultiSelect)
+}
+
+// Status returns the name of the option of a status property.
+func (p Properties) Status(name string) (string, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return "", err
+	}
+	if property, ok := property.(*StatusProperty); ok {
+		return property.Status.Name, nil
+	}
+	return "", propertyTypeError(name, property, PropertyTypeStatus)
+}
+
+// Date returns the start of a date property, the zero time if it is empty.
+func (p Properties) Date(name string) (time.Time, error) {
+	start, _, err := p.DateRange(name)
+	return start, err
+}
+
+// DateRange returns the start and the end of a date property. The end is the
+// zero time for dates that are not ranges.
+func (p Properties) DateRange(name string) (start, end time.Time, err error) {
+	property, err := p.get(name)
+	if err != nil {
+		return start, end, err
+	}
+	date, ok := property.(*DateProperty)
+	if !ok {
+		return start, end, propertyTypeError(name, property, PropertyTypeDate)
+	}
+	if date.Date == nil {
+		return start, end, nil
+	}
+	if date.Date.Start != nil {
+		start = time.Time(*date.Date.Start)
+	}
+	if date.Date.End != nil {
+		end = time.Time(*date.Date.End)
+	}
+	return start, end, nil
+}
+
+// Checkbox returns whether a checkbox property is checked.
+func (p Properties) Checkbox(name string) (bool, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return false, err
+	}
+	if property, ok := property.(*CheckboxProperty); ok {
+		return property.Checkbox, nil
+	}
+	return false, propertyTypeError(name, property, PropertyTypeCheckbox)
+}
+
+// URL returns the value of a url property.
+func (p Properties) URL(name string) (string, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return "", err
+	}
+	if property, ok := property.(*URLProperty); ok {
+		return property.URL, nil
+	}
+	return "", propertyTypeError(name, property, PropertyTypeURL)
+}
+
+// Email returns the value of an email property.
+func (p Properties) Email(name string) (string, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return "", err
+	}
+	if property, ok := property.(*EmailProperty); ok {
+		return property.Email, nil
+	}
+	return "", propertyTypeError(name, property, PropertyTypeEmail)
+}
+
+// PhoneNumber returns the value of a phone_number property.
+func (p Properties) PhoneNumber(name string) (string, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return "", err
+	}
+	if property, ok := property.(*PhoneNumberProperty); ok {
+		return property.PhoneNumber, nil
+	}
+	return "", propertyTypeError(name, property, PropertyTypePhoneNumber)
+}
+
+// Relations returns the IDs of the pages of a relation property. Relations
+// of more than 25 pages are truncated in page objects: see
+// PageService.GetPropertyValue.
+func (p Properties) Relations(name string) ([]PageID, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return nil, err
+	}
+	if property, ok := property.(*RelationProperty); ok {
+		ids := make([]PageID, len(property.Relation))
+		for i, relation := range property.Relation {
+			ids[i] = relation.ID
+		}
+		return ids, nil
+	}
+	return nil, propertyTypeError(name, property, PropertyTypeRelation)
+}
+
+// People returns the users of a people property.
+func (p Properties) People(name string) ([]User, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return nil, err
+	}
+	if property, ok := property.(*PeopleProperty); ok {
+		return property.People, nil
+	}
+	return nil, propertyTypeError(name, property, PropertyTypePeople)
+}
+
+// Files returns the files of a files property.
+func (p Properties) Files(name string) ([]File, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return nil, err
+	}
+	if property, ok := property.(*FilesProperty); ok {
+		return property.Files, nil
+	}
+	return nil, propertyTypeError(name, property, PropertyTypeFiles)
+}
+
+// UniqueID returns the ID of a unique_id property.
+func (p Properties) UniqueID(name string) (UniqueID, error) {
+	property, err := p.get(name)
+	if err != nil {
+		return UniqueID{}, err
+	}
+	if property, ok := property.(*UniqueIDProperty); ok {
+		return property.UniqueID, nil
+	}
+	return UniqueID{}, propertyTypeError(name, property, PropertyTypeUniqueID)
+}
@@ -0,0 +1,93 @@
+package notionapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestPropertiesAccessors(t *testing.T) {
+	due := notionapi.Date(time.Date(2021, 5, 24, 0, 0, 0, 0, time.UTC))
+	props := notionapi.Properties{
+		"Name": &notionapi.TitleProperty{Type: notionapi.PropertyTypeTitle, Title: []notionapi.RichText{
+			{PlainText: "Q3 "}, {PlainText: "Report"},
+		}},
+		"Price":   &notionapi.NumberProperty{Type: notionapi.PropertyTypeNumber, Number: 12.5},
+		"Status":  notionapi.SelectProperty{Type: notionapi.PropertyTypeSelect, Select: notionapi.Option{Name: "Active"}},
+		"Tags":    &notionapi.MultiSelectProperty{Type: notionapi.PropertyTypeMultiSelect, MultiSelect: []notionapi.Option{{Name: "a"}, {Name: "b"}}},
+		"Due":     &notionapi.DateProperty{Type: notionapi.PropertyTypeDate, Date: &notionapi.DateObject{Start: &due}},
+		"Done":    &notionapi.CheckboxProperty{Type: notionapi.PropertyTypeCheckbox, Checkbox: true},
+		"Project": &notionapi.RelationProperty{Type: notionapi.PropertyTypeRelation, Relation: []notionapi.Relation{{ID: "page_1"}}},
+	}
+
+	title, err := props.Title()
+	if err != nil || title != "Q3 Report" {
+		t.Errorf("Title() = %q, %v", title, err)
+	}
+	price, err := props.Number("Price")
+	if err != nil || price != 12.5 {
+		t.Errorf("Number() = %v, %v", price, err)
+	}
+	status, err := props.Select("Status")
+	if err != nil || status != "Active" {
+		t.Errorf("Select() = %q, %v", status, err)
+	}
+	tags, err := props.MultiSelect("Tags")
+	if err != nil || !reflect.DeepEqual(tags, []string{"a", "b"}) {
+		t.Errorf("MultiSelect() = %v, %v", tags, err)
+	}
+	date, err := props.Date("Due")
+	if err != nil || !date.Equal(time.Time(due)) {
+		t.Errorf("Date() = %v, %v", date, err)
+	}
+	done, err := props.Checkbox("Done")
+	if err != nil || !done {
+		t.Errorf("Checkbox() = %v, %v", done, err)
+	}
+	relations, err := props.Relations("Project")
+	if err != nil || !reflect.DeepEqual(relations, []notionapi.PageID{"page_1"}) {
+		t.Errorf("Relations() = %v, %v", relations, err)
+	}
+
+	if _, err = props.Number("Missing"); !errors.Is(err, notionapi.ErrPropertyNotFound) {
+		t.Errorf("Number() of a missing property error = %v, want ErrPropertyNotFound", err)
+	}
+	var typeErr *notionapi.PropertyTypeError
+	if _, err = props.Number("Status"); !errors.As(err, &typeErr) || typeErr.Type != notionapi.PropertyTypeSelect {
+		t.Errorf("Number() of a select property error = %v, want a *PropertyTypeError", err)
+	}
+}
+
+func TestNumberPropertyEmpty(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantEmpty bool
+	}{
+		{name: "null", data: `{"id":"a","type":"number","number":null}`, wantEmpty: true},
+		{name: "zero", data: `{"id":"a","type":"number","number":0}`},
+		{name: "value", data: `{"id":"a","type":"number","number":1.5}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var property notionapi.NumberProperty
+			if err := json.Unmarshal([]byte(tt.data), &property); err != nil {
+				t.Fatal(err)
+			}
+			if property.IsEmpty() != tt.wantEmpty {
+				t.Errorf("IsEmpty() = %v, want %v", property.IsEmpty(), tt.wantEmpty)
+			}
+			got, err := json.Marshal(property)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.data {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.data)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package notionapi
+
+import "context"
+
+// Query runs the query of requestBody on the database with the ID specified,
+// following pagination, and returns its pages decoded into rows of type T,
+// a struct or a pointer to a struct, with DecodePage. It is the typed
+// version of Client.QueryInto.
+func Query[T any](ctx context.Context, client *Client, id DatabaseID, requestBody *DatabaseQueryRequest) ([]T, error) {
+	var rows []T
+	if err := client.QueryInto(ctx, id, requestBody, &rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
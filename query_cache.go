@@ -0,0 +1,132 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// WithQueryCache caches the responses of DatabaseService.Query for ttl, by
+// database and request: the queries with the same filter, sorts, cursor, page
+// size and properties are answered from the cache until they expire.
+//
+// The queries of a database are invalidated when the client updates it, and
+// when it creates, updates or moves the pages of the database. The changes
+// made in Notion, or by other clients, are seen once the queries expire. See
+// Client.InvalidateQueryCache.
+//
+// The responses from the cache share their pages with the response cached,
+// which must not be modified.
+func WithQueryCache(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.queryCache = &queryCache{ttl: ttl, now: time.Now, queries: map[string]map[string]queryCacheEntry{}}
+	}
+}
+
+// InvalidateQueryCache removes the queries of the database with the ID
+// specified from the cache of WithQueryCache, or all of them when id is
+// empty.
+func (c *Client) InvalidateQueryCache(id DatabaseID) {
+	if c.queryCache == nil {
+		return
+	}
+	if id == "" {
+		c.queryCache.clear()
+		return
+	}
+	c.queryCache.invalidate(id)
+}
+
+// invalidateParentQueries invalidates the queries of the database parent
+// belongs to, or all of them for the parents that are data sources, whose
+// database is not always known.
+func (c *Client) invalidateParentQueries(parent Parent) {
+	if c.queryCache == nil {
+		return
+	}
+	switch {
+	case parent.DatabaseID != "":
+		c.queryCache.invalidate(parent.DatabaseID)
+	case parent.Type == ParentTypeDataSourceID:
+		c.queryCache.clear()
+	}
+}
+
+type queryCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu sync.Mutex
+	// queries are the responses cached, by database ID and request
+	queries map[string]map[string]queryCacheEntry
+}
+
+type queryCacheEntry struct {
+	response *DatabaseQueryResponse
+	expires  time.Time
+}
+
+// queryCacheKey returns the key of a query in the cache.
+func queryCacheKey(requestBody *DatabaseQueryRequest) (string, error) {
+	if requestBody == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(requestBody)
+	if err != nil {
+		return "", err
+	}
+	return string(data) + filterPropertiesQuery(requestBody.FilterProperties), nil
+}
+
+// get returns the response cached of a query, nil if there is none.
+func (qc *queryCache) get(id DatabaseID, key string) *DatabaseQueryResponse {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	queries := qc.queries[pathID(id.String())]
+	entry, ok := queries[key]
+	if !ok {
+		return nil
+	}
+	if !qc.now().Before(entry.expires) {
+		delete(queries, key)
+		return nil
+	}
+	response := *entry.response
+	response.Results = append([]Page(nil), response.Results...)
+	return &response
+}
+
+// put caches the response of a query, and removes the queries of the
+// database that expired.
+func (qc *queryCache) put(id DatabaseID, key string, response *DatabaseQueryResponse) {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	now := qc.now()
+	queries := qc.queries[pathID(id.String())]
+	if queries == nil {
+		queries = map[string]queryCacheEntry{}
+		qc.queries[pathID(id.String())] = queries
+	}
+	for k, entry := range queries {
+		if !now.Before(entry.expires) {
+			delete(queries, k)
+		}
+	}
+	cached := *response
+	cached.Results = append([]Page(nil), response.Results...)
+	queries[key] = queryCacheEntry{response: &cached, expires: now.Add(qc.ttl)}
+}
+
+func (qc *queryCache) invalidate(id DatabaseID) {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	delete(qc.queries, pathID(id.String()))
+}
+
+func (qc *queryCache) clear() {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	qc.queries = map[string]map[string]queryCacheEntry{}
+}
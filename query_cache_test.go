@@ -0,0 +1,122 @@
+package notionapi_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestWithQueryCache(t *testing.T) {
+	done := notionapi.PropertyFilter{Property: "Done", Checkbox: &notionapi.CheckboxFilterCondition{Equals: true}}
+	query := func(client *notionapi.Client, filter notionapi.Filter) func() error {
+		return func() error {
+			_, err := client.Database.Query(context.Background(), "database_id", &notionapi.DatabaseQueryRequest{Filter: filter})
+			return err
+		}
+	}
+
+	tests := []struct {
+		name        string
+		ttl         time.Duration
+		calls       func(*notionapi.Client) []func() error
+		wantQueries int
+	}{
+		{
+			name: "identical queries are cached",
+			ttl:  time.Minute,
+			calls: func(client *notionapi.Client) []func() error {
+				return []func() error{query(client, done), query(client, done)}
+			},
+			wantQueries: 1,
+		},
+		{
+			name: "queries are cached by filter",
+			ttl:  time.Minute,
+			calls: func(client *notionapi.Client) []func() error {
+				return []func() error{query(client, done), query(client, nil), query(client, done)}
+			},
+			wantQueries: 2,
+		},
+		{
+			name: "expired queries",
+			ttl:  0,
+			calls: func(client *notionapi.Client) []func() error {
+				return []func() error{query(client, done), query(client, done)}
+			},
+			wantQueries: 2,
+		},
+		{
+			name: "creating a page invalidates the queries of its database",
+			ttl:  time.Minute,
+			calls: func(client *notionapi.Client) []func() error {
+				return []func() error{query(client, done), func() error {
+					_, err := client.Page.Create(context.Background(), &notionapi.PageCreateRequest{
+						Parent: notionapi.Parent{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "database_id"},
+					})
+					return err
+				}, query(client, done)}
+			},
+			wantQueries: 2,
+		},
+		{
+			name: "updating a page invalidates the queries of its database",
+			ttl:  time.Minute,
+			calls: func(client *notionapi.Client) []func() error {
+				return []func() error{query(client, done), func() error {
+					_, err := client.Page.Archive(context.Background(), "page_id")
+					return err
+				}, query(client, done)}
+			},
+			wantQueries: 2,
+		},
+		{
+			name: "pages of other databases",
+			ttl:  time.Minute,
+			calls: func(client *notionapi.Client) []func() error {
+				return []func() error{query(client, done), func() error {
+					_, err := client.Page.Create(context.Background(), &notionapi.PageCreateRequest{
+						Parent: notionapi.Parent{Type: notionapi.ParentTypeDatabaseID, DatabaseID: "other_id"},
+					})
+					return err
+				}, query(client, done)}
+			},
+			wantQueries: 1,
+		},
+		{
+			name: "explicit invalidation",
+			ttl:  time.Minute,
+			calls: func(client *notionapi.Client) []func() error {
+				return []func() error{query(client, done), func() error {
+					client.InvalidateQueryCache("database_id")
+					return nil
+				}, query(client, done)}
+			},
+			wantQueries: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := 0
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if strings.HasSuffix(req.URL.Path, "/query") {
+					queries++
+					return newJSONResponse(http.StatusOK, `{"object":"list","results":[{"object":"page","id":"page_1"}],"has_more":false}`)
+				}
+				return newJSONResponse(http.StatusOK, `{"object":"page","id":"page_id","parent":{"type":"database_id","database_id":"database_id"}}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c), notionapi.WithQueryCache(tt.ttl))
+			for _, call := range tt.calls(client) {
+				if err := call(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if queries != tt.wantQueries {
+				t.Errorf("queries sent = %d, want %d", queries, tt.wantQueries)
+			}
+		})
+	}
+}
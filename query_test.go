@@ -0,0 +1,28 @@
+package notionapi_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestQuery(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[`+decodeTestPage+`]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tasks, err := notionapi.Query[decodedTask](context.Background(), client, "database_id", &notionapi.DatabaseQueryRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || tasks[0].Name != "Buy milk" {
+		t.Errorf("Query() got %+v", tasks)
+	}
+
+	if _, err := notionapi.Query[string](context.Background(), client, "database_id", nil); err == nil {
+		t.Error("Query() of strings: expected an error")
+	}
+}
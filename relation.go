@@ -0,0 +1,110 @@
+package notionapi
+
+import (
+	"context"
+)
+
+// NewSingleRelationPropertyConfig returns the configuration of a relation to
+// the pages of the database with the ID specified, which does not appear in
+// that database.
+func NewSingleRelationPropertyConfig(database DatabaseID) RelationPropertyConfig {
+	return RelationPropertyConfig{
+		Type: PropertyConfigTypeRelation,
+		Relation: RelationConfig{
+			DatabaseID:     database,
+			Type:           RelationSingleProperty,
+			SingleProperty: &SingleProperty{},
+		},
+	}
+}
+
+// NewDualRelationPropertyConfig returns the configuration of a relation to
+// the pages of the database with the ID specified, synced with a relation of
+// that database named syncedPropertyName. Notion names the synced relation
+// after the database when syncedPropertyName is empty.
+func NewDualRelationPropertyConfig(database DatabaseID, syncedPropertyName string) RelationPropertyConfig {
+	return RelationPropertyConfig{
+		Type: PropertyConfigTypeRelation,
+		Relation: RelationConfig{
+			DatabaseID:   database,
+			Type:         RelationDualProperty,
+			DualProperty: &DualProperty{SyncedPropertyName: syncedPropertyName},
+		},
+	}
+}
+
+// IsDual reports whether the relation is synced with a relation of the
+// related database.
+func (p RelationPropertyConfig) IsDual() bool {
+	return p.Relation.Type == RelationDualProperty || p.Relation.DualProperty != nil
+}
+
+// SyncedProperty returns the ID and name of the relation of the related
+// database the relation is synced with, empty for single relations.
+func (p RelationPropertyConfig) SyncedProperty() (PropertyID, string) {
+	if dual := p.Relation.DualProperty; dual != nil && (dual.SyncedPropertyID != "" || dual.SyncedPropertyName != "") {
+		return dual.SyncedPropertyID, dual.SyncedPropertyName
+	}
+	// the fields of relations before the 2022-06-28 version of the API
+	return p.Relation.SyncedPropertyID, p.Relation.SyncedPropertyName
+}
+
+// NewRollupPropertyConfig returns the configuration of a rollup computing
+// function over rollupProperty, a property of the pages related by the
+// relation property of the database named relationProperty.
+func NewRollupPropertyConfig(relationProperty, rollupProperty string, function FunctionType) RollupPropertyConfig {
+	return RollupPropertyConfig{
+		Type: PropertyConfigTypeRollup,
+		Rollup: RollupConfig{
+			RelationPropertyName: relationProperty,
+			RollupPropertyName:   rollupProperty,
+			Function:             function,
+		},
+	}
+}
+
+// ExpandRelations retrieves the pages related to page by its relation
+// property with the name specified, in the order of the relation. The
+// relations of more than 25 pages, truncated in page objects, are retrieved
+// whole first.
+//
+// The pages are retrieved DefaultConcurrency at a time. The pages that
+// cannot be retrieved are reported with a *BatchError, and left out of the
+// pages returned.
+func (c *Client) ExpandRelations(ctx context.Context, page *Page, name string) ([]*Page, error) {
+	property, err := page.Properties.get(name)
+	if err != nil {
+		return nil, err
+	}
+	relation, ok := property.(*RelationProperty)
+	if !ok {
+		return nil, propertyTypeError(name, property, PropertyTypeRelation)
+	}
+	if relation.HasMore {
+		value, err := c.Page.GetPropertyValue(ctx, PageID(page.ID), PropertyID(relation.ID))
+		if err != nil {
+			return nil, err
+		}
+		if relation, ok = value.(*RelationProperty); !ok {
+			return nil, propertyTypeError(name, value, PropertyTypeRelation)
+		}
+	}
+
+	pages := make([]*Page, len(relation.Relation))
+	errs := forEachConcurrently(ctx, len(pages), DefaultConcurrency, func(i int) error {
+		var err error
+		pages[i], err = c.Page.Get(ctx, relation.Relation[i].ID)
+		return err
+	})
+
+	related := make([]*Page, 0, len(pages))
+	for i, page := range pages {
+		if errs[i] == nil {
+			related = append(related, page)
+		}
+	}
+	if err := newBatchError(errs, func(i int) string { return relation.Relation[i].ID.String() }); err != nil {
+		return related, err
+	}
+	return related, nil
+}
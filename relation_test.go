@@ -0,0 +1,104 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestRelationPropertyConfigs(t *testing.T) {
+	tests := []struct {
+		name   string
+		config notionapi.PropertyConfig
+		want   string
+	}{
+		{
+			name:   "single relation",
+			config: notionapi.NewSingleRelationPropertyConfig("database_id"),
+			want:   `{"type":"relation","relation":{"database_id":"database_id","type":"single_property","single_property":{}}}`,
+		},
+		{
+			name:   "dual relation",
+			config: notionapi.NewDualRelationPropertyConfig("database_id", "Tasks"),
+			want:   `{"type":"relation","relation":{"database_id":"database_id","type":"dual_property","dual_property":{"synced_property_name":"Tasks"}}}`,
+		},
+		{
+			name:   "rollup",
+			config: notionapi.NewRollupPropertyConfig("Tasks", "Estimate", notionapi.FunctionSum),
+			want:   `{"type":"rollup","rollup":{"relation_property_name":"Tasks","rollup_property_name":"Estimate","function":"sum"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("synced property", func(t *testing.T) {
+		var configs notionapi.PropertyConfigs
+		err := json.Unmarshal([]byte(`{"Project":{"id":"rel","type":"relation","relation":{"database_id":"database_id","type":"dual_property","dual_property":{"synced_property_name":"Tasks","synced_property_id":"abc"}}}}`), &configs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		relation := configs["Project"].(*notionapi.RelationPropertyConfig)
+		if !relation.IsDual() {
+			t.Error("IsDual() got = false, want true")
+		}
+		if id, name := relation.SyncedProperty(); id != "abc" || name != "Tasks" {
+			t.Errorf("SyncedProperty() got = %q, %q", id, name)
+		}
+	})
+}
+
+func TestClientExpandRelations(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		switch req.URL.RequestURI() {
+		case "/v1/pages/source_id/properties/rel":
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"type":"property_item",
+				"property_item":{"id":"rel","type":"relation","relation":{}},
+				"results":[
+					{"object":"property_item","id":"rel","type":"relation","relation":{"id":"page_1"}},
+					{"object":"property_item","id":"rel","type":"relation","relation":{"id":"missing"}},
+					{"object":"property_item","id":"rel","type":"relation","relation":{"id":"page_2"}}]}`)
+		case "/v1/pages/missing":
+			return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"not found"}`)
+		}
+		id := strings.TrimPrefix(req.URL.Path, "/v1/pages/")
+		return newJSONResponse(http.StatusOK, `{"object":"page","id":"`+id+`","properties":{}}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	page := &notionapi.Page{ID: "source_id", Properties: notionapi.Properties{
+		"Project": &notionapi.RelationProperty{ID: "rel", Type: notionapi.PropertyTypeRelation, Relation: []notionapi.Relation{{ID: "page_1"}}, HasMore: true},
+		"Name":    &notionapi.TitleProperty{Type: notionapi.PropertyTypeTitle},
+	}}
+	pages, err := client.ExpandRelations(context.Background(), page, "Project")
+	var batchErr *notionapi.BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 1 || batchErr.Errors["missing"] == nil {
+		t.Errorf("ExpandRelations() error = %v, want a batch error for missing", err)
+	}
+	var got []notionapi.ObjectID
+	for _, page := range pages {
+		got = append(got, page.ID)
+	}
+	if want := []notionapi.ObjectID{"page_1", "page_2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandRelations() got = %v, want %v", got, want)
+	}
+
+	var typeErr *notionapi.PropertyTypeError
+	if _, err = client.ExpandRelations(context.Background(), page, "Name"); !errors.As(err, &typeErr) {
+		t.Errorf("ExpandRelations() error = %v, want a *PropertyTypeError", err)
+	}
+}
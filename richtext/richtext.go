@@ -0,0 +1,155 @@
+// Package richtext builds Notion rich text.
+//
+//	richText := richtext.New().
+//		Text("Hello ").
+//		Bold("world").
+//		Text(", see ").
+//		Link("the docs", "https://developers.notion.com").
+//		Build()
+package richtext
+
+import "github.com/robinlbt/notionapi"
+
+// MaxTextLength is the maximum length of the content of a text object, in
+// UTF-16 code units.
+const MaxTextLength = notionapi.MaxTextContentLength
+
+// Builder builds rich text. The zero value is ready to use.
+type Builder struct {
+	richText []notionapi.RichText
+}
+
+// New returns an empty Builder.
+func New() *Builder {
+	return &Builder{}
+}
+
+// Text appends unstyled text.
+func (b *Builder) Text(content string) *Builder {
+	return b.Styled(content, notionapi.Annotations{})
+}
+
+// Bold appends bold text.
+func (b *Builder) Bold(content string) *Builder {
+	return b.Styled(content, notionapi.Annotations{Bold: true})
+}
+
+// Italic appends italic text.
+func (b *Builder) Italic(content string) *Builder {
+	return b.Styled(content, notionapi.Annotations{Italic: true})
+}
+
+// Strikethrough appends struck through text.
+func (b *Builder) Strikethrough(content string) *Builder {
+	return b.Styled(content, notionapi.Annotations{Strikethrough: true})
+}
+
+// Underline appends underlined text.
+func (b *Builder) Underline(content string) *Builder {
+	return b.Styled(content, notionapi.Annotations{Underline: true})
+}
+
+// Code appends inline code.
+func (b *Builder) Code(content string) *Builder {
+	return b.Styled(content, notionapi.Annotations{Code: true})
+}
+
+// Color appends text of the color specified, which can be a background color.
+func (b *Builder) Color(content string, color notionapi.Color) *Builder {
+	return b.Styled(content, notionapi.Annotations{Color: color})
+}
+
+// Styled appends text with the annotations specified.
+func (b *Builder) Styled(content string, annotations notionapi.Annotations) *Builder {
+	return b.append(content, "", annotations)
+}
+
+// Link appends unstyled text linking to url.
+func (b *Builder) Link(content, url string) *Builder {
+	return b.append(content, url, notionapi.Annotations{})
+}
+
+// StyledLink appends text linking to url with the annotations specified.
+func (b *Builder) StyledLink(content, url string, annotations notionapi.Annotations) *Builder {
+	return b.append(content, url, annotations)
+}
+
+// Mention appends a mention of the user with the ID specified.
+func (b *Builder) Mention(id notionapi.UserID) *Builder {
+	return b.Append(notionapi.NewUserMention(id))
+}
+
+// Mentions appends mentions of the users with the IDs specified, separated
+// by spaces. In comments, the users mentioned are notified.
+//
+//	richText := richtext.New().Mentions(reviewer, owner).Text(" please review").Build()
+func (b *Builder) Mentions(ids ...notionapi.UserID) *Builder {
+	for i, id := range ids {
+		if i > 0 {
+			b.Text(" ")
+		}
+		b.Mention(id)
+	}
+	return b
+}
+
+// PageMention appends a mention of the page with the ID specified.
+func (b *Builder) PageMention(id notionapi.PageID) *Builder {
+	return b.Append(notionapi.NewPageMention(id))
+}
+
+// DatabaseMention appends a mention of the database with the ID specified.
+func (b *Builder) DatabaseMention(id notionapi.DatabaseID) *Builder {
+	return b.Append(notionapi.NewDatabaseMention(id))
+}
+
+// DateMention appends a mention of a date, or a date range if end is not nil.
+func (b *Builder) DateMention(start notionapi.Date, end *notionapi.Date) *Builder {
+	return b.Append(notionapi.NewDateMention(start, end))
+}
+
+// Equation appends an inline LaTeX equation.
+func (b *Builder) Equation(expression string) *Builder {
+	b.richText = append(b.richText, notionapi.RichText{
+		Type:     notionapi.ObjectTypeEquation,
+		Equation: &notionapi.Equation{Expression: expression},
+	})
+	return b
+}
+
+// Append appends rich text as is.
+func (b *Builder) Append(richText ...notionapi.RichText) *Builder {
+	b.richText = append(b.richText, richText...)
+	return b
+}
+
+// Build returns the rich text, with the text longer than MaxTextLength split
+// across several text objects.
+func (b *Builder) Build() []notionapi.RichText {
+	return Split(b.richText)
+}
+
+func (b *Builder) append(content, url string, annotations notionapi.Annotations) *Builder {
+	if content == "" {
+		return b
+	}
+	rt := notionapi.RichText{
+		Type: notionapi.ObjectTypeText,
+		Text: &notionapi.Text{Content: content},
+	}
+	if url != "" {
+		rt.Text.Link = &notionapi.Link{Url: url}
+	}
+	if annotations != (notionapi.Annotations{}) {
+		rt.Annotations = &annotations
+	}
+	b.richText = append(b.richText, rt)
+	return b
+}
+
+// Split returns richText with the text objects longer than MaxTextLength
+// split into several objects with the same annotations and link, with
+// notionapi.SplitRichText.
+func Split(richText []notionapi.RichText) []notionapi.RichText {
+	return notionapi.SplitRichText(richText)
+}
@@ -0,0 +1,88 @@
+package richtext_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/robinlbt/notionapi"
+	"github.com/robinlbt/notionapi/richtext"
+)
+
+func TestBuilder(t *testing.T) {
+	got, err := json.Marshal(richtext.New().
+		Text("Hello ").
+		Bold("world").
+		Link("docs", "https://developers.notion.com").
+		Mention("user_id").
+		Equation("E = mc^2").
+		Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"type":"text","text":{"content":"Hello "}},` +
+		`{"type":"text","text":{"content":"world"},"annotations":{"bold":true,"italic":false,"strikethrough":false,"underline":false,"code":false}},` +
+		`{"type":"text","text":{"content":"docs","link":{"url":"https://developers.notion.com"}}},` +
+		`{"type":"mention","mention":{"type":"user","user":{"object":"user","id":"user_id"}}},` +
+		`{"type":"equation","equation":{"expression":"E = mc^2"}}]`
+	if string(got) != want {
+		t.Errorf("Build() got = %s\nwant %s", got, want)
+	}
+}
+
+func TestBuilderMentions(t *testing.T) {
+	got, err := json.Marshal(richtext.New().Mentions("user_1", "user_2").Text(" please review").Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"type":"mention","mention":{"type":"user","user":{"object":"user","id":"user_1"}}},` +
+		`{"type":"text","text":{"content":" "}},` +
+		`{"type":"mention","mention":{"type":"user","user":{"object":"user","id":"user_2"}}},` +
+		`{"type":"text","text":{"content":" please review"}}]`
+	if string(got) != want {
+		t.Errorf("Mentions() got = %s\nwant %s", got, want)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	content := strings.Repeat("é", richtext.MaxTextLength*2+1)
+	got := richtext.New().StyledLink(content, "https://example.com", notionapi.Annotations{Italic: true}).Build()
+
+	if len(got) != 3 {
+		t.Fatalf("Build() got %d objects, want 3", len(got))
+	}
+	var joined string
+	for _, rt := range got {
+		if !rt.Annotations.Italic || rt.Text.Link.Url != "https://example.com" {
+			t.Errorf("Build() lost the style of %+v", rt)
+		}
+		joined += rt.Text.Content
+	}
+	if joined != content {
+		t.Error("Build() did not preserve the content")
+	}
+
+	t.Run("utf-16", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			content string
+			want    []int
+		}{
+			{name: "emoji", content: strings.Repeat("😀", 1001), want: []int{1000, 1}},
+			{name: "surrogate pair at the limit", content: "a" + strings.Repeat("😀", 1000), want: []int{1000, 1}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var got []int
+				for _, rt := range richtext.New().Text(tt.content).Build() {
+					got = append(got, utf8.RuneCountInString(rt.Text.Content))
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Build() got objects of %v characters, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,226 @@
+package notionapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// SavedQuery is a query of a database that can be saved with the
+// configuration of an application and loaded at runtime: its JSON form is
+// the filter and sorts Notion expects, along with a name and a database.
+//
+//	{
+//		"name": "overdue",
+//		"database": "4cd9d7cd-7b4a-4b6f-9d4b-5ed4b5b4b0a1",
+//		"filter": {"and": [
+//			{"property": "Done", "checkbox": {"equals": false}},
+//			{"property": "Due", "date": {"before": "2024-01-01"}}
+//		]},
+//		"sorts": [{"property": "Due", "direction": "ascending"}]
+//	}
+//
+// The queries in YAML files are loaded with DecodeSavedQuery.
+type SavedQuery struct {
+	Name     string       `json:"name,omitempty"`
+	Database DatabaseID   `json:"database,omitempty"`
+	Filter   Filter       `json:"filter,omitempty"`
+	Sorts    []SortObject `json:"sorts,omitempty"`
+}
+
+// UnmarshalJSON decodes the filter of the query with UnmarshalFilter.
+func (q *SavedQuery) UnmarshalJSON(data []byte) error {
+	var tmp struct {
+		Name     string          `json:"name"`
+		Database DatabaseID      `json:"database"`
+		Filter   json.RawMessage `json:"filter"`
+		Sorts    []SortObject    `json:"sorts"`
+	}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	filter, err := UnmarshalFilter(tmp.Filter)
+	if err != nil {
+		return err
+	}
+	*q = SavedQuery{Name: tmp.Name, Database: tmp.Database, Filter: filter, Sorts: tmp.Sorts}
+	return nil
+}
+
+// Validate checks that the filter of the query is not nested deeper than
+// Notion allows and that its sorts are complete.
+func (q *SavedQuery) Validate() error {
+	if q.Filter != nil {
+		if err := ValidateFilter(q.Filter); err != nil {
+			return err
+		}
+	}
+	for i, sort := range q.Sorts {
+		if (sort.Property == "") == (sort.Timestamp == "") {
+			return fmt.Errorf("sort %d: exactly one of property and timestamp must be set", i)
+		}
+		if sort.Direction != SortOrderASC && sort.Direction != SortOrderDESC {
+			return fmt.Errorf("sort %d: invalid sort direction %q", i, sort.Direction)
+		}
+	}
+	return nil
+}
+
+// Request returns the request of the query, to be given to
+// DatabaseService.Query.
+func (q *SavedQuery) Request() *DatabaseQueryRequest {
+	request := &DatabaseQueryRequest{Sorts: q.Sorts}
+	if q.Filter != nil {
+		request.Filter = FlattenFilter(q.Filter)
+	}
+	return request
+}
+
+// ParseSavedQuery decodes and validates the query of data, in JSON.
+func ParseSavedQuery(data []byte) (*SavedQuery, error) {
+	var query SavedQuery
+	if err := json.Unmarshal(data, &query); err != nil {
+		return nil, err
+	}
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	return &query, nil
+}
+
+// DecodeSavedQuery decodes and validates the query of v, a value decoded from
+// a configuration file such as the interface{} values YAML libraries decode
+// documents to. The maps of v may have keys of any type, which are formatted
+// as strings.
+func DecodeSavedQuery(v interface{}) (*SavedQuery, error) {
+	data, err := json.Marshal(jsonValue(v))
+	if err != nil {
+		return nil, err
+	}
+	return ParseSavedQuery(data)
+}
+
+// jsonValue returns v with the maps with keys that are not strings converted
+// to maps with string keys, which encoding/json cannot marshal otherwise.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonValue(value)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[key] = jsonValue(value)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, value := range v {
+			s[i] = jsonValue(value)
+		}
+		return s
+	}
+	return v
+}
+
+// LoadSavedQueries decodes and validates the queries of r, a JSON array of
+// queries, which must have distinct names.
+func LoadSavedQueries(r io.Reader) ([]SavedQuery, error) {
+	var queries []SavedQuery
+	if err := json.NewDecoder(r).Decode(&queries); err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for i := range queries {
+		if err := queries[i].Validate(); err != nil {
+			return nil, fmt.Errorf("query %d %q: %w", i, queries[i].Name, err)
+		}
+		if names[queries[i].Name] && queries[i].Name != "" {
+			return nil, fmt.Errorf("query %d: duplicate name %q", i, queries[i].Name)
+		}
+		names[queries[i].Name] = true
+	}
+	return queries, nil
+}
+
+// SaveQueries writes queries to w as an indented JSON array, which
+// LoadSavedQueries reads back.
+func SaveQueries(w io.Writer, queries []SavedQuery) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(queries)
+}
+
+// UnmarshalFilter decodes data, a filter in the JSON form Notion expects,
+// into the and, or, timestamp and property filters it is made of. The
+// conditions of unknown property types, and the filters that are none of
+// those, are rejected. It returns nil when data is empty or null.
+func UnmarshalFilter(data []byte) (Filter, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for _, operator := range []string{"and", "or"} {
+		raw, ok := object[operator]
+		if !ok {
+			continue
+		}
+		if len(object) != 1 {
+			return nil, fmt.Errorf("%s filter with other keys", operator)
+		}
+		var children []json.RawMessage
+		if err := json.Unmarshal(raw, &children); err != nil {
+			return nil, fmt.Errorf("%s filter: %w", operator, err)
+		}
+		filters := make([]Filter, len(children))
+		for i, child := range children {
+			filter, err := UnmarshalFilter(child)
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", operator, i, err)
+			}
+			if filter == nil {
+				return nil, fmt.Errorf("%s[%d]: empty filter", operator, i)
+			}
+			filters[i] = filter
+		}
+		if operator == "and" {
+			return AndCompoundFilter(filters), nil
+		}
+		return OrCompoundFilter(filters), nil
+	}
+
+	var filter Filter
+	switch {
+	case object["timestamp"] != nil:
+		filter = &TimestampFilter{}
+	case object["property"] != nil:
+		filter = &PropertyFilter{}
+	default:
+		return nil, errors.New("filter without and, or, timestamp or property")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(filter); err != nil {
+		return nil, err
+	}
+	switch f := filter.(type) {
+	case *TimestampFilter:
+		return *f, nil
+	case *PropertyFilter:
+		if len(object) == 1 {
+			return nil, fmt.Errorf("property filter %s without condition", object["property"])
+		}
+		return *f, nil
+	}
+	return filter, nil
+}
@@ -0,0 +1,135 @@
+package notionapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestParseSavedQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "compound filter and sorts",
+			data: `{"name":"overdue","database":"database_id","filter":{"and":[
+				{"property":"Done","checkbox":{"equals":false}},
+				{"property":"Due","date":{"before":"2024-01-01T00:00:00Z"}},
+				{"or":[{"timestamp":"created_time","created_time":{"past_week":{}}},{"property":"Estimate","number":{"equals":0}}]}
+			]},"sorts":[{"property":"Due","direction":"ascending"}]}`,
+			want: `{"name":"overdue","database":"database_id","filter":{"and":[{"property":"Done","checkbox":{"does_not_equal":true}},{"property":"Due","date":{"before":"2024-01-01T00:00:00Z"}},{"or":[{"timestamp":"created_time","created_time":{"past_week":{}}},{"property":"Estimate","number":{"equals":0}}]}]},"sorts":[{"property":"Due","direction":"ascending"}]}`,
+		},
+		{
+			name: "without filter",
+			data: `{"sorts":[{"timestamp":"last_edited_time","direction":"descending"}]}`,
+			want: `{"sorts":[{"timestamp":"last_edited_time","direction":"descending"}]}`,
+		},
+		{
+			name:    "unknown condition",
+			data:    `{"filter":{"property":"Done","toggle":{"equals":true}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "property without condition",
+			data:    `{"filter":{"property":"Done"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown filter",
+			data:    `{"filter":{"not":[]}}`,
+			wantErr: true,
+		},
+		{
+			name:    "nested too deep",
+			data:    `{"filter":{"and":[{"or":[{"and":[{"property":"Done","checkbox":{"equals":true}}]}]}]}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid sort direction",
+			data:    `{"sorts":[{"property":"Due","direction":"up"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "sort without property",
+			data:    `{"sorts":[{"direction":"ascending"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := notionapi.ParseSavedQuery([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSavedQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			data, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("ParseSavedQuery() = %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeSavedQuery(t *testing.T) {
+	// as decoded from YAML by libraries decoding maps with interface{} keys
+	v := map[interface{}]interface{}{
+		"name": "done",
+		"filter": map[interface{}]interface{}{
+			"property": "Done",
+			"checkbox": map[interface{}]interface{}{"equals": true},
+		},
+		"sorts": []interface{}{
+			map[interface{}]interface{}{"property": "Due", "direction": "descending"},
+		},
+	}
+	got, err := notionapi.DecodeSavedQuery(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &notionapi.SavedQuery{
+		Name: "done",
+		Filter: notionapi.PropertyFilter{
+			Property: "Done",
+			Checkbox: &notionapi.CheckboxFilterCondition{Equals: true},
+		},
+		Sorts: []notionapi.SortObject{{Property: "Due", Direction: notionapi.SortOrderDESC}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeSavedQuery() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadSavedQueries(t *testing.T) {
+	queries := []notionapi.SavedQuery{
+		{Name: "done", Filter: notionapi.PropertyFilter{Property: "Done", Checkbox: &notionapi.CheckboxFilterCondition{Equals: true}}},
+		{Name: "recent", Sorts: []notionapi.SortObject{{Timestamp: notionapi.TimestampCreated, Direction: notionapi.SortOrderDESC}}},
+	}
+	var buf bytes.Buffer
+	if err := notionapi.SaveQueries(&buf, queries); err != nil {
+		t.Fatal(err)
+	}
+	got, err := notionapi.LoadSavedQueries(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, queries) {
+		t.Errorf("LoadSavedQueries() = %+v, want %+v", got, queries)
+	}
+
+	_, err = notionapi.LoadSavedQueries(strings.NewReader(`[{"name":"a"},{"name":"a"}]`))
+	if err == nil {
+		t.Error("LoadSavedQueries() of duplicate names error = nil, want an error")
+	}
+}
@@ -0,0 +1,291 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+type SchemaOpType string
+
+func (t SchemaOpType) String() string {
+	return string(t)
+}
+
+const (
+	SchemaOpAdd           SchemaOpType = "add"
+	SchemaOpRename        SchemaOpType = "rename"
+	SchemaOpChangeType    SchemaOpType = "change_type"
+	SchemaOpUpdateOptions SchemaOpType = "update_options"
+	SchemaOpUpdate        SchemaOpType = "update"
+	// SchemaOpUnsupported is a change the API cannot make, such as to the
+	// options of status properties.
+	SchemaOpUnsupported SchemaOpType = "unsupported"
+)
+
+// ErrUnsupportedSchemaOp is returned by Client.MigrateSchema for the schemas
+// whose diff has SchemaOpUnsupported operations.
+var ErrUnsupportedSchemaOp = errors.New("schema change not supported by the API")
+
+// SchemaOp is an operation of a database schema diff, as returned by
+// DiffSchemas.
+type SchemaOp struct {
+	Type SchemaOpType
+	// Name is the name of the property in the desired schema.
+	Name string
+	// OldName is the name of the property in the current schema, different
+	// from Name for renames and empty for additions.
+	OldName string
+	// Config is the configuration of the property in the desired schema.
+	Config PropertyConfig
+}
+
+// DiffSchemas returns the operations turning the current schema of a
+// database, as retrieved with DatabaseService.Get, into the desired one,
+// sorted by name.
+//
+// The properties of desired are matched with the properties of current by
+// ID, then by name: a property with the ID of a property of current under
+// another name renames it. The title property is matched with the title of
+// current whatever its name, since databases have a single title.
+//
+// Matched properties whose type differ are changed to the new type. The
+// options of select and multi-select properties are updated when their names
+// or colors differ, and the configuration of the other properties, such as
+// the expression of formulas, when it differs. The options of status
+// properties cannot be updated with the API: their changes are
+// SchemaOpUnsupported operations. Only the
+// settings of desired are compared: the settings it omits are left as they
+// are.
+//
+// The properties of current missing from desired are kept: they are removed
+// by setting them to nil in a DatabaseUpdateRequest.
+func DiffSchemas(current, desired PropertyConfigs) ([]SchemaOp, error) {
+	byID := map[PropertyID]string{}
+	var title string
+	for name, config := range current {
+		if config == nil {
+			continue
+		}
+		if id := config.GetID(); id != "" {
+			byID[id] = name
+		}
+		if config.GetType() == PropertyConfigTypeTitle {
+			title = name
+		}
+	}
+
+	names := make([]string, 0, len(desired))
+	for name := range desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var ops []SchemaOp
+	for _, name := range names {
+		config := desired[name]
+		if config == nil {
+			continue
+		}
+		oldName, ok := byID[config.GetID()]
+		if !ok {
+			if _, ok = current[name]; ok {
+				oldName = name
+			} else if config.GetType() == PropertyConfigTypeTitle && title != "" {
+				oldName = title
+			}
+		}
+		if oldName == "" {
+			ops = append(ops, SchemaOp{Type: SchemaOpAdd, Name: name, Config: config})
+			continue
+		}
+		if oldName != name {
+			ops = append(ops, SchemaOp{Type: SchemaOpRename, Name: name, OldName: oldName, Config: config})
+		}
+
+		old := current[oldName]
+		if old.GetType() != config.GetType() {
+			ops = append(ops, SchemaOp{Type: SchemaOpChangeType, Name: name, OldName: oldName, Config: config})
+			continue
+		}
+		differs, err := configDiffers(old, config)
+		if err != nil {
+			return nil, err
+		}
+		if !differs {
+			continue
+		}
+		op := SchemaOpUpdate
+		switch {
+		case config.GetType() == PropertyConfigStatus:
+			op = SchemaOpUnsupported
+		case hasOptions(config.GetType()):
+			op = SchemaOpUpdateOptions
+		}
+		ops = append(ops, SchemaOp{Type: op, Name: name, OldName: oldName, Config: config})
+	}
+	return ops, nil
+}
+
+// MigrateSchema updates the schema of the database with the ID specified to
+// desired, with the operations returned by DiffSchemas, in a single request.
+// It returns the operations applied, if any.
+//
+// The options of select and multi-select properties are replaced with the
+// options of desired: the options missing from it are removed by Notion. When
+// the diff has SchemaOpUnsupported operations, nothing is updated and an
+// error wrapping ErrUnsupportedSchemaOp is returned.
+func (c *Client) MigrateSchema(ctx context.Context, id DatabaseID, desired PropertyConfigs) ([]SchemaOp, error) {
+	database, err := c.Database.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ops, err := DiffSchemas(database.Properties, desired)
+	if err != nil || len(ops) == 0 {
+		return nil, err
+	}
+	for _, op := range ops {
+		if op.Type == SchemaOpUnsupported {
+			return nil, fmt.Errorf("%w: property %q: the options of status properties cannot be updated", ErrUnsupportedSchemaOp, op.Name)
+		}
+	}
+
+	properties := PropertyConfigs{}
+	for _, op := range ops {
+		key := op.OldName
+		if key == "" {
+			key = op.Name
+		}
+		config, err := rawConfig(op.Config)
+		if err != nil {
+			return nil, err
+		}
+		if op.OldName != "" && op.OldName != op.Name {
+			config["name"] = op.Name
+		}
+		if op.Type == SchemaOpUpdateOptions {
+			keepOptionIDs(config, database.Properties[op.OldName])
+		}
+		if op.OldName != "" && config.GetType() == PropertyConfigStatus {
+			// the options of existing status properties cannot be sent
+			config[string(PropertyConfigStatus)] = map[string]interface{}{}
+		}
+		properties[key] = config
+	}
+	if _, err = c.Database.Update(ctx, id, &DatabaseUpdateRequest{Properties: properties}); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+// rawPropertyConfig is a property configuration as JSON, sent as is.
+type rawPropertyConfig map[string]interface{}
+
+func (p rawPropertyConfig) GetType() PropertyConfigType {
+	t, _ := p["type"].(string)
+	return PropertyConfigType(t)
+}
+
+func (p rawPropertyConfig) GetID() PropertyID {
+	id, _ := p["id"].(string)
+	return PropertyID(id)
+}
+
+// rawConfig returns config as JSON, without its ID and name.
+func rawConfig(config PropertyConfig) (rawPropertyConfig, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var raw rawPropertyConfig
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	delete(raw, "id")
+	delete(raw, "name")
+	return raw, nil
+}
+
+// configDiffers reports whether the configuration of a property, of the same
+// type in old and new, differs. Only the settings of new are compared, the IDs
+// of options and groups excepted, so that the settings Notion fills in, such
+// as the IDs of the properties of rollups or the colors of options, do not
+// need to be specified.
+func configDiffers(old, new PropertyConfig) (bool, error) {
+	oldRaw, err := rawConfig(old)
+	if err != nil {
+		return false, err
+	}
+	newRaw, err := rawConfig(new)
+	if err != nil {
+		return false, err
+	}
+	key := string(new.GetType())
+	return !includes(oldRaw[key], newRaw[key]), nil
+}
+
+// includes reports whether old, a value decoded from JSON, has all the
+// settings of new.
+func includes(old, new interface{}) bool {
+	switch new := new.(type) {
+	case map[string]interface{}:
+		old, ok := old.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range new {
+			if k != "id" && k != "option_ids" && !includes(old[k], v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		old, ok := old.([]interface{})
+		if !ok || len(old) != len(new) {
+			return false
+		}
+		for i := range new {
+			if !includes(old[i], new[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(old, new)
+}
+
+func hasOptions(t PropertyConfigType) bool {
+	return t == PropertyConfigTypeSelect || t == PropertyConfigTypeMultiSelect
+}
+
+// keepOptionIDs sets the IDs of the options of config that old has an option
+// of the same name of, for Notion to update them instead of recreating them.
+func keepOptionIDs(config rawPropertyConfig, old PropertyConfig) {
+	var oldOptions []Option
+	switch old := old.(type) {
+	case *SelectPropertyConfig:
+		oldOptions = old.Select.Options
+	case *MultiSelectPropertyConfig:
+		oldOptions = old.MultiSelect.Options
+	}
+	ids := map[string]PropertyID{}
+	for _, option := range oldOptions {
+		ids[option.Name] = option.ID
+	}
+
+	typed, _ := config[string(config.GetType())].(map[string]interface{})
+	options, _ := typed["options"].([]interface{})
+	for _, option := range options {
+		option, ok := option.(map[string]interface{})
+		if !ok || option["id"] != nil {
+			continue
+		}
+		name, _ := option["name"].(string)
+		if id, ok := ids[name]; ok {
+			option["id"] = id.String()
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+const schemaDatabaseJSON = `{
+	"object": "database",
+	"id": "database_id",
+	"properties": {
+		"Name": {"id": "title", "name": "Name", "type": "title", "title": {}},
+		"Tags": {"id": "tags", "name": "Tags", "type": "multi_select", "multi_select": {"options": [{"id": "opt_a", "name": "A", "color": "red"}]}},
+		"Notes": {"id": "notes", "name": "Notes", "type": "rich_text", "rich_text": {}},
+		"State": {"id": "state", "name": "State", "type": "status", "status": {"options": [{"id": "opt_todo", "name": "To do", "color": "gray"}]}},
+		"Total": {"id": "total", "name": "Total", "type": "formula", "formula": {"expression": "1"}},
+		"Count": {"id": "count", "name": "Count", "type": "rollup", "rollup": {"relation_property_name": "Project", "relation_property_id": "rel", "rollup_property_name": "Name", "rollup_property_id": "title", "function": "count_all"}}
+	}
+}`
+
+func TestDiffSchemas(t *testing.T) {
+	var database notionapi.Database
+	if err := json.Unmarshal([]byte(schemaDatabaseJSON), &database); err != nil {
+		t.Fatal(err)
+	}
+	type op struct {
+		Type    notionapi.SchemaOpType
+		Name    string
+		OldName string
+	}
+	tests := []struct {
+		name    string
+		desired notionapi.PropertyConfigs
+		want    []op
+	}{
+		{
+			name: "same schema with settings omitted",
+			desired: notionapi.PropertyConfigs{
+				"Name":  notionapi.TitlePropertyConfig{Type: notionapi.PropertyConfigTypeTitle},
+				"Tags":  notionapi.MultiSelectPropertyConfig{Type: notionapi.PropertyConfigTypeMultiSelect, MultiSelect: notionapi.Select{Options: []notionapi.Option{{Name: "A"}}}},
+				"Total": notionapi.FormulaPropertyConfig{Type: notionapi.PropertyConfigTypeFormula, Formula: notionapi.FormulaConfig{Expression: "1"}},
+				"Count": notionapi.RollupPropertyConfig{Type: notionapi.PropertyConfigTypeRollup, Rollup: notionapi.RollupConfig{RelationPropertyName: "Project", RollupPropertyName: "Name", Function: notionapi.FunctionCountAll}},
+			},
+		},
+		{
+			name: "changes",
+			desired: notionapi.PropertyConfigs{
+				"Title":   notionapi.TitlePropertyConfig{Type: notionapi.PropertyConfigTypeTitle},
+				"Tags":    notionapi.MultiSelectPropertyConfig{Type: notionapi.PropertyConfigTypeMultiSelect, MultiSelect: notionapi.Select{Options: []notionapi.Option{{Name: "A"}, {Name: "B"}}}},
+				"Comment": notionapi.RichTextPropertyConfig{ID: "notes", Type: notionapi.PropertyConfigTypeRichText},
+				"Total":   notionapi.NumberPropertyConfig{Type: notionapi.PropertyConfigTypeNumber},
+				"Count":   notionapi.RollupPropertyConfig{Type: notionapi.PropertyConfigTypeRollup, Rollup: notionapi.RollupConfig{Function: notionapi.FunctionSum}},
+				"Done":    notionapi.CheckboxPropertyConfig{Type: notionapi.PropertyConfigTypeCheckbox},
+			},
+			want: []op{
+				{Type: notionapi.SchemaOpRename, Name: "Comment", OldName: "Notes"},
+				{Type: notionapi.SchemaOpUpdate, Name: "Count", OldName: "Count"},
+				{Type: notionapi.SchemaOpAdd, Name: "Done"},
+				{Type: notionapi.SchemaOpUpdateOptions, Name: "Tags", OldName: "Tags"},
+				{Type: notionapi.SchemaOpRename, Name: "Title", OldName: "Name"},
+				{Type: notionapi.SchemaOpChangeType, Name: "Total", OldName: "Total"},
+			},
+		},
+		{
+			name: "status options",
+			desired: notionapi.PropertyConfigs{
+				"State": notionapi.StatusPropertyConfig{Type: notionapi.PropertyConfigStatus, Status: notionapi.StatusConfig{Options: []notionapi.Option{{Name: "To do"}, {Name: "Done"}}}},
+			},
+			want: []op{
+				{Type: notionapi.SchemaOpUnsupported, Name: "State", OldName: "State"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops, err := notionapi.DiffSchemas(database.Properties, tt.desired)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []op
+			for _, o := range ops {
+				got = append(got, op{Type: o.Type, Name: o.Name, OldName: o.OldName})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffSchemas() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientMigrateSchema(t *testing.T) {
+	var gotBody string
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodPatch {
+			body, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotBody = string(body)
+		}
+		return newJSONResponse(http.StatusOK, schemaDatabaseJSON)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	ops, err := client.MigrateSchema(context.Background(), "database_id", notionapi.PropertyConfigs{
+		"Comment": notionapi.RichTextPropertyConfig{ID: "notes", Type: notionapi.PropertyConfigTypeRichText},
+		"Tags":    notionapi.MultiSelectPropertyConfig{Type: notionapi.PropertyConfigTypeMultiSelect, MultiSelect: notionapi.Select{Options: []notionapi.Option{{Name: "A"}, {Name: "B", Color: "blue"}}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 2 {
+		t.Errorf("MigrateSchema() got %d ops, want 2", len(ops))
+	}
+	want := `{"properties":{"Notes":{"name":"Comment","rich_text":{},"type":"rich_text"},"Tags":{"multi_select":{"options":[{"id":"opt_a","name":"A"},{"color":"blue","name":"B"}]},"type":"multi_select"}}}`
+	if gotBody != want {
+		t.Errorf("MigrateSchema() sent %s\nwant %s", gotBody, want)
+	}
+}
+
+func TestClientMigrateSchemaStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  notionapi.PropertyConfigs
+		wantBody string
+		wantErr  error
+	}{
+		{
+			name: "rename",
+			desired: notionapi.PropertyConfigs{
+				"Stage": notionapi.StatusPropertyConfig{ID: "state", Type: notionapi.PropertyConfigStatus, Status: notionapi.StatusConfig{Options: []notionapi.Option{{Name: "To do"}}}},
+			},
+			wantBody: `{"properties":{"State":{"name":"Stage","status":{},"type":"status"}}}`,
+		},
+		{
+			name: "options",
+			desired: notionapi.PropertyConfigs{
+				"Comment": notionapi.RichTextPropertyConfig{ID: "notes", Type: notionapi.PropertyConfigTypeRichText},
+				"State":   notionapi.StatusPropertyConfig{Type: notionapi.PropertyConfigStatus, Status: notionapi.StatusConfig{Options: []notionapi.Option{{Name: "Done"}}}},
+			},
+			wantErr: notionapi.ErrUnsupportedSchemaOp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBody string
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if req.Method == http.MethodPatch {
+					body, err := ioutil.ReadAll(req.Body)
+					if err != nil {
+						t.Fatal(err)
+					}
+					gotBody = string(body)
+				}
+				return newJSONResponse(http.StatusOK, schemaDatabaseJSON)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			_, err := client.MigrateSchema(context.Background(), "database_id", tt.desired)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MigrateSchema() error = %v, want %v", err, tt.wantErr)
+			}
+			if gotBody != tt.wantBody {
+				t.Errorf("MigrateSchema() sent %s\nwant %s", gotBody, tt.wantBody)
+			}
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package notionapi
 
+import (
+	"errors"
+	"fmt"
+)
+
 type SortOrder string
 
 type TimestampType string
@@ -9,3 +14,59 @@ type SortObject struct {
 	Timestamp TimestampType `json:"timestamp,omitempty"`
 	Direction SortOrder     `json:"direction,omitempty"`
 }
+
+// SortBuilder builds the sorts of database queries, the first sort taking
+// precedence. Its methods can be chained, and the first error they meet is
+// returned by Build:
+//
+//	sorts, err := notionapi.NewSortBuilder().
+//		Property("Priority", notionapi.SortOrderDESC).
+//		CreatedTime(notionapi.SortOrderASC).
+//		Build()
+type SortBuilder struct {
+	sorts []SortObject
+	err   error
+}
+
+// NewSortBuilder returns a builder without sorts.
+func NewSortBuilder() *SortBuilder {
+	return &SortBuilder{sorts: []SortObject{}}
+}
+
+// Property sorts by the property with the name or ID specified.
+func (b *SortBuilder) Property(name string, direction SortOrder) *SortBuilder {
+	if name == "" && b.err == nil {
+		b.err = errors.New("sort by a property without name")
+	}
+	return b.add(SortObject{Property: name, Direction: direction})
+}
+
+// CreatedTime sorts by the time the pages were created.
+func (b *SortBuilder) CreatedTime(direction SortOrder) *SortBuilder {
+	return b.add(SortObject{Timestamp: TimestampCreated, Direction: direction})
+}
+
+// LastEditedTime sorts by the time the pages were last edited.
+func (b *SortBuilder) LastEditedTime(direction SortOrder) *SortBuilder {
+	return b.add(SortObject{Timestamp: TimestampLastEdited, Direction: direction})
+}
+
+func (b *SortBuilder) add(sort SortObject) *SortBuilder {
+	if b.err != nil {
+		return b
+	}
+	if sort.Direction != SortOrderASC && sort.Direction != SortOrderDESC {
+		b.err = fmt.Errorf("invalid sort direction %q", sort.Direction)
+		return b
+	}
+	b.sorts = append(b.sorts, sort)
+	return b
+}
+
+// Build returns the sorts, to be set as DatabaseQueryRequest.Sorts.
+func (b *SortBuilder) Build() ([]SortObject, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.sorts, nil
+}
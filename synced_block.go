@@ -0,0 +1,57 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+)
+
+// NewSyncedBlock returns an original synced block holding children. Its
+// content can be reused elsewhere with duplicates made by
+// NewSyncedBlockDuplicate once it has been appended.
+func NewSyncedBlock(children ...Block) *SyncedBlock {
+	return &SyncedBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypeSyncedBlock,
+		},
+		SyncedBlock: Synced{Children: children},
+	}
+}
+
+// NewSyncedBlockDuplicate returns a synced block mirroring the content of the
+// original synced block with the ID specified. Duplicates have no children of
+// their own.
+func NewSyncedBlockDuplicate(original BlockID) *SyncedBlock {
+	return &SyncedBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypeSyncedBlock,
+		},
+		SyncedBlock: Synced{SyncedFrom: &SyncedFrom{BlockID: original}},
+	}
+}
+
+// IsOriginal reports whether b is an original synced block rather than a
+// duplicate.
+func (b *SyncedBlock) IsOriginal() bool {
+	return b.SyncedBlock.SyncedFrom == nil
+}
+
+// GetOriginalID returns the ID of the original synced block: the ID of b
+// itself if it is the original.
+func (b *SyncedBlock) GetOriginalID() BlockID {
+	if b.IsOriginal() {
+		return b.ID
+	}
+	return b.SyncedBlock.SyncedFrom.BlockID
+}
+
+// ResolveSyncedBlock retrieves the content of a synced block. The children of
+// a duplicate are those of its original, which are retrieved instead, so the
+// integration needs access to the page holding the original.
+func (bc *BlockClient) ResolveSyncedBlock(ctx context.Context, block *SyncedBlock) (Blocks, error) {
+	if block == nil {
+		return nil, errors.New("nil synced block")
+	}
+	return bc.getAllChildren(ctx, block.GetOriginalID())
+}
@@ -0,0 +1,66 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestNewSyncedBlock(t *testing.T) {
+	tests := []struct {
+		name  string
+		block *notionapi.SyncedBlock
+		want  string
+	}{
+		{
+			name:  "original",
+			block: notionapi.NewSyncedBlock(),
+			want:  `{"object":"block","type":"synced_block","synced_block":{"synced_from":null}}`,
+		},
+		{
+			name:  "duplicate",
+			block: notionapi.NewSyncedBlockDuplicate("original_id"),
+			want:  `{"object":"block","type":"synced_block","synced_block":{"synced_from":{"block_id":"original_id"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.block)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockClientResolveSyncedBlock(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/v1/blocks/original_id/children" {
+			t.Errorf("unexpected request to %s", req.URL)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"paragraph_id","type":"paragraph","paragraph":{"rich_text":[]}}]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	duplicate := notionapi.NewSyncedBlockDuplicate("original_id")
+	duplicate.ID = "duplicate_id"
+	if duplicate.IsOriginal() {
+		t.Error("IsOriginal() = true, want false")
+	}
+
+	got, err := client.Block.ResolveSyncedBlock(context.Background(), duplicate)
+	if err != nil {
+		t.Fatalf("ResolveSyncedBlock() error = %v", err)
+	}
+	if len(got) != 1 || got[0].GetID() != "paragraph_id" {
+		t.Errorf("ResolveSyncedBlock() got = %v", got)
+	}
+}
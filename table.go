@@ -0,0 +1,146 @@
+package notionapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TableBuilder builds table blocks, with their table_row children, from rows
+// of cells. Rows shorter than the widest row are padded with empty cells.
+type TableBuilder struct {
+	hasColumnHeader bool
+	hasRowHeader    bool
+	rows            [][][]RichText
+}
+
+// NewTableBuilder returns an empty TableBuilder.
+func NewTableBuilder() *TableBuilder {
+	return &TableBuilder{}
+}
+
+// ColumnHeader sets whether the first row of the table is its header.
+func (tb *TableBuilder) ColumnHeader(enabled bool) *TableBuilder {
+	tb.hasColumnHeader = enabled
+	return tb
+}
+
+// RowHeader sets whether the first column of the table is its header.
+func (tb *TableBuilder) RowHeader(enabled bool) *TableBuilder {
+	tb.hasRowHeader = enabled
+	return tb
+}
+
+// AddRow appends a row made of the cells specified.
+func (tb *TableBuilder) AddRow(cells ...[]RichText) *TableBuilder {
+	tb.rows = append(tb.rows, cells)
+	return tb
+}
+
+// AddTextRow appends a row of plain text cells.
+func (tb *TableBuilder) AddTextRow(cells ...string) *TableBuilder {
+	row := make([][]RichText, len(cells))
+	for i, cell := range cells {
+		row[i] = textCell(cell)
+	}
+	return tb.AddRow(row...)
+}
+
+// AddRows appends a row for each element of rows.
+func (tb *TableBuilder) AddRows(rows [][][]RichText) *TableBuilder {
+	for _, row := range rows {
+		tb.AddRow(row...)
+	}
+	return tb
+}
+
+// AddTextRows appends a row of plain text cells for each element of rows.
+func (tb *TableBuilder) AddTextRows(rows [][]string) *TableBuilder {
+	for _, row := range rows {
+		tb.AddTextRow(row...)
+	}
+	return tb
+}
+
+// Build returns the table block, ready to be appended with
+// BlockService.AppendChildren. The width of the table cannot be changed once
+// it is created.
+func (tb *TableBuilder) Build() (*TableBlock, error) {
+	width := 0
+	for _, row := range tb.rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+	if width == 0 {
+		return nil, errors.New("table has no cells")
+	}
+
+	children := make(Blocks, len(tb.rows))
+	for i, row := range tb.rows {
+		cells := make([][]RichText, width)
+		copy(cells, row)
+		for j := range cells {
+			if cells[j] == nil {
+				cells[j] = []RichText{}
+			}
+		}
+		children[i] = &TableRowBlock{
+			BasicBlock: BasicBlock{
+				Object: ObjectTypeBlock,
+				Type:   BlockTypeTableRowBlock,
+			},
+			TableRow: TableRow{Cells: cells},
+		}
+	}
+
+	return &TableBlock{
+		BasicBlock: BasicBlock{
+			Object: ObjectTypeBlock,
+			Type:   BlockTypeTableBlock,
+		},
+		Table: Table{
+			TableWidth:      width,
+			HasColumnHeader: tb.hasColumnHeader,
+			HasRowHeader:    tb.hasRowHeader,
+			Children:        children,
+		},
+	}, nil
+}
+
+// TableCells returns the cells of the rows of a table, as retrieved by
+// BlockService.GetTree. The header row, if any, is the first one.
+func TableCells(table *BlockNode) ([][][]RichText, error) {
+	if table == nil || table.Block.GetType() != BlockTypeTableBlock {
+		return nil, errors.New("not a table block")
+	}
+	rows := make([][][]RichText, 0, len(table.Children))
+	for _, child := range table.Children {
+		row, ok := child.Block.(*TableRowBlock)
+		if !ok {
+			return nil, fmt.Errorf("unexpected %s block in table", child.Block.GetType())
+		}
+		rows = append(rows, row.TableRow.Cells)
+	}
+	return rows, nil
+}
+
+// TableText returns the plain text of the cells of the rows of a table, as
+// retrieved by BlockService.GetTree.
+func TableText(table *BlockNode) ([][]string, error) {
+	rows, err := TableCells(table)
+	if err != nil {
+		return nil, err
+	}
+	text := make([][]string, len(rows))
+	for i, row := range rows {
+		text[i] = make([]string, len(row))
+		for j, cell := range row {
+			text[i][j] = concatenateRichText(cell)
+		}
+	}
+	return text, nil
+}
+
+func textCell(content string) []RichText {
+	return []RichText{{Type: ObjectTypeText, Text: &Text{Content: content}}}
+}
@@ -0,0 +1,65 @@
+package notionapi_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestTableBuilder(t *testing.T) {
+	t.Run("pads rows to the width of the table", func(t *testing.T) {
+		table, err := notionapi.NewTableBuilder().
+			ColumnHeader(true).
+			AddTextRows([][]string{{"Name", "Age"}, {"Ada"}}).
+			Build()
+		if err != nil {
+			t.Fatalf("Build() error = %v", err)
+		}
+		if table.Table.TableWidth != 2 || !table.Table.HasColumnHeader || table.Table.HasRowHeader {
+			t.Errorf("Build() table = %+v", table.Table)
+		}
+		if len(table.Table.Children) != 2 {
+			t.Fatalf("Build() got %d rows, want 2", len(table.Table.Children))
+		}
+		row := table.Table.Children[1].(*notionapi.TableRowBlock)
+		if len(row.TableRow.Cells) != 2 || row.TableRow.Cells[1] == nil {
+			t.Errorf("Build() row = %+v", row.TableRow.Cells)
+		}
+	})
+
+	t.Run("fails on empty tables", func(t *testing.T) {
+		if _, err := notionapi.NewTableBuilder().Build(); err == nil {
+			t.Error("Build() error = nil")
+		}
+	})
+}
+
+func TestTableText(t *testing.T) {
+	responses := map[string]string{
+		"/v1/blocks/table_id": `{"object":"block","id":"table_id","type":"table","has_children":true,
+			"table":{"table_width":2,"has_column_header":true,"has_row_header":false}}`,
+		"/v1/blocks/table_id/children": `{"object":"list","has_more":false,"results":[
+			{"object":"block","id":"row1","type":"table_row","table_row":{"cells":[[{"type":"text","text":{"content":"Name"},"plain_text":"Name"}],[{"type":"text","text":{"content":"Age"},"plain_text":"Age"}]]}},
+			{"object":"block","id":"row2","type":"table_row","table_row":{"cells":[[{"type":"text","text":{"content":"Ada"},"plain_text":"Ada"}],[]]}}]}`,
+	}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		return newJSONResponse(http.StatusOK, responses[req.URL.Path])
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	tree, err := client.Block.GetTree(context.Background(), "table_id", nil)
+	if err != nil {
+		t.Fatalf("GetTree() error = %v", err)
+	}
+	got, err := notionapi.TableText(tree)
+	if err != nil {
+		t.Fatalf("TableText() error = %v", err)
+	}
+	want := [][]string{{"Name", "Age"}, {"Ada", ""}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TableText() got = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrPageNotFound is returned by the lookups of pages that find none.
+var ErrPageNotFound = errors.New("page not found")
+
+// ParseUniqueID parses a unique ID as formatted by UniqueID.String, such as
+// "TASK-42", or "42" for the IDs without prefix.
+func ParseUniqueID(s string) (UniqueID, error) {
+	var id UniqueID
+	number := s
+	if i := strings.LastIndex(s, "-"); i >= 0 {
+		prefix := s[:i]
+		if prefix == "" {
+			return UniqueID{}, fmt.Errorf("invalid unique id %q", s)
+		}
+		id.Prefix = &prefix
+		number = s[i+1:]
+	}
+	n, err := strconv.Atoi(number)
+	if err != nil || n < 0 {
+		return UniqueID{}, fmt.Errorf("invalid unique id %q", s)
+	}
+	id.Number = n
+	return id, nil
+}
+
+// NewUniqueIDPropertyConfig returns the configuration of a unique_id property
+// numbering the pages of a database, whose IDs start with prefix when it is
+// not empty.
+func NewUniqueIDPropertyConfig(prefix string) UniqueIDPropertyConfig {
+	return UniqueIDPropertyConfig{
+		Type:     PropertyConfigUniqueID,
+		UniqueID: UniqueIDConfig{Prefix: prefix},
+	}
+}
+
+// FindPageByUniqueID returns the page of the database with the ID specified
+// whose unique_id property with the name specified has the ID id, such as
+// "TASK-42", or ErrPageNotFound. The prefix of id, if any, must be the
+// prefix of the property.
+func (c *Client) FindPageByUniqueID(ctx context.Context, databaseID DatabaseID, property, id string) (*Page, error) {
+	uniqueID, err := ParseUniqueID(id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.Database.Query(ctx, databaseID, &DatabaseQueryRequest{
+		Filter: PropertyFilter{
+			Property: property,
+			UniqueId: &UniqueIdFilterCondition{Equals: &uniqueID.Number},
+		},
+		PageSize: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Results) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrPageNotFound, id)
+	}
+	page := &res.Results[0]
+	if uniqueID.Prefix != nil {
+		found, err := page.Properties.UniqueID(property)
+		if err != nil {
+			return nil, err
+		}
+		if found.Prefix == nil || *found.Prefix != *uniqueID.Prefix {
+			return nil, fmt.Errorf("%w: %s", ErrPageNotFound, id)
+		}
+	}
+	return page, nil
+}
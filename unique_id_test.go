@@ -0,0 +1,113 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestParseUniqueID(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{s: "TASK-42", want: "TASK-42"},
+		{s: "42", want: "42"},
+		{s: "MY-TEAM-7", want: "MY-TEAM-7"},
+		{s: "-42", wantErr: true},
+		{s: "TASK-", wantErr: true},
+		{s: "TASK", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := notionapi.ParseUniqueID(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseUniqueID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseUniqueID() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewUniqueIDPropertyConfig(t *testing.T) {
+	got, err := json.Marshal(notionapi.NewUniqueIDPropertyConfig("TASK"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"type":"unique_id","unique_id":{"prefix":"TASK"}}`; string(got) != want {
+		t.Errorf("Marshal() got = %s, want %s", got, want)
+	}
+}
+
+func TestClientFindPageByUniqueID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		response string
+		wantErr  error
+	}{
+		{
+			name:     "found",
+			id:       "TASK-42",
+			response: `{"object":"list","results":[{"object":"page","id":"page_1","properties":{"Ticket":{"id":"a","type":"unique_id","unique_id":{"prefix":"TASK","number":42}}}}]}`,
+		},
+		{
+			name:     "other prefix",
+			id:       "BUG-42",
+			response: `{"object":"list","results":[{"object":"page","id":"page_1","properties":{"Ticket":{"id":"a","type":"unique_id","unique_id":{"prefix":"TASK","number":42}}}}]}`,
+			wantErr:  notionapi.ErrPageNotFound,
+		},
+		{
+			name:     "not found",
+			id:       "TASK-43",
+			response: `{"object":"list","results":[]}`,
+			wantErr:  notionapi.ErrPageNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(func(req *http.Request) *http.Response {
+				body, _ := ioutil.ReadAll(req.Body)
+				var query struct {
+					Filter struct {
+						Property string `json:"property"`
+						UniqueID struct {
+							Equals int `json:"equals"`
+						} `json:"unique_id"`
+					} `json:"filter"`
+				}
+				if err := json.Unmarshal(body, &query); err != nil {
+					t.Fatal(err)
+				}
+				if query.Filter.Property != "Ticket" || query.Filter.UniqueID.Equals == 0 {
+					t.Errorf("unexpected query %s", body)
+				}
+				return newJSONResponse(http.StatusOK, tt.response)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+			page, err := client.FindPageByUniqueID(context.Background(), "database_id", "Ticket", tt.id)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("FindPageByUniqueID() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			id, err := page.Properties.UniqueID("Ticket")
+			if err != nil || id.String() != tt.id {
+				t.Errorf("UniqueID() got = %s, %v", id, err)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ type UserService interface {
 	List(context.Context, *Pagination) (*UsersListResponse, error)
 	Get(context.Context, UserID) (*User, error)
 	Me(context.Context) (*User, error)
+	ListIterator(context.Context) *UserIterator
 }
 
 type UserClient struct {
@@ -25,7 +26,8 @@ type UserClient struct {
 }
 
 // Returns a paginated list of Users for the workspace. The response may contain
-// fewer than page_size of results.
+// fewer than page_size of results. The next page starts at the NextCursor of
+// the response, set as the StartCursor of pagination, while HasMore is true.
 //
 // See https://developers.notion.com/reference/get-users
 func (uc *UserClient) List(ctx context.Context, pagination *Pagination) (*UsersListResponse, error) {
@@ -49,11 +51,67 @@ func (uc *UserClient) List(ctx context.Context, pagination *Pagination) (*UsersL
 	return &response, nil
 }
 
+// ListIterator returns an iterator over all the users of the workspace, which
+// retrieves the pages of results as they are needed.
+//
+//	it := client.User.ListIterator(ctx)
+//	for it.Next() {
+//		user := it.User()
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle the error
+//	}
+func (uc *UserClient) ListIterator(ctx context.Context) *UserIterator {
+	return &UserIterator{ctx: ctx, client: uc}
+}
+
+// UserIterator iterates over the users of the workspace, following
+// next_cursor. It is not safe for concurrent use.
+type UserIterator struct {
+	ctx    context.Context
+	client *UserClient
+
+	users   []User
+	pager   cursorPager
+	current *User
+}
+
+// Next advances the iterator to the next user, which is then available
+// through User. It returns false when there are no more users or an error
+// occurred.
+func (it *UserIterator) Next() bool {
+	for len(it.users) == 0 {
+		if !it.pager.fetch(func(cursor Cursor) (Cursor, bool, error) {
+			res, err := it.client.List(it.ctx, &Pagination{StartCursor: cursor})
+			if err != nil {
+				return "", false, err
+			}
+			it.users = res.Results
+			return res.NextCursor, res.HasMore, nil
+		}) {
+			it.current = nil
+			return false
+		}
+	}
+	it.current, it.users = &it.users[0], it.users[1:]
+	return true
+}
+
+// User returns the user the iterator is at.
+func (it *UserIterator) User() *User {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *UserIterator) Err() error {
+	return it.pager.err
+}
+
 // Retrieves a User using the ID specified.
 //
 // See https://developers.notion.com/reference/get-user
 func (uc *UserClient) Get(ctx context.Context, id UserID) (*User, error) {
-	res, err := uc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s", id.String()), nil, nil, ContentTypeJSON)
+	res, err := uc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s", pathID(id.String())), nil, nil, ContentTypeJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +159,17 @@ func (uc *UserClient) Me(ctx context.Context) (*User, error) {
 
 type UserType string
 
+// PartialUser is a user referenced by the created_by and last_edited_by of
+// pages, databases and blocks, of which only Object and ID are set.
+// UserCache.Resolve retrieves the full users. Partial users are Users, so
+// that the full users can replace them.
+type PartialUser = User
+
+// IsPartial reports whether only the ID of the user is known.
+func (u User) IsPartial() bool {
+	return u.Type == "" && u.Name == "" && u.Person == nil && u.Bot == nil
+}
+
 type User struct {
 	Object    ObjectType `json:"object,omitempty"`
 	ID        UserID     `json:"id"`
@@ -118,11 +187,77 @@ type Person struct {
 type Bot struct {
 	Owner         Owner  `json:"owner"`
 	WorkspaceName string `json:"workspace_name"`
+	// WorkspaceLimits are the limits of the workspace of the bot, returned
+	// by UserService.Me.
+	WorkspaceLimits *WorkspaceLimits `json:"workspace_limits,omitempty"`
+	// Capabilities are the capabilities of the integration of the bot,
+	// returned by UserService.Me when Notion reports them. Nil when they are
+	// not known.
+	Capabilities *BotCapabilities `json:"capabilities,omitempty"`
 }
 
+type BotOwnerType string
+
+const (
+	// BotOwnerTypeWorkspace is the owner of the bots of internal
+	// integrations, owned by their workspace.
+	BotOwnerTypeWorkspace BotOwnerType = "workspace"
+	// BotOwnerTypeUser is the owner of the bots of public integrations,
+	// owned by the user who authorized them.
+	BotOwnerTypeUser BotOwnerType = "user"
+)
+
+// Owner is the owner of a bot: its workspace, or the user who authorized
+// the integration, set in User.
 type Owner struct {
-	Type      string `json:"type"`
-	Workspace bool   `json:"workspace"`
+	Type      BotOwnerType `json:"type"`
+	Workspace bool         `json:"workspace"`
+	User      *User        `json:"user,omitempty"`
+}
+
+// IsWorkspace reports whether the bot is owned by its workspace.
+func (o Owner) IsWorkspace() bool {
+	return o.Type == BotOwnerTypeWorkspace
+}
+
+// WorkspaceLimits are the limits of a workspace.
+type WorkspaceLimits struct {
+	// MaxFileUploadSizeInBytes is the size of the largest file that can be
+	// uploaded with FileUploadService.
+	MaxFileUploadSizeInBytes int64 `json:"max_file_upload_size_in_bytes"`
+}
+
+type UserInformationCapability string
+
+const (
+	UserInformationNone         UserInformationCapability = "no_user_information"
+	UserInformationWithoutEmail UserInformationCapability = "user_information_without_email"
+	UserInformationWithEmail    UserInformationCapability = "user_information_with_email"
+)
+
+// BotCapabilities are the capabilities of an integration, configured in its
+// settings, which limit the endpoints it can use.
+type BotCapabilities struct {
+	ReadContent    bool `json:"read_content"`
+	UpdateContent  bool `json:"update_content"`
+	InsertContent  bool `json:"insert_content"`
+	ReadComments   bool `json:"read_comments"`
+	InsertComments bool `json:"insert_comments"`
+	// UserInformation is the information about users the integration can
+	// read: whether it sees the users, and their emails.
+	UserInformation UserInformationCapability `json:"user_information"`
+}
+
+// CanReadUsers reports whether the integration can read the users of the
+// workspace.
+func (c *BotCapabilities) CanReadUsers() bool {
+	return c.UserInformation == UserInformationWithoutEmail || c.UserInformation == UserInformationWithEmail
+}
+
+// CanReadEmails reports whether the integration can read the email
+// addresses of users, which Client.FindUserByEmail needs.
+func (c *BotCapabilities) CanReadEmails() bool {
+	return c.UserInformation == UserInformationWithEmail
 }
 
 type UsersListResponse struct {
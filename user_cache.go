@@ -0,0 +1,255 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUserNotFound is returned by the lookups of users that find none.
+var ErrUserNotFound = errors.New("user not found")
+
+// DefaultUserCacheTTL is the time the users are cached by the clients without
+// WithUserCache.
+const DefaultUserCacheTTL = 5 * time.Minute
+
+// WithUserCache caches the users of the workspace looked up by the client,
+// such as with Client.FindUserByEmail, for ttl. See UserCache.
+func WithUserCache(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.userCache = NewUserCache(c.User, ttl)
+	}
+}
+
+// UserCache returns the cache of the users of the workspace of the client,
+// created with DefaultUserCacheTTL unless set with WithUserCache.
+func (c *Client) UserCache() *UserCache {
+	c.userCacheOnce.Do(func() {
+		if c.userCache == nil {
+			c.userCache = NewUserCache(c.User, DefaultUserCacheTTL)
+		}
+	})
+	return c.userCache
+}
+
+// FindUserByEmail returns the person of the workspace with the email
+// address specified, ignoring case, or ErrUserNotFound. The API cannot look up
+// users by email: the users are listed, and cached, with Client.UserCache.
+func (c *Client) FindUserByEmail(ctx context.Context, email string) (*User, error) {
+	return c.UserCache().FindUserByEmail(ctx, email)
+}
+
+// UserCache caches all the users of the workspace, listed with
+// UserService.ListIterator, for ttl, so that the IDs of people properties and
+// mentions can be looked up without a request per user. It is safe for
+// concurrent use.
+//
+// The users that are not listed, such as guests, are retrieved with
+// UserService.Get and cached along with the others. The users returned are
+// copies, which can be modified.
+type UserCache struct {
+	users UserService
+	ttl   time.Duration
+
+	mu      sync.Mutex
+	loaded  time.Time
+	byID    map[UserID]*User
+	ordered []*User
+	// err is the error of the listing of the users, and failed the errors
+	// of the users retrieved with UserService.Get, which are cached as the
+	// users are so that failures are not retried for every lookup.
+	err    error
+	failed map[UserID]error
+}
+
+// NewUserCache returns a cache retrieving users with users, usually
+// Client.User, whose users expire after ttl.
+func NewUserCache(users UserService, ttl time.Duration) *UserCache {
+	return &UserCache{users: users, ttl: ttl}
+}
+
+// All returns the users of the workspace, in the order they are listed.
+func (c *UserCache) All(ctx context.Context) ([]User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.load(ctx); err != nil {
+		return nil, err
+	}
+	users := make([]User, len(c.ordered))
+	for i, user := range c.ordered {
+		users[i] = user.clone()
+	}
+	return users, nil
+}
+
+// Get returns the user with the ID specified.
+func (c *UserCache) Get(ctx context.Context, id UserID) (*User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.load(ctx); err != nil {
+		return nil, err
+	}
+	key := UserID(pathID(id.String()))
+	if user, ok := c.byID[key]; ok {
+		user := user.clone()
+		return &user, nil
+	}
+	if err, ok := c.failed[key]; ok {
+		return nil, err
+	}
+	user, err := c.users.Get(ctx, id)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.failed[key] = err
+		}
+		return nil, err
+	}
+	c.byID[key] = user
+	cached := user.clone()
+	return &cached, nil
+}
+
+// FindUserByEmail returns the person of the workspace with the email
+// address specified, ignoring case, or ErrUserNotFound. Bots have no email.
+func (c *UserCache) FindUserByEmail(ctx context.Context, email string) (*User, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, ErrUserNotFound
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.load(ctx); err != nil {
+		return nil, err
+	}
+	for _, user := range c.ordered {
+		if user.Person != nil && strings.EqualFold(user.Person.Email, email) {
+			user := user.clone()
+			return &user, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUserNotFound, email)
+}
+
+// find returns the user that value references: the ID of a user, or the
+// email address or the name of a person, ignoring case. The names shared by
+// several users match none.
+func (c *UserCache) find(ctx context.Context, value string) (*User, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, ErrUserNotFound
+	}
+	user, err := c.findListed(ctx, value)
+	if err != nil || user != nil {
+		return user, err
+	}
+	if id, ok := canonicalID(value); ok {
+		// the users that are not listed, such as guests
+		return c.Get(ctx, UserID(id))
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUserNotFound, value)
+}
+
+// findListed returns the listed user that value references, as find does,
+// or nil.
+func (c *UserCache) findListed(ctx context.Context, value string) (*User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.load(ctx); err != nil {
+		return nil, err
+	}
+	var (
+		found *User
+		key   = pathID(value)
+	)
+	for _, user := range c.ordered {
+		if pathID(user.ID.String()) == key {
+			found = user
+			break
+		}
+		if strings.EqualFold(user.Name, value) || user.Person != nil && strings.EqualFold(user.Person.Email, value) {
+			if found != nil {
+				return nil, fmt.Errorf("%w: several users are named %s", ErrUserNotFound, value)
+			}
+			found = user
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	user := found.clone()
+	return &user, nil
+}
+
+// Resolve returns the full user that user references. Users that are not
+// partial are returned as is.
+func (c *UserCache) Resolve(ctx context.Context, user *PartialUser) (*User, error) {
+	if user == nil || !user.IsPartial() {
+		return user, nil
+	}
+	return c.Get(ctx, user.ID)
+}
+
+// Invalidate removes the users from the cache, which are listed again when
+// they are next needed.
+func (c *UserCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byID, c.ordered, c.err, c.failed = nil, nil, nil, nil
+}
+
+// clone returns a copy of the user which shares nothing with it, so that the
+// users of the cache cannot be modified through the users it returns.
+func (u *User) clone() User {
+	user := *u
+	if u.Person != nil {
+		person := *u.Person
+		user.Person = &person
+	}
+	if u.Bot != nil {
+		bot := *u.Bot
+		if bot.Owner.User != nil {
+			owner := bot.Owner.User.clone()
+			bot.Owner.User = &owner
+		}
+		if bot.WorkspaceLimits != nil {
+			limits := *bot.WorkspaceLimits
+			bot.WorkspaceLimits = &limits
+		}
+		if bot.Capabilities != nil {
+			capabilities := *bot.Capabilities
+			bot.Capabilities = &capabilities
+		}
+		user.Bot = &bot
+	}
+	return user
+}
+
+// load lists the users when they are not cached or have expired. A failed
+// listing is cached too, unless ctx is done, so that integrations which
+// cannot read users do not list them for every lookup.
+func (c *UserCache) load(ctx context.Context) error {
+	if (c.byID != nil || c.err != nil) && time.Since(c.loaded) < c.ttl {
+		return c.err
+	}
+	var (
+		byID    = map[UserID]*User{}
+		ordered []*User
+	)
+	it := c.users.ListIterator(ctx)
+	for it.Next() {
+		user := *it.User()
+		byID[UserID(pathID(user.ID.String()))] = &user
+		ordered = append(ordered, &user)
+	}
+	if err := it.Err(); err != nil {
+		if ctx.Err() == nil {
+			c.byID, c.ordered, c.err, c.failed, c.loaded = nil, nil, err, nil, time.Now()
+		}
+		return err
+	}
+	c.byID, c.ordered, c.err, c.failed, c.loaded = byID, ordered, nil, map[UserID]error{}, time.Now()
+	return nil
+}
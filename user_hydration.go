@@ -0,0 +1,90 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+)
+
+// WithUserHydration replaces the partial users of the pages the client
+// retrieves, creates and updates, and of the pages of database queries, with
+// the full users, as HydrateUsers does. The users are looked up in
+// Client.UserCache, which lists all the users of the workspace once rather
+// than retrieving them one by one.
+//
+// The users that cannot be resolved, such as when the integration cannot read
+// user information, are left partial: the pages are returned as if the option
+// was not set. The failures are cached along with the users, so that they
+// are not retried for every page.
+func WithUserHydration() ClientOption {
+	return func(c *Client) {
+		c.hydrateUsers = true
+	}
+}
+
+// HydrateUsers replaces the partial users of page with the full users from
+// Client.UserCache: the users who created and last edited the page, the users
+// of its people, created_by and last_edited_by properties, and the users
+// mentioned in its title and rich_text properties. The users that cannot be
+// resolved are left partial, and the first error is returned once the others
+// are resolved.
+func (c *Client) HydrateUsers(ctx context.Context, page *Page) error {
+	var firstErr error
+	resolve := func(user *User) {
+		if !user.IsPartial() {
+			return
+		}
+		full, err := c.UserCache().Resolve(ctx, user)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("user %s: %w", user.ID, err)
+			}
+			return
+		}
+		*user = *full
+	}
+	resolveRichText := func(richText []RichText) {
+		for i := range richText {
+			if mention := richText[i].Mention; mention != nil && mention.User != nil {
+				resolve(mention.User)
+			}
+		}
+	}
+
+	resolve(&page.CreatedBy)
+	resolve(&page.LastEditedBy)
+	for name, property := range page.Properties {
+		if property == nil {
+			continue
+		}
+		property = propertyPointer(property)
+		switch p := property.(type) {
+		case *PeopleProperty:
+			for i := range p.People {
+				resolve(&p.People[i])
+			}
+		case *CreatedByProperty:
+			resolve(&p.CreatedBy)
+		case *LastEditedByProperty:
+			resolve(&p.LastEditedBy)
+		case *TitleProperty:
+			resolveRichText(p.Title)
+		case *RichTextProperty:
+			resolveRichText(p.RichText)
+		default:
+			continue
+		}
+		page.Properties[name] = property
+	}
+	return firstErr
+}
+
+// hydratePages hydrates the users of pages when WithUserHydration is set,
+// leaving the users that cannot be resolved partial.
+func (c *Client) hydratePages(ctx context.Context, pages ...*Page) {
+	if !c.hydrateUsers {
+		return
+	}
+	for _, page := range pages {
+		_ = c.HydrateUsers(ctx, page)
+	}
+}
@@ -0,0 +1,139 @@
+package notionapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestWithUserHydration(t *testing.T) {
+	const page = `{"object":"page","id":"page_id",
+		"created_by":{"object":"user","id":"user_1"},
+		"last_edited_by":{"object":"user","id":"user_2"},
+		"properties":{
+			"Assignees": {"id":"a","type":"people","people":[{"object":"user","id":"user_2"}]},
+			"Notes": {"id":"b","type":"rich_text","rich_text":[{"type":"mention","mention":{"type":"user","user":{"object":"user","id":"user_1"}},"plain_text":"@Ada"}]}
+		}}`
+
+	tests := []struct {
+		name      string
+		opts      []notionapi.ClientOption
+		query     bool
+		wantNames bool
+		wantLists int
+	}{
+		{name: "page", opts: []notionapi.ClientOption{notionapi.WithUserHydration()}, wantNames: true, wantLists: 1},
+		{name: "query", opts: []notionapi.ClientOption{notionapi.WithUserHydration()}, query: true, wantNames: true, wantLists: 1},
+		{name: "disabled", wantLists: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lists := 0
+			c := newTestClient(func(req *http.Request) *http.Response {
+				switch req.URL.Path {
+				case "/v1/users":
+					lists++
+					return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+						{"object":"user","id":"user_1","type":"person","name":"Ada"},
+						{"object":"user","id":"user_2","type":"person","name":"Grace"}]}`)
+				case "/v1/databases/database_id/query":
+					return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[`+page+`]}`)
+				}
+				return newJSONResponse(http.StatusOK, page)
+			})
+			client := notionapi.NewClient("some_token", append(tt.opts, notionapi.WithHTTPClient(c))...)
+
+			var got *notionapi.Page
+			if tt.query {
+				res, err := client.Database.Query(context.Background(), "database_id", nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = &res.Results[0]
+			} else {
+				var err error
+				if got, err = client.Page.Get(context.Background(), "page_id"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			people, err := got.Properties.People("Assignees")
+			if err != nil {
+				t.Fatal(err)
+			}
+			notes := got.Properties["Notes"].(*notionapi.RichTextProperty).RichText[0].Mention.User
+			names := []string{got.CreatedBy.Name, got.LastEditedBy.Name, people[0].Name, notes.Name}
+			want := []string{"", "", "", ""}
+			if tt.wantNames {
+				want = []string{"Ada", "Grace", "Grace", "Ada"}
+			}
+			for i := range names {
+				if names[i] != want[i] {
+					t.Errorf("names = %v, want %v", names, want)
+					break
+				}
+			}
+			if lists != tt.wantLists {
+				t.Errorf("users listed %d times, want %d", lists, tt.wantLists)
+			}
+		})
+	}
+}
+
+func TestWithUserHydrationFailures(t *testing.T) {
+	const page = `{"object":"page","id":"page_%d",
+		"created_by":{"object":"user","id":"ghost"},
+		"last_edited_by":{"object":"user","id":"user_1"},
+		"properties":{}}`
+	results := fmt.Sprintf(`{"object":"list","has_more":false,"results":[%s,%s,%s]}`,
+		fmt.Sprintf(page, 1), fmt.Sprintf(page, 2), fmt.Sprintf(page, 3))
+
+	tests := []struct {
+		name       string
+		canList    bool
+		wantNames  []string
+		wantLists  int
+		wantGhosts int
+	}{
+		{name: "unknown user", canList: true, wantNames: []string{"", "Ada"}, wantLists: 1, wantGhosts: 1},
+		{name: "users cannot be read", wantNames: []string{"", ""}, wantLists: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lists, ghosts int
+			c := newTestClient(func(req *http.Request) *http.Response {
+				switch req.URL.Path {
+				case "/v1/users":
+					lists++
+					if !tt.canList {
+						return newJSONResponse(http.StatusForbidden, `{"object":"error","status":403,"code":"restricted_resource","message":"Insufficient permissions"}`)
+					}
+					return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+						{"object":"user","id":"user_1","type":"person","name":"Ada"}]}`)
+				case "/v1/users/ghost":
+					ghosts++
+					return newJSONResponse(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"Could not find user"}`)
+				}
+				return newJSONResponse(http.StatusOK, results)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c), notionapi.WithUserHydration())
+
+			res, err := client.Database.Query(context.Background(), "database_id", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, page := range res.Results {
+				if names := []string{page.CreatedBy.Name, page.LastEditedBy.Name}; !reflect.DeepEqual(names, tt.wantNames) {
+					t.Errorf("page %s names = %v, want %v", page.ID, names, tt.wantNames)
+				}
+			}
+			if lists != tt.wantLists || ghosts != tt.wantGhosts {
+				t.Errorf("requests: %d lists, %d gets, want %d and %d", lists, ghosts, tt.wantLists, tt.wantGhosts)
+			}
+		})
+	}
+}
@@ -2,9 +2,12 @@ package notionapi_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/robinlbt/notionapi"
 )
@@ -108,6 +111,23 @@ func TestUserClient(t *testing.T) {
 		}
 	})
 
+	t.Run("List pagination", func(t *testing.T) {
+		var query string
+		c := newTestClient(func(req *http.Request) *http.Response {
+			query = req.URL.RawQuery
+			return newJSONResponse(http.StatusOK, `{"object":"list","results":[],"has_more":false}`)
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+		_, err := client.User.List(context.Background(), &notionapi.Pagination{StartCursor: "cursor", PageSize: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "page_size=10&start_cursor=cursor"; query != want {
+			t.Errorf("List() query = %q, want %q", query, want)
+		}
+	})
+
 	t.Run("Me", func(t *testing.T) {
 		tests := []struct {
 			name       string
@@ -133,6 +153,37 @@ func TestUserClient(t *testing.T) {
 					}, WorkspaceName: "John Doe's Workspace"},
 				},
 			},
+			{
+				name:       "returns bot owned by a user",
+				filePath:   "testdata/user_me_user_owner.json",
+				statusCode: http.StatusOK,
+				want: &notionapi.User{
+					Object: notionapi.ObjectTypeUser,
+					ID:     "bot_id",
+					Type:   notionapi.UserTypeBot,
+					Name:   "Sync",
+					Bot: &notionapi.Bot{
+						Owner: notionapi.Owner{
+							Type: notionapi.BotOwnerTypeUser,
+							User: &notionapi.User{
+								Object: notionapi.ObjectTypeUser,
+								ID:     "user_id",
+								Type:   notionapi.UserTypePerson,
+								Name:   "Ada",
+								Person: &notionapi.Person{Email: "ada@example.com"},
+							},
+						},
+						WorkspaceName:   "Ada's Workspace",
+						WorkspaceLimits: &notionapi.WorkspaceLimits{MaxFileUploadSizeInBytes: 5368709120},
+						Capabilities: &notionapi.BotCapabilities{
+							ReadContent:     true,
+							UpdateContent:   true,
+							ReadComments:    true,
+							UserInformation: notionapi.UserInformationWithoutEmail,
+						},
+					},
+				},
+			},
 		}
 
 		for _, tt := range tests {
@@ -153,3 +204,215 @@ func TestUserClient(t *testing.T) {
 		}
 	})
 }
+
+func TestBotCapabilities(t *testing.T) {
+	tests := []struct {
+		name           string
+		capabilities   notionapi.BotCapabilities
+		wantReadUsers  bool
+		wantReadEmails bool
+	}{
+		{name: "no user information", capabilities: notionapi.BotCapabilities{UserInformation: notionapi.UserInformationNone}},
+		{name: "without email", capabilities: notionapi.BotCapabilities{UserInformation: notionapi.UserInformationWithoutEmail}, wantReadUsers: true},
+		{name: "with email", capabilities: notionapi.BotCapabilities{UserInformation: notionapi.UserInformationWithEmail}, wantReadUsers: true, wantReadEmails: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.capabilities.CanReadUsers(); got != tt.wantReadUsers {
+				t.Errorf("CanReadUsers() = %v, want %v", got, tt.wantReadUsers)
+			}
+			if got := tt.capabilities.CanReadEmails(); got != tt.wantReadEmails {
+				t.Errorf("CanReadEmails() = %v, want %v", got, tt.wantReadEmails)
+			}
+		})
+	}
+}
+
+func TestUserCacheResolve(t *testing.T) {
+	var requests int
+	c := newTestClient(func(req *http.Request) *http.Response {
+		requests++
+		if req.URL.Path != "/v1/users" {
+			t.Fatalf("unexpected request %s", req.URL)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[{"object":"user","id":"11111111-1111-1111-1111-111111111111","type":"person","name":"Ada","person":{"email":"ada@example.com"}}]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+	resolver := client.UserCache()
+
+	var page notionapi.Page
+	if err := json.Unmarshal([]byte(`{"object":"page","created_by":{"object":"user","id":"11111111-1111-1111-1111-111111111111"}}`), &page); err != nil {
+		t.Fatal(err)
+	}
+	if !page.CreatedBy.IsPartial() {
+		t.Fatal("IsPartial() = false, want true")
+	}
+	for i := 0; i < 2; i++ {
+		user, err := resolver.Resolve(context.Background(), &page.CreatedBy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user.Name != "Ada" || user.Person.Email != "ada@example.com" {
+			t.Errorf("Resolve() = %+v", user)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Resolve() sent %d requests, want 1", requests)
+	}
+}
+
+func TestUserClientListIterator(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("start_cursor") == "" {
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":true,"next_cursor":"cursor","results":[{"object":"user","id":"user_1"},{"object":"user","id":"user_2"}]}`)
+		}
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[{"object":"user","id":"user_3"}]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	var ids []notionapi.UserID
+	it := client.User.ListIterator(context.Background())
+	for it.Next() {
+		ids = append(ids, it.User().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []notionapi.UserID{"user_1", "user_2", "user_3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListIterator() = %v, want %v", ids, want)
+	}
+}
+
+func TestUserCache(t *testing.T) {
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		ids       []notionapi.UserID
+		wantNames []string
+		wantLists int
+		wantGets  int
+	}{
+		{
+			name:      "listed once",
+			ttl:       time.Minute,
+			ids:       []notionapi.UserID{"user_1", "user_2", "user_1"},
+			wantNames: []string{"Ada", "Grace", "Ada"},
+			wantLists: 1,
+		},
+		{
+			name:      "expired",
+			ttl:       0,
+			ids:       []notionapi.UserID{"user_1", "user_2"},
+			wantNames: []string{"Ada", "Grace"},
+			wantLists: 2,
+		},
+		{
+			name:      "users not listed",
+			ttl:       time.Minute,
+			ids:       []notionapi.UserID{"guest", "guest"},
+			wantNames: []string{"Guest", "Guest"},
+			wantLists: 1,
+			wantGets:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lists, gets int
+			c := newTestClient(func(req *http.Request) *http.Response {
+				if req.URL.Path == "/v1/users" {
+					lists++
+					return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+						{"object":"user","id":"user_1","type":"person","name":"Ada"},
+						{"object":"user","id":"user_2","type":"person","name":"Grace"}]}`)
+				}
+				gets++
+				return newJSONResponse(http.StatusOK, `{"object":"user","id":"guest","type":"person","name":"Guest"}`)
+			})
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+			cache := notionapi.NewUserCache(client.User, tt.ttl)
+
+			var names []string
+			for _, id := range tt.ids {
+				user, err := cache.Get(context.Background(), id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				names = append(names, user.Name)
+				// the users returned are copies, which do not change the cache
+				user.Name = "modified"
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("Get() names = %v, want %v", names, tt.wantNames)
+			}
+			if lists != tt.wantLists || gets != tt.wantGets {
+				t.Errorf("requests: %d lists, %d gets, want %d and %d", lists, gets, tt.wantLists, tt.wantGets)
+			}
+		})
+	}
+}
+
+func TestUserCacheCopies(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+			{"object":"user","id":"user_1","type":"person","name":"Ada","person":{"email":"ada@example.com"}}]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+	cache := notionapi.NewUserCache(client.User, time.Minute)
+
+	user, err := cache.FindUserByEmail(context.Background(), "ada@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user.Name = "modified"
+	user.Person.Email = "modified@example.com"
+
+	users, err := cache.All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	users[0].Person.Email = "modified@example.com"
+
+	got, err := cache.Get(context.Background(), "user_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Ada" || got.Person.Email != "ada@example.com" {
+		t.Errorf("Get() = %s %s, want the cached user unchanged", got.Name, got.Person.Email)
+	}
+}
+
+func TestClientFindUserByEmail(t *testing.T) {
+	var lists int
+	c := newTestClient(func(req *http.Request) *http.Response {
+		lists++
+		return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+			{"object":"user","id":"bot_1","type":"bot","name":"Bot","bot":{}},
+			{"object":"user","id":"user_1","type":"person","name":"Ada","person":{"email":"Ada@Example.com"}}]}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c), notionapi.WithUserCache(time.Minute))
+
+	tests := []struct {
+		name    string
+		email   string
+		want    notionapi.UserID
+		wantErr error
+	}{
+		{name: "ignoring case", email: " ada@example.com", want: "user_1"},
+		{name: "unknown", email: "grace@example.com", wantErr: notionapi.ErrUserNotFound},
+		{name: "empty", email: "", wantErr: notionapi.ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.FindUserByEmail(context.Background(), tt.email)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindUserByEmail() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != nil && got.ID != tt.want {
+				t.Errorf("FindUserByEmail() = %s, want %s", got.ID, tt.want)
+			}
+		})
+	}
+	if lists != 1 {
+		t.Errorf("users listed %d times, want 1", lists)
+	}
+}
@@ -0,0 +1,119 @@
+package notionapi
+
+import (
+	"context"
+	"time"
+)
+
+// PageChange is a change of a page detected by Client.WatchPage.
+type PageChange struct {
+	// Page is the page as retrieved after the change.
+	Page *Page
+	// PreviousEditedTime is the last_edited_time of the page before the
+	// change.
+	PreviousEditedTime time.Time
+	// Ops are the operations turning the previous content of the page into
+	// the new one, with WatchPageOptions.DiffBlocks.
+	Ops []BlockOp
+	// Err is the error met while polling the page, in which case the other
+	// fields are not set. Polling carries on after errors.
+	Err error
+}
+
+// WatchPageOptions configures Client.WatchPage.
+type WatchPageOptions struct {
+	// DiffBlocks retrieves the content of the page on each change, and
+	// computes the operations that changed it with DiffBlockTrees.
+	DiffBlocks bool
+}
+
+// DefaultWatchInterval is the interval at which Client.WatchPage polls pages
+// when it is given none. last_edited_time is rounded to the minute.
+const DefaultWatchInterval = time.Minute
+
+// WatchPage polls the page with the ID specified every interval, or every
+// DefaultWatchInterval if interval is not positive, and sends a PageChange on
+// the channel returned each time its last_edited_time changes, for the
+// integrations that cannot receive webhooks. The channel is closed when ctx
+// is done.
+//
+// Notion rounds last_edited_time to the minute, so the changes made within
+// the minute of the previous change are only detected with the next one.
+func (c *Client) WatchPage(ctx context.Context, id PageID, interval time.Duration, opts *WatchPageOptions) <-chan PageChange {
+	if opts == nil {
+		opts = &WatchPageOptions{}
+	}
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	changes := make(chan PageChange)
+	go func() {
+		defer close(changes)
+		w := &pageWatcher{client: c, id: id, opts: opts}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if change := w.poll(ctx); change != nil {
+				select {
+				case changes <- *change:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return changes
+}
+
+type pageWatcher struct {
+	client *Client
+	id     PageID
+	opts   *WatchPageOptions
+	// page and tree are the last versions of the page retrieved, nil until
+	// the first poll succeeds.
+	page *Page
+	tree *BlockNode
+}
+
+// poll retrieves the page and returns its change since the previous poll, or
+// nil.
+func (w *pageWatcher) poll(ctx context.Context) *PageChange {
+	page, err := w.client.Page.Get(ctx, w.id)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return &PageChange{Err: err}
+	}
+	if w.page != nil && page.LastEditedTime.Equal(w.page.LastEditedTime) {
+		return nil
+	}
+
+	var tree *BlockNode
+	if w.opts.DiffBlocks {
+		if tree, err = w.client.Block.GetTree(ctx, BlockID(w.id), nil); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return &PageChange{Err: err}
+		}
+	}
+	previous, previousTree := w.page, w.tree
+	w.page, w.tree = page, tree
+	if previous == nil {
+		return nil
+	}
+
+	change := &PageChange{Page: page, PreviousEditedTime: previous.LastEditedTime}
+	if tree != nil && previousTree != nil {
+		if change.Ops, err = DiffBlockTrees(previousTree.Children, tree.Children); err != nil {
+			return &PageChange{Err: err}
+		}
+	}
+	return change
+}
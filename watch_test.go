@@ -0,0 +1,85 @@
+package notionapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/robinlbt/notionapi"
+)
+
+func TestClientWatchPage(t *testing.T) {
+	const page = "11111111-1111-1111-1111-111111111111"
+	var (
+		mu      sync.Mutex
+		version int
+	)
+	c := newTestClient(func(req *http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+		switch req.URL.Path {
+		case "/v1/pages/" + page:
+			// the page is edited every third poll
+			version++
+			edited := fmt.Sprintf("2021-05-24T05:%02d:00.000Z", version/3)
+			return newJSONResponse(http.StatusOK, `{"object":"page","id":"`+page+`","last_edited_time":"`+edited+`"}`)
+		case "/v1/blocks/" + page:
+			return newJSONResponse(http.StatusOK, `{"object":"block","id":"`+page+`","type":"child_page","has_children":true,"child_page":{"title":"Page"}}`)
+		case "/v1/blocks/" + page + "/children":
+			content := fmt.Sprintf("version %d", version/3)
+			return newJSONResponse(http.StatusOK, `{"object":"list","has_more":false,"results":[
+				{"object":"block","id":"paragraph","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"`+content+`"},"plain_text":"`+content+`"}]}}]}`)
+		}
+		t.Errorf("unexpected request %s", req.URL)
+		return newJSONResponse(http.StatusNotFound, `{}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := client.WatchPage(ctx, page, time.Millisecond, &notionapi.WatchPageOptions{DiffBlocks: true})
+
+	change := <-changes
+	if change.Err != nil {
+		t.Fatalf("WatchPage() error = %v", change.Err)
+	}
+	if want := time.Date(2021, 5, 24, 5, 0, 0, 0, time.UTC); !change.PreviousEditedTime.Equal(want) {
+		t.Errorf("WatchPage() previous edited time = %v, want %v", change.PreviousEditedTime, want)
+	}
+	if want := time.Date(2021, 5, 24, 5, 1, 0, 0, time.UTC); !change.Page.LastEditedTime.Equal(want) {
+		t.Errorf("WatchPage() edited time = %v, want %v", change.Page.LastEditedTime, want)
+	}
+	if len(change.Ops) != 1 || change.Ops[0].Type != notionapi.BlockOpUpdate {
+		t.Errorf("WatchPage() ops = %+v, want an update", change.Ops)
+	}
+
+	cancel()
+	for range changes {
+	}
+}
+
+func TestClientWatchPageDefaultInterval(t *testing.T) {
+	var polls int32
+	c := newTestClient(func(req *http.Request) *http.Response {
+		atomic.AddInt32(&polls, 1)
+		return newJSONResponse(http.StatusOK, `{"object":"page","id":"page","last_edited_time":"2021-05-24T05:00:00.000Z"}`)
+	})
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := client.WatchPage(ctx, "page", 0, nil)
+	for i := 0; i < 100 && atomic.LoadInt32(&polls) == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if n := atomic.LoadInt32(&polls); n != 1 {
+		t.Errorf("WatchPage() polled %d times, want 1 before the default interval", n)
+	}
+
+	cancel()
+	for range changes {
+	}
+}